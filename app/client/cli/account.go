@@ -1,11 +1,8 @@
 package cli
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 
-	"github.com/pokt-network/pocket/app/client/cli/flags"
 	"github.com/pokt-network/pocket/shared/crypto"
 	"github.com/pokt-network/pocket/utility/types"
 )
@@ -25,6 +22,8 @@ func NewAccountCommand() *cobra.Command {
 	cmds := accountCommands()
 	applySubcommandOptions(cmds, attachKeybaseFlagsToSubcommands())
 	applySubcommandOptions(cmds, attachPwdFlagToSubcommands())
+	applySubcommandOptions(cmds, attachDryRunFlagToSubcommands())
+	applySubcommandOptions(cmds, attachGenerateOnlyFlagsToSubcommands())
 	cmd.AddCommand(cmds...)
 
 	return cmd
@@ -45,44 +44,18 @@ func accountCommands() []*cobra.Command {
 				toAddr := crypto.AddressFromString(args[1])
 				amount := args[2]
 
-				kb, err := keybaseForCLI()
+				signer, err := getTxSigner(fromAddrHex)
 				if err != nil {
 					return err
 				}
 
-				if !flags.NonInteractive {
-					pwd = readPassphrase(pwd)
-				}
-
-				pk, err := kb.GetPrivKey(fromAddrHex, pwd)
-				if err != nil {
-					return err
-				}
-				if err := kb.Stop(); err != nil {
-					return err
-				}
-
 				msg := &types.MessageSend{
 					FromAddress: fromAddr,
 					ToAddress:   toAddr,
 					Amount:      amount,
 				}
 
-				tx, err := prepareTxBytes(msg, pk)
-				if err != nil {
-					return err
-				}
-
-				resp, err := postRawTx(cmd.Context(), pk, tx)
-				if err != nil {
-					return err
-				}
-
-				// DISCUSS(#310): define UX for return values - should we return the raw response or a parsed/human readable response? For now, I am simply printing to stdout
-				fmt.Printf("HTTP status code: %d\n", resp.StatusCode())
-				fmt.Println(string(resp.Body))
-
-				return nil
+				return submitTx(cmd, signer, msg)
 			},
 		},
 	}
@@ -8,7 +8,6 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/pokt-network/pocket/app/client/cli/flags"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	"github.com/pokt-network/pocket/shared/crypto"
 	typesUtil "github.com/pokt-network/pocket/utility/types"
@@ -30,6 +29,9 @@ const (
 
 var (
 	pwd                  string
+	dryRun               bool
+	generateOnly         bool
+	signerPubKeyHex      string
 	rawChainCleanupRegex *regexp.Regexp
 	oneMillion           *big.Int
 )
@@ -72,6 +74,8 @@ func newActorCommands(cmdDef actorCmdDef) []*cobra.Command {
 	}
 	applySubcommandOptions(cmds, attachPwdFlagToSubcommands())
 	applySubcommandOptions(cmds, attachKeybaseFlagsToSubcommands())
+	applySubcommandOptions(cmds, attachDryRunFlagToSubcommands())
+	applySubcommandOptions(cmds, attachGenerateOnlyFlagsToSubcommands())
 	return cmds
 }
 
@@ -96,21 +100,12 @@ If no changes are desired for the parameter, just enter the current param value
 			fromAddrHex := args[0]
 			amount := args[1]
 
-			kb, err := keybaseForCLI()
+			signer, err := getTxSigner(fromAddrHex)
 			if err != nil {
 				return err
 			}
-
-			if !flags.NonInteractive {
-				pwd = readPassphrase(pwd)
-			}
-
-			pk, err := kb.GetPrivKey(fromAddrHex, pwd)
-			if err != nil {
-				return err
-			}
-			if err := kb.Stop(); err != nil {
-				return err
+			if signer.publicKey == nil {
+				return fmt.Errorf("the public key of %s is required to stake, provide it with --public_key", fromAddrHex)
 			}
 
 			err = validateStakeAmount(amount)
@@ -124,29 +119,16 @@ If no changes are desired for the parameter, just enter the current param value
 			serviceURL := args[3]
 
 			msg := &typesUtil.MessageStake{
-				PublicKey:     pk.PublicKey().Bytes(),
+				PublicKey:     signer.publicKey.Bytes(),
 				Chains:        chains,
 				Amount:        amount,
 				ServiceUrl:    serviceURL,
-				OutputAddress: pk.Address(),
-				Signer:        pk.Address(),
+				OutputAddress: signer.address,
+				Signer:        signer.address,
 				ActorType:     cmdDef.ActorType,
 			}
 
-			tx, err := prepareTxBytes(msg, pk)
-			if err != nil {
-				return err
-			}
-
-			resp, err := postRawTx(cmd.Context(), pk, tx)
-			if err != nil {
-				return err
-			}
-			// DISCUSS(#310): define UX for return values - should we return the raw response or a parsed/human readable response? For now, I am simply printing to stdout
-			fmt.Printf("HTTP status code: %d\n", resp.StatusCode())
-			fmt.Println(string(resp.Body))
-
-			return nil
+			return submitTx(cmd, signer, msg)
 		},
 	}
 
@@ -165,21 +147,12 @@ func newEditStakeCmd(cmdDef actorCmdDef) *cobra.Command {
 			fromAddr := crypto.AddressFromString(args[0])
 			amount := args[1]
 
-			kb, err := keybaseForCLI()
+			signer, err := getTxSigner(fromAddrHex)
 			if err != nil {
 				return err
 			}
-
-			if !flags.NonInteractive {
-				pwd = readPassphrase(pwd)
-			}
-
-			pk, err := kb.GetPrivKey(fromAddrHex, pwd)
-			if err != nil {
-				return err
-			}
-			if err := kb.Stop(); err != nil {
-				return err
+			if signer.publicKey == nil {
+				return fmt.Errorf("the public key of %s is required to stake, provide it with --public_key", fromAddrHex)
 			}
 
 			err = validateStakeAmount(amount)
@@ -196,24 +169,11 @@ func newEditStakeCmd(cmdDef actorCmdDef) *cobra.Command {
 				Chains:     chains,
 				Amount:     amount,
 				ServiceUrl: serviceURL,
-				Signer:     pk.Address(),
+				Signer:     signer.address,
 				ActorType:  cmdDef.ActorType,
 			}
 
-			tx, err := prepareTxBytes(msg, pk)
-			if err != nil {
-				return err
-			}
-
-			resp, err := postRawTx(cmd.Context(), pk, tx)
-			if err != nil {
-				return err
-			}
-			// DISCUSS(#310): define UX for return values - should we return the raw response or a parsed/human readable response? For now, I am simply printing to stdout
-			fmt.Printf("HTTP status code: %d\n", resp.StatusCode())
-			fmt.Println(string(resp.Body))
-
-			return nil
+			return submitTx(cmd, signer, msg)
 		},
 	}
 	return editStakeCmd
@@ -229,42 +189,18 @@ func newUnstakeCmd(cmdDef actorCmdDef) *cobra.Command {
 			// Unpack CLI arguments
 			fromAddrHex := args[0]
 
-			kb, err := keybaseForCLI()
+			signer, err := getTxSigner(fromAddrHex)
 			if err != nil {
 				return err
 			}
 
-			if !flags.NonInteractive {
-				pwd = readPassphrase(pwd)
-			}
-			pk, err := kb.GetPrivKey(fromAddrHex, pwd)
-			if err != nil {
-				return err
-			}
-			if err := kb.Stop(); err != nil {
-				return err
-			}
-
 			msg := &typesUtil.MessageUnstake{
-				Address:   pk.Address(),
-				Signer:    pk.Address(),
+				Address:   signer.address,
+				Signer:    signer.address,
 				ActorType: cmdDef.ActorType,
 			}
 
-			tx, err := prepareTxBytes(msg, pk)
-			if err != nil {
-				return err
-			}
-
-			resp, err := postRawTx(cmd.Context(), pk, tx)
-			if err != nil {
-				return err
-			}
-			// DISCUSS(#310): define UX for return values - should we return the raw response or a parsed/human readable response? For now, I am simply printing to stdout
-			fmt.Printf("HTTP status code: %d\n", resp.StatusCode())
-			fmt.Println(string(resp.Body))
-
-			return nil
+			return submitTx(cmd, signer, msg)
 		},
 	}
 	return unstakeCmd
@@ -280,43 +216,18 @@ func newUnpauseCmd(cmdDef actorCmdDef) *cobra.Command {
 			// Unpack CLI arguments
 			fromAddrHex := args[0]
 
-			kb, err := keybaseForCLI()
-			if err != nil {
-				return err
-			}
-
-			if !flags.NonInteractive {
-				pwd = readPassphrase(pwd)
-			}
-
-			pk, err := kb.GetPrivKey(fromAddrHex, pwd)
+			signer, err := getTxSigner(fromAddrHex)
 			if err != nil {
 				return err
 			}
-			if err := kb.Stop(); err != nil {
-				return err
-			}
 
 			msg := &typesUtil.MessageUnpause{
-				Address:   pk.Address(),
-				Signer:    pk.Address(),
+				Address:   signer.address,
+				Signer:    signer.address,
 				ActorType: cmdDef.ActorType,
 			}
 
-			tx, err := prepareTxBytes(msg, pk)
-			if err != nil {
-				return err
-			}
-
-			resp, err := postRawTx(cmd.Context(), pk, tx)
-			if err != nil {
-				return err
-			}
-			// DISCUSS(#310): define UX for return values - should we return the raw response or a parsed/human readable response? For now, I am simply printing to stdout
-			fmt.Printf("HTTP status code: %d\n", resp.StatusCode())
-			fmt.Println(string(resp.Body))
-
-			return nil
+			return submitTx(cmd, signer, msg)
 		},
 	}
 	return unpauseCmd
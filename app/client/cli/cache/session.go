@@ -1,6 +1,5 @@
 package cache
 
-// TODO: add a TTL for cached sessions, since we know the sessions' length
 import (
 	"encoding/json"
 	"errors"
@@ -10,11 +9,18 @@ import (
 	"github.com/pokt-network/pocket/rpc"
 )
 
-var errSessionNotFound = errors.New("session not found in cache")
+var (
+	errSessionNotFound = errors.New("session not found in cache")
+	// ErrSessionExpired is returned when the cached session ended before the requested height
+	ErrSessionExpired = errors.New("cached session expired")
+	// ErrSessionNotStarted is returned when the cached session starts after the requested height
+	ErrSessionNotStarted = errors.New("cached session not started")
+)
 
 // SessionCache defines the set of methods used to interact with the client-side session cache
 type SessionCache interface {
 	Get(appAddr, chain string) (*rpc.Session, error)
+	GetAtHeight(appAddr, chain string, height int64) (*rpc.Session, error)
 	Set(session *rpc.Session) error
 	Stop() error
 }
@@ -58,6 +64,29 @@ func (s *sessionCache) Get(appAddr, chain string) (*rpc.Session, error) {
 	return &session, nil
 }
 
+// GetAtHeight returns the cached session for an app+chain combination if it is the session of the provided height.
+// Sessions expire after their last block, i.e. at SessionHeight+NumSessionBlocks: an expired session is evicted from the cache.
+// GetAtHeight is NOT safe to use concurrently
+func (s *sessionCache) GetAtHeight(appAddr, chain string, height int64) (*rpc.Session, error) {
+	session, err := s.Get(appAddr, chain)
+	if err != nil {
+		return nil, err
+	}
+
+	if height < session.SessionHeight {
+		return nil, fmt.Errorf("%w: session height %d, requested height %d", ErrSessionNotStarted, session.SessionHeight, height)
+	}
+
+	if height >= session.SessionHeight+session.NumSessionBlocks {
+		if err := s.store.Delete(sessionKey(appAddr, chain)); err != nil {
+			return nil, fmt.Errorf("error evicting expired session from the store: %w", err)
+		}
+		return nil, fmt.Errorf("%w: session ended at height %d, requested height %d", ErrSessionExpired, session.SessionHeight+session.NumSessionBlocks, height)
+	}
+
+	return session, nil
+}
+
 // Set stores the provided session in the cache with the key being the app+chain combination.
 // For each app+chain combination, a single session will be stored. Subsequent calls to Set will overwrite the entry for the provided app and chain.
 // Set is NOT safe to use concurrently
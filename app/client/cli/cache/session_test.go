@@ -73,3 +73,75 @@ func TestGet(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAtHeight(t *testing.T) {
+	const (
+		app1             = "app1Addr"
+		relaychainEth    = "ETH-Goerli"
+		numSessionBlocks = 4
+		sessionHeight    = 8
+	)
+
+	session1 := &rpc.Session{
+		Application: rpc.ProtocolActor{
+			ActorType: rpc.Application,
+			Address:   app1,
+			Chains:    []string{relaychainEth},
+		},
+		Chain:            relaychainEth,
+		NumSessionBlocks: numSessionBlocks,
+		SessionHeight:    sessionHeight,
+		SessionNumber:    sessionHeight / numSessionBlocks,
+	}
+
+	testCases := []struct {
+		name        string
+		height      int64
+		expected    *rpc.Session
+		expectedErr error
+		evicted     bool
+	}{
+		{
+			name:     "Return session at its first height",
+			height:   sessionHeight,
+			expected: session1,
+		},
+		{
+			name:     "Return session at its last height",
+			height:   sessionHeight + numSessionBlocks - 1,
+			expected: session1,
+		},
+		{
+			name:        "Error returned for a session not started yet",
+			height:      sessionHeight - 1,
+			expectedErr: ErrSessionNotStarted,
+		},
+		{
+			name:        "Expired session is evicted",
+			height:      sessionHeight + numSessionBlocks,
+			expectedErr: ErrSessionExpired,
+			evicted:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cache, err := NewSessionCache(t.TempDir())
+			require.NoError(t, err)
+			defer cache.Stop()
+
+			require.NoError(t, cache.Set(session1))
+
+			got, err := cache.GetAtHeight(app1, relaychainEth, tc.height)
+			require.ErrorIs(t, err, tc.expectedErr)
+			require.EqualValues(t, tc.expected, got)
+
+			_, err = cache.Get(app1, relaychainEth)
+			if tc.evicted {
+				require.ErrorIs(t, err, errSessionNotFound)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
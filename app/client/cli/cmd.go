@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"log"
 
 	"github.com/spf13/cobra"
@@ -39,6 +40,15 @@ func init() {
 	if err := viper.BindPFlag("verbose", rootCmd.PersistentFlags().Lookup("verbose")); err != nil {
 		log.Fatalf(flagBindErrFormat, "verbose", err)
 	}
+
+	rootCmd.PersistentFlags().StringVar(&flags.OutputFormat, "output", outputFormatJSON, "format of the results written to stdout and of the errors written to stderr: json, yaml or table")
+
+	// The errors are rendered by ExecuteContext, along with their code
+	rootCmd.SilenceErrors = true
+	rootCmd.SilenceUsage = true
+	rootCmd.SetFlagErrorFunc(func(_ *cobra.Command, err error) error {
+		return newCLIError(errCodeInvalidArgument, err)
+	})
 }
 
 var rootCmd = &cobra.Command{
@@ -46,6 +56,9 @@ var rootCmd = &cobra.Command{
 	Short: "Pocket Network Command Line Interface (CLI)",
 	Long:  "The CLI is meant to be an user but also a machine friendly way for interacting with Pocket Network.",
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := validateOutputFormat(); err != nil {
+			return err
+		}
 		// by this time, the config path should be set
 		cfg = configs.ParseConfig(flags.ConfigPath)
 		// set final `remote_cli_url` value; order of precedence: flag > env var > config > default
@@ -54,8 +67,29 @@ var rootCmd = &cobra.Command{
 	},
 }
 
+// ExecuteContext executes the command and renders the error it returns to stderr, see ExitCode for the exit status
 func ExecuteContext(ctx context.Context) error {
-	return rootCmd.ExecuteContext(ctx)
+	wrapArgsErrors(rootCmd)
+	err := rootCmd.ExecuteContext(ctx)
+	if err != nil && !errors.Is(err, context.Canceled) {
+		renderError(rootCmd.ErrOrStderr(), err)
+	}
+	return err
+}
+
+// wrapArgsErrors gives the `invalid_argument` code to the errors of the validation of the arguments of the commands
+func wrapArgsErrors(cmd *cobra.Command) {
+	if validateArgs := cmd.Args; validateArgs != nil {
+		cmd.Args = func(cmd *cobra.Command, args []string) error {
+			if err := validateArgs(cmd, args); err != nil {
+				return newCLIError(errCodeInvalidArgument, err)
+			}
+			return nil
+		}
+	}
+	for _, subCmd := range cmd.Commands() {
+		wrapArgsErrors(subCmd)
+	}
 }
 
 func GetRootCmd() *cobra.Command {
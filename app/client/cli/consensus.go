@@ -1,7 +1,7 @@
 package cli
 
 import (
-	"fmt"
+	"net/http"
 
 	"github.com/spf13/cobra"
 
@@ -31,18 +31,16 @@ func consensusCommands() []*cobra.Command {
 	cmds := []*cobra.Command{
 		{
 			Use:     "State",
-			Short:   "Returns \"Height/Round/Step\"",
-			Long:    "State returns the height, round and step in \"Height/Round/Step\" format",
+			Short:   "Returns the Height, Round and Step",
+			Long:    "State returns the height, round and step of the node's current consensus state",
 			Aliases: []string{"state"},
 			RunE: func(cmd *cobra.Command, args []string) error {
-				response, err := getConsensusState(cmd)
+				consensusState, err := getConsensusState(cmd)
 				if err != nil {
 					return err
 				}
 
-				fmt.Printf("%d/%d/%d\n", response.JSONDefault.Height, response.JSONDefault.Round, response.JSONDefault.Step)
-
-				return nil
+				return render(cmd, consensusState)
 			},
 		},
 		{
@@ -51,14 +49,12 @@ func consensusCommands() []*cobra.Command {
 			Long:    "Height returns the height in the node's current consensus state",
 			Aliases: []string{"height"},
 			RunE: func(cmd *cobra.Command, args []string) error {
-				response, err := getConsensusState(cmd)
+				consensusState, err := getConsensusState(cmd)
 				if err != nil {
 					return err
 				}
 
-				fmt.Printf("%d\n", response.JSONDefault.Height)
-
-				return nil
+				return render(cmd, consensusHeightResult{Height: consensusState.Height})
 			},
 		},
 		{
@@ -67,14 +63,12 @@ func consensusCommands() []*cobra.Command {
 			Long:    "Round returns the round in the node's current consensus state",
 			Aliases: []string{"round"},
 			RunE: func(cmd *cobra.Command, args []string) error {
-				response, err := getConsensusState(cmd)
+				consensusState, err := getConsensusState(cmd)
 				if err != nil {
 					return err
 				}
 
-				fmt.Printf("%d\n", response.JSONDefault.Round)
-
-				return nil
+				return render(cmd, consensusRoundResult{Round: consensusState.Round})
 			},
 		},
 		{
@@ -83,28 +77,41 @@ func consensusCommands() []*cobra.Command {
 			Long:    "Step returns the step in the node's current consensus state",
 			Aliases: []string{"step"},
 			RunE: func(cmd *cobra.Command, args []string) error {
-				response, err := getConsensusState(cmd)
+				consensusState, err := getConsensusState(cmd)
 				if err != nil {
 					return err
 				}
 
-				fmt.Printf("%d\n", response.JSONDefault.Step)
-
-				return nil
+				return render(cmd, consensusStepResult{Step: consensusState.Step})
 			},
 		},
 	}
 	return cmds
 }
 
-func getConsensusState(cmd *cobra.Command) (*rpc.GetV1ConsensusStateResponse, error) {
+type consensusHeightResult struct {
+	Height int64 `json:"height"`
+}
+
+type consensusRoundResult struct {
+	Round int64 `json:"round"`
+}
+
+type consensusStepResult struct {
+	Step int64 `json:"step"`
+}
+
+func getConsensusState(cmd *cobra.Command) (*rpc.ConsensusState, error) {
 	client, err := rpc.NewClientWithResponses(flags.RemoteCLIURL)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 	response, err := client.GetV1ConsensusStateWithResponse(cmd.Context())
 	if err != nil {
 		return nil, unableToConnectToRpc(err)
 	}
-	return response, nil
+	if response.StatusCode() != http.StatusOK || response.JSONDefault == nil {
+		return nil, rpcResponseCodeUnhealthy(response.StatusCode(), response.Body)
+	}
+	return response.JSONDefault, nil
 }
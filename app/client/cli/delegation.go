@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"encoding/hex"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pokt-network/pocket/utility/types"
+)
+
+func init() {
+	rootCmd.AddCommand(NewDelegationCommand())
+}
+
+func NewDelegationCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "Delegation",
+		Short:   "Delegated staking specific commands",
+		Aliases: []string{"delegation"},
+		Args:    cobra.ExactArgs(0),
+	}
+
+	cmds := delegationCommands()
+	applySubcommandOptions(cmds, attachKeybaseFlagsToSubcommands())
+	applySubcommandOptions(cmds, attachPwdFlagToSubcommands())
+	applySubcommandOptions(cmds, attachDryRunFlagToSubcommands())
+	applySubcommandOptions(cmds, attachGenerateOnlyFlagsToSubcommands())
+	cmd.AddCommand(cmds...)
+
+	return cmd
+}
+
+func delegationCommands() []*cobra.Command {
+	cmds := []*cobra.Command{
+		{
+			Use:     "Delegate <delegator> <validator> <amount>",
+			Short:   "Delegate <delegator> <validator> <amount>",
+			Long:    "Delegates <amount> of the balance of <delegator> to the staked <validator>. The delegated stake counts towards the voting power of the validator and earns <delegator> a share of its proposer rewards",
+			Aliases: []string{"delegate"},
+			Args:    cobra.ExactArgs(3),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				// Unpack CLI arguments
+				fromAddrHex := args[0]
+				validator, err := hex.DecodeString(args[1])
+				if err != nil {
+					return newCLIError(errCodeInvalidArgument, err)
+				}
+				amount := args[2]
+
+				signer, err := getTxSigner(fromAddrHex)
+				if err != nil {
+					return err
+				}
+
+				msg := &types.MessageDelegate{
+					Delegator:        signer.address,
+					ValidatorAddress: validator,
+					Amount:           amount,
+				}
+
+				return submitTx(cmd, signer, msg)
+			},
+		},
+		{
+			Use:     "Undelegate <delegator> <validator> <amount>",
+			Short:   "Undelegate <delegator> <validator> <amount>",
+			Long:    "Undelegates <amount> of the stake delegated by <delegator> to <validator>. The stake is returned to <delegator> once it finishes unbonding after the validator unstaking blocks",
+			Aliases: []string{"undelegate"},
+			Args:    cobra.ExactArgs(3),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				// Unpack CLI arguments
+				fromAddrHex := args[0]
+				validator, err := hex.DecodeString(args[1])
+				if err != nil {
+					return newCLIError(errCodeInvalidArgument, err)
+				}
+				amount := args[2]
+
+				signer, err := getTxSigner(fromAddrHex)
+				if err != nil {
+					return err
+				}
+
+				msg := &types.MessageUndelegate{
+					Delegator:        signer.address,
+					ValidatorAddress: validator,
+					Amount:           amount,
+				}
+
+				return submitTx(cmd, signer, msg)
+			},
+		},
+		{
+			Use:     "Redelegate <delegator> <source_validator> <destination_validator> <amount>",
+			Short:   "Redelegate <delegator> <source_validator> <destination_validator> <amount>",
+			Long:    "Moves <amount> of the stake delegated by <delegator> from <source_validator> to the staked <destination_validator> without unbonding it",
+			Aliases: []string{"redelegate"},
+			Args:    cobra.ExactArgs(4),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				// Unpack CLI arguments
+				fromAddrHex := args[0]
+				sourceValidator, err := hex.DecodeString(args[1])
+				if err != nil {
+					return newCLIError(errCodeInvalidArgument, err)
+				}
+				destinationValidator, err := hex.DecodeString(args[2])
+				if err != nil {
+					return newCLIError(errCodeInvalidArgument, err)
+				}
+				amount := args[3]
+
+				signer, err := getTxSigner(fromAddrHex)
+				if err != nil {
+					return err
+				}
+
+				msg := &types.MessageRedelegate{
+					Delegator:                   signer.address,
+					SourceValidatorAddress:      sourceValidator,
+					DestinationValidatorAddress: destinationValidator,
+					Amount:                      amount,
+				}
+
+				return submitTx(cmd, signer, msg)
+			},
+		},
+	}
+	return cmds
+}
@@ -21,4 +21,8 @@ var (
 	// Show verbose output
 	// (see: --help the root command for more info).
 	Verbose bool
+
+	// OutputFormat is the format of the results and errors of the commands: json, yaml or table
+	// (see: --help the root command for more info).
+	OutputFormat string
 )
@@ -2,15 +2,31 @@ package cli
 
 import (
 	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/pokt-network/pocket/app/client/cli/flags"
+	"github.com/pokt-network/pocket/rpc"
+	"github.com/pokt-network/pocket/runtime/genesis"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/utils"
 	"github.com/pokt-network/pocket/utility/types"
 )
 
+var (
+	proposalTitle       string
+	proposalDescription string
+	flagEnabled         bool
+	proposalStatus      string
+)
+
 func init() {
 	rootCmd.AddCommand(NewGovernanceCommand())
 }
@@ -24,10 +40,20 @@ func NewGovernanceCommand() *cobra.Command {
 	}
 
 	cmds := govCommands()
-	applySubcommandOptions(cmds, attachPwdFlagToSubcommands())
-	applySubcommandOptions(cmds, attachKeybaseFlagsToSubcommands())
+	proposalCmds := govProposalCommands()
+	txCmds := append(cmds, proposalCmds...)
+	applySubcommandOptions(txCmds, attachPwdFlagToSubcommands())
+	applySubcommandOptions(txCmds, attachKeybaseFlagsToSubcommands())
+	applySubcommandOptions(txCmds, attachDryRunFlagToSubcommands())
+	applySubcommandOptions(txCmds, attachGenerateOnlyFlagsToSubcommands())
+	applySubcommandOptions(proposalCmds, attachProposalFlagsToSubcommands())
 
-	cmd.AddCommand(cmds...)
+	queryCmds := govQueryCommands()
+	applySubcommandOptions(queryCmds, attachHeightFlagToSubcommands())
+	applySubcommandOptions(queryCmds, attachBlockSelectorFlagsToSubcommands())
+
+	cmd.AddCommand(txCmds...)
+	cmd.AddCommand(queryCmds...)
 
 	return cmd
 }
@@ -47,53 +73,304 @@ func govCommands() []*cobra.Command {
 				value := args[2]
 
 				// TODO(deblasis): implement RPC client, route and handler
-				fmt.Printf("changing parameter %s owned by %s to %s\n", args[1], args[0], args[2])
+				fmt.Fprintf(os.Stderr, "changing parameter %s owned by %s to %s\n", args[1], args[0], args[2])
+
+				signer, err := getTxSigner(fromAddrHex)
+				if err != nil {
+					return err
+				}
 
-				kb, err := keybaseForCLI()
+				pbValue, err := anypb.New(wrapperspb.String(value))
 				if err != nil {
 					return err
 				}
 
-				if !flags.NonInteractive {
-					pwd = readPassphrase(pwd)
+				msg := &types.MessageChangeParameter{
+					Signer:         signer.address,
+					Owner:          signer.address,
+					ParameterKey:   key,
+					ParameterValue: pbValue,
 				}
 
-				pk, err := kb.GetPrivKey(fromAddrHex, pwd)
+				return submitTx(cmd, signer, msg)
+			},
+		},
+		{
+			Use:     "Vote <voter> <proposal_id> <yes|no|abstain>",
+			Short:   "Vote <voter> <proposal_id> <yes|no|abstain>",
+			Long:    "Casts the vote of the validator <voter> on the proposal with <proposal_id>. The vote is weighted by the stake of the validator when the proposal is tallied at the end of its voting window and can be changed until then",
+			Aliases: []string{"vote"},
+			Args:    cobra.ExactArgs(3),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				// Unpack CLI arguments
+				fromAddrHex := args[0]
+				proposalID, err := strconv.ParseUint(args[1], 10, 64)
+				if err != nil {
+					return newCLIError(errCodeInvalidArgument, err)
+				}
+				option, err := parseVoteOption(args[2])
+				if err != nil {
+					return newCLIError(errCodeInvalidArgument, err)
+				}
+
+				signer, err := getTxSigner(fromAddrHex)
 				if err != nil {
 					return err
 				}
-				if err := kb.Stop(); err != nil {
+
+				msg := &types.MessageVote{
+					Voter:      signer.address,
+					ProposalId: proposalID,
+					Option:     option,
+				}
+
+				return submitTx(cmd, signer, msg)
+			},
+		},
+		{
+			Use:     "Upgrade <owner> <version> <height>",
+			Short:   "Upgrade <owner> <version> <height>",
+			Long:    "Schedules the protocol <version> (e.g. v1.0.0) at the future <height>, superseding the pending upgrade if any. <version> must be greater than the active and pending versions. Nodes whose binary is older than <version> stop applying blocks from <height>",
+			Aliases: []string{"upgrade"},
+			Args:    cobra.ExactArgs(3),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				// Unpack CLI arguments
+				fromAddrHex := args[0]
+				version := args[1]
+				upgradeHeight, err := strconv.ParseInt(args[2], 10, 64)
+				if err != nil {
+					return newCLIError(errCodeInvalidArgument, err)
+				}
+
+				signer, err := getTxSigner(fromAddrHex)
+				if err != nil {
 					return err
 				}
 
+				msg := &types.MessageUpgrade{
+					Signer:  signer.address,
+					Version: version,
+					Height:  upgradeHeight,
+				}
+
+				return submitTx(cmd, signer, msg)
+			},
+		},
+	}
+	return cmds
+}
+
+func govProposalCommands() []*cobra.Command {
+	cmds := []*cobra.Command{
+		{
+			Use:     "ProposeParameterChange <proposer> <key> <value> --title [--description]",
+			Short:   "ProposeParameterChange <proposer> <key> <value> --title [--description]",
+			Long:    "Submits a proposal to change the Governance parameter with <key> to <value>, which is applied if the validators vote it through",
+			Aliases: []string{"proposeparameterchange"},
+			Args:    cobra.ExactArgs(3),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				// Unpack CLI arguments
+				fromAddrHex := args[0]
+				key := args[1]
+				value := args[2]
+
+				pbValue, err := paramValueToAny(key, value)
+				if err != nil {
+					return newCLIError(errCodeInvalidArgument, err)
+				}
+
+				return submitProposal(cmd, fromAddrHex, &types.MessageSubmitProposal{Content: &types.MessageSubmitProposal_ParamChange{
+					ParamChange: &coreTypes.ParamChangeProposal{
+						ParameterKey:   key,
+						ParameterValue: pbValue,
+					},
+				}})
+			},
+		},
+		{
+			Use:     "ProposeFlagChange <proposer> <key> <value> --title [--description] [--enabled]",
+			Short:   "ProposeFlagChange <proposer> <key> <value> --title [--description] [--enabled]",
+			Long:    "Submits a proposal to set the feature flag with <key> to <value>, enabled or not depending on [--enabled], which is applied if the validators vote it through",
+			Aliases: []string{"proposeflagchange"},
+			Args:    cobra.ExactArgs(3),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				// Unpack CLI arguments
+				fromAddrHex := args[0]
+				key := args[1]
+				value := args[2]
+
 				pbValue, err := anypb.New(wrapperspb.String(value))
 				if err != nil {
 					return err
 				}
 
-				msg := &types.MessageChangeParameter{
-					Signer:         pk.Address(),
-					Owner:          pk.Address(),
-					ParameterKey:   key,
-					ParameterValue: pbValue,
+				return submitProposal(cmd, fromAddrHex, &types.MessageSubmitProposal{Content: &types.MessageSubmitProposal_FlagChange{
+					FlagChange: &coreTypes.FlagChangeProposal{
+						FlagKey:   key,
+						FlagValue: pbValue,
+						Enabled:   flagEnabled,
+					},
+				}})
+			},
+		},
+		{
+			Use:     "ProposeUpgrade <proposer> <version> <height> --title [--description]",
+			Short:   "ProposeUpgrade <proposer> <version> <height> --title [--description]",
+			Long:    "Submits a proposal to upgrade the protocol to <version> at <height>",
+			Aliases: []string{"proposeupgrade"},
+			Args:    cobra.ExactArgs(3),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				// Unpack CLI arguments
+				fromAddrHex := args[0]
+				version := args[1]
+				upgradeHeight, err := strconv.ParseInt(args[2], 10, 64)
+				if err != nil {
+					return newCLIError(errCodeInvalidArgument, err)
 				}
 
-				tx, err := prepareTxBytes(msg, pk)
+				return submitProposal(cmd, fromAddrHex, &types.MessageSubmitProposal{Content: &types.MessageSubmitProposal_Upgrade{
+					Upgrade: &coreTypes.UpgradeProposal{
+						Version: version,
+						Height:  upgradeHeight,
+					},
+				}})
+			},
+		},
+	}
+	cmds[1].Flags().BoolVar(&flagEnabled, "enabled", true, "whether the feature flag is enabled once the proposal passes")
+	return cmds
+}
+
+func govQueryCommands() []*cobra.Command {
+	cmds := []*cobra.Command{
+		{
+			Use:     "Proposal <proposal_id> [--height] [--block_hash] [--timestamp]",
+			Short:   "Get a governance proposal and its votes",
+			Long:    "Queries the node RPC to obtain the proposal with <proposal_id> and the votes cast on it at the given (or latest if unspecified) height",
+			Aliases: []string{"proposal"},
+			Args:    cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				proposalID, err := strconv.ParseInt(args[0], 10, 64)
+				if err != nil {
+					return newCLIError(errCodeInvalidArgument, err)
+				}
+
+				client, err := rpc.NewClientWithResponses(flags.RemoteCLIURL)
 				if err != nil {
 					return err
 				}
 
-				resp, err := postRawTx(cmd.Context(), pk, tx)
+				body := rpc.QueryProposal{
+					Id:        proposalID,
+					Height:    height,
+					BlockHash: optionalBlockHash(),
+					Timestamp: timestamp.value,
+				}
+
+				response, err := client.PostV1QueryProposalWithResponse(cmd.Context(), body)
+				if err != nil {
+					return unableToConnectToRpc(err)
+				}
+				return renderRPCResponse(cmd, response.HTTPResponse, response.Body, response.JSON200)
+			},
+		},
+		{
+			Use:     "Proposals [--height] [--block_hash] [--timestamp] [--status]",
+			Short:   "Get the governance proposals",
+			Long:    "Queries the node RPC to obtain the governance proposals at the given (or latest if unspecified) height, optionally filtered by status",
+			Aliases: []string{"proposals"},
+			Args:    cobra.ExactArgs(0),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				client, err := rpc.NewClientWithResponses(flags.RemoteCLIURL)
 				if err != nil {
 					return err
 				}
-				// DISCUSS(#310): define UX for return values - should we return the raw response or a parsed/human readable response? For now, I am simply printing to stdout
-				fmt.Printf("HTTP status code: %d\n", resp.StatusCode())
-				fmt.Println(string(resp.Body))
 
-				return nil
+				body := rpc.QueryProposals{
+					Height:    height,
+					BlockHash: optionalBlockHash(),
+					Timestamp: timestamp.value,
+				}
+				if proposalStatus != "" {
+					status := rpc.ProposalStatusEnum(proposalStatus)
+					body.Status = &status
+				}
+
+				response, err := client.PostV1QueryProposalsWithResponse(cmd.Context(), body)
+				if err != nil {
+					return unableToConnectToRpc(err)
+				}
+				return renderRPCResponse(cmd, response.HTTPResponse, response.Body, response.JSON200)
 			},
 		},
 	}
+	cmds[1].Flags().StringVar(&proposalStatus, "status", "", "only return proposals with this status: voting, passed, rejected or failed")
+	applySubcommandOptions(cmds[1:], attachColumnsFlagToSubcommands())
 	return cmds
 }
+
+func attachProposalFlagsToSubcommands() []cmdOption {
+	return []cmdOption{func(c *cobra.Command) {
+		c.Flags().StringVar(&proposalTitle, "title", "", "title of the proposal")
+		c.Flags().StringVar(&proposalDescription, "description", "", "description of the proposal")
+	}}
+}
+
+// submitProposal submits the `MessageSubmitProposal` signed by the proposer with the title and description flags
+func submitProposal(cmd *cobra.Command, fromAddrHex string, msg *types.MessageSubmitProposal) error {
+	if proposalTitle == "" {
+		return newCLIError(errCodeInvalidArgument, fmt.Errorf("the proposal requires a --title"))
+	}
+
+	signer, err := getTxSigner(fromAddrHex)
+	if err != nil {
+		return err
+	}
+
+	msg.Proposer = signer.address
+	msg.Title = proposalTitle
+	msg.Description = proposalDescription
+
+	return submitTx(cmd, signer, msg)
+}
+
+// paramValueToAny wraps the value of the parameter with <key> in the protobuf wrapper of its type in the genesis
+// so the proposal can be applied as is, i.e. an integer for the numerical parameters and a string for the others
+func paramValueToAny(key, value string) (*anypb.Any, error) {
+	metadata, ok := utils.GovParamMetadataMap[key]
+	if !ok {
+		return nil, coreTypes.ErrUnknownParam(key)
+	}
+	field, ok := reflect.TypeOf(genesis.Params{}).FieldByName(metadata.PropertyName)
+	if !ok {
+		return nil, coreTypes.ErrUnknownParam(key)
+	}
+
+	var pbValue proto.Message
+	switch field.Type.Kind() {
+	case reflect.Int32:
+		i, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		pbValue = wrapperspb.Int32(int32(i))
+	case reflect.String:
+		pbValue = wrapperspb.String(value)
+	default:
+		return nil, fmt.Errorf("unsupported type %s of parameter %s", field.Type, key)
+	}
+	return anypb.New(pbValue)
+}
+
+func parseVoteOption(option string) (coreTypes.VoteOption, error) {
+	switch strings.ToLower(option) {
+	case "yes":
+		return coreTypes.VoteOption_VOTE_OPTION_YES, nil
+	case "no":
+		return coreTypes.VoteOption_VOTE_OPTION_NO, nil
+	case "abstain":
+		return coreTypes.VoteOption_VOTE_OPTION_ABSTAIN, nil
+	default:
+		return coreTypes.VoteOption_VOTE_OPTION_UNSPECIFIED, fmt.Errorf("invalid vote option %q, options are: yes, no, abstain", option)
+	}
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"bytes"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -10,9 +11,9 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/pokt-network/pocket/app/client/cli/flags"
-	"github.com/pokt-network/pocket/shared/codec"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/crypto/bip39"
 	"github.com/pokt-network/pocket/shared/utils"
 )
 
@@ -26,6 +27,12 @@ var (
 	storeChild bool
 	childPwd   string
 	childHint  string
+
+	mnemonicWords int
+	mnemonicPwd   string
+	mnemonicIndex uint32
+
+	multisigPath string
 )
 
 func init() {
@@ -48,7 +55,9 @@ func NewKeysCommand() *cobra.Command {
 	cmd.AddCommand(keysImportCommands()...)
 	cmd.AddCommand(keysSignMsgCommands()...)
 	cmd.AddCommand(keysSignTxCommands()...)
+	cmd.AddCommand(keysMultisigCommands()...)
 	cmd.AddCommand(keysSlipCommands()...)
+	cmd.AddCommand(keysMnemonicCommands()...)
 
 	return cmd
 }
@@ -81,9 +90,7 @@ func keysCreateCommands() []*cobra.Command {
 					return err
 				}
 
-				fmt.Printf("New key created 🔐: %s\n", kp.GetAddressString())
-
-				return nil
+				return render(cmd, newKeyResult(kp))
 			},
 		},
 	}
@@ -129,9 +136,7 @@ func keysUpdateCommands() []*cobra.Command {
 					return err
 				}
 
-				fmt.Printf("Key updated 🔐: %s\n", addrHex)
-
-				return nil
+				return render(cmd, keyResult{Address: addrHex})
 			},
 		},
 	}
@@ -176,9 +181,7 @@ func keysDeleteCommands() []*cobra.Command {
 					return err
 				}
 
-				fmt.Printf("Key deleted ❌: %s\n", addrHex)
-
-				return nil
+				return render(cmd, keyResult{Address: addrHex})
 			},
 		},
 	}
@@ -206,7 +209,7 @@ func keysGetCommands() []*cobra.Command {
 					return err
 				}
 
-				addresses, _, err := kb.GetAll()
+				_, keyPairs, err := kb.GetAll()
 				if err != nil {
 					return err
 				}
@@ -215,12 +218,12 @@ func keysGetCommands() []*cobra.Command {
 					return err
 				}
 
-				fmt.Println("All keys 🔑")
-				for _, addr := range addresses {
-					fmt.Println(addr)
+				keys := make([]keyResult, 0, len(keyPairs))
+				for _, kp := range keyPairs {
+					keys = append(keys, newKeyResult(kp))
 				}
 
-				return nil
+				return render(cmd, keysListResult{Keys: keys})
 			},
 		},
 		{
@@ -247,14 +250,13 @@ func keysGetCommands() []*cobra.Command {
 					return err
 				}
 
-				fmt.Println("Key details 🕵️")
-				fmt.Printf("Address: %s\nPublic Key: %s\n", addrHex, kp.GetPublicKey().String())
-
-				return nil
+				return render(cmd, newKeyResult(kp))
 			},
 		},
 	}
 
+	// Add --columns flag to List
+	applySubcommandOptions(cmds[:1], attachColumnsFlagToSubcommands())
 	// Add --keybase flag
 	applySubcommandOptions(cmds, attachKeybaseFlagsToSubcommands())
 
@@ -305,13 +307,14 @@ func keysExportCommands() []*cobra.Command {
 
 				// Write to stdout or file
 				if outputFile == "" {
-					fmt.Printf("Private Key 🔒: %s\n", exportString)
-					return nil
+					return render(cmd, exportResult{Address: addrHex, PrivateKey: exportString})
 				}
 
-				fmt.Println("Writing private key to file ⏳")
+				if err := utils.WriteOutput(exportString, outputFile); err != nil {
+					return err
+				}
 
-				return utils.WriteOutput(exportString, outputFile)
+				return render(cmd, exportResult{Address: addrHex, File: outputFile})
 			},
 		},
 	}
@@ -384,9 +387,7 @@ func keysImportCommands() []*cobra.Command {
 					return err
 				}
 
-				fmt.Printf("Key imported 📥: %s\n", kp.GetAddressString())
-
-				return nil
+				return render(cmd, newKeyResult(kp))
 			},
 		},
 	}
@@ -438,11 +439,7 @@ func keysSignMsgCommands() []*cobra.Command {
 					return err
 				}
 
-				sigHex := hex.EncodeToString(sigBz)
-
-				fmt.Printf("Message signed 🔏\nSignature: %s\n", sigHex)
-
-				return nil
+				return render(cmd, signatureResult{Address: addrHex, Signature: hex.EncodeToString(sigBz)})
 			},
 		},
 		{
@@ -479,14 +476,7 @@ func keysSignMsgCommands() []*cobra.Command {
 					return err
 				}
 
-				if !valid {
-					fmt.Println("Signature is not valid ❌")
-					return nil
-				}
-
-				fmt.Println("Signature is valid ✅")
-
-				return nil
+				return render(cmd, verifyResult{Valid: valid})
 			},
 		},
 	}
@@ -502,9 +492,9 @@ func keysSignMsgCommands() []*cobra.Command {
 func keysSignTxCommands() []*cobra.Command {
 	cmds := []*cobra.Command{
 		{
-			Use:     "SignTx <addrHex> [--input_file] [--output_file]",
+			Use:     "SignTx <addrHex> [--input_file] [--output_file] [--multisig]",
 			Short:   "Signs a transaction using the key provided",
-			Long:    "Signs [--input_file] with <addrHex> from the keybase, writing the signed transaction to [--output_file]",
+			Long:    "Signs [--input_file], e.g. the unsigned transaction written by --generate-only, with <addrHex> from the keybase, writing the signed transaction to [--output_file] in the format it was read in. With [--multisig], the signature is added to the partial signatures of the multisig defined in the file created by `Keys CreateMultisig`, which can be combined with `Keys CombineTx`",
 			Aliases: []string{"signtx"},
 			Args:    cobra.ExactArgs(1),
 			RunE: func(cmd *cobra.Command, args []string) error {
@@ -522,65 +512,77 @@ func keysSignTxCommands() []*cobra.Command {
 					return err
 				}
 
-				if !flags.NonInteractive {
-					pwd = readPassphrase(pwd)
-				}
+				readKeybasePassphrase()
 
-				privKey, err := kb.GetPrivKey(addrHex, pwd)
+				pubKey, err := kb.GetPubKey(addrHex)
 				if err != nil {
 					return err
 				}
 
-				if err := kb.Stop(); err != nil {
+				// Unmarshal Tx from input file, which is either the JSON written by --generate-only or protobuf bytes
+				txProto, isJSON, err := readTx(inputFile)
+				if err != nil {
 					return err
 				}
 
-				// Unmarshal Tx from input file
-				txBz, err := utils.ReadInput(inputFile)
+				// Sign the serialised transaction through the keybase, which may be an external signer
+				txSigBz, err := txProto.SignableBytes()
 				if err != nil {
 					return err
 				}
-				txProto := new(coreTypes.Transaction)
-				if err := codec.GetCodec().Unmarshal(txBz, txProto); err != nil {
-					return err
-				}
 
-				// Sign the serialised transaction
-				txSigBz, err := txProto.SignableBytes()
+				sigBz, err := kb.Sign(addrHex, pwd, txSigBz)
 				if err != nil {
 					return err
 				}
 
-				sigBz, err := privKey.Sign(txSigBz)
-				if err != nil {
+				if err := kb.Stop(); err != nil {
 					return err
 				}
 
-				// Add signature to the transaction
-				sig := new(coreTypes.Signature)
-				sig.PublicKey = privKey.PublicKey().Bytes()
-				sig.Signature = sigBz
-				txProto.Signature = sig
+				sig := &coreTypes.Signature{
+					PublicKey: pubKey.Bytes(),
+					Signature: sigBz,
+				}
 
-				// Re-serealise the transaction and write to output_file
-				txBz, err = codec.GetCodec().Marshal(txProto)
-				if err != nil {
-					return err
+				// Add a partial signature to the transaction if it is signed by a multisig
+				if multisigPath != "" {
+					multisigKey, err := readMultisigFile(multisigPath)
+					if err != nil {
+						return err
+					}
+					if err := txProto.AddMultisigSignature(multisigKey, sig); err != nil {
+						return err
+					}
+
+					if err := writeTx(txProto, outputFile, isJSON); err != nil {
+						return err
+					}
+
+					return render(cmd, txFileResult{
+						SignerAddr:   addrHex,
+						MultisigAddr: multisigAddress(multisigKey),
+						Signatures:   len(txProto.MultiSignature.GetSignatures()),
+						Threshold:    multisigKey.GetThreshold(),
+						File:         outputFile,
+					})
 				}
 
-				if err := utils.WriteOutput(txBz, outputFile); err != nil {
+				// Add signature to the transaction
+				txProto.Signature = sig
+
+				// Re-serealise the transaction in the format it was read in and write to output_file
+				if err := writeTx(txProto, outputFile, isJSON); err != nil {
 					return err
 				}
 
-				fmt.Printf("Message signed 🔏\nKey Address: %s\nSignature file: %s\n", addrHex, outputFile)
-
-				return nil
+				return render(cmd, txFileResult{SignerAddr: addrHex, File: outputFile})
 			},
 		},
 		{
 			Use:     "VerifyTx <addrHex> [--input_file]",
 			Short:   "Verifies the transaction's signature is valid from the signer",
-			Long:    "Verify that [--input_file] contains a valid signature for the transaction in the file signed by <addrHex>",
+			Long:    "Verify that [--input_file] contains a valid signature for the transaction in the file signed by <addrHex>. For multi-signed transactions, the partial signature of <addrHex> and the threshold of the multisig are verified",
 			Aliases: []string{"verifytx"},
 			Args:    cobra.ExactArgs(1),
 			RunE: func(cmd *cobra.Command, args []string) error {
@@ -602,13 +604,24 @@ func keysSignTxCommands() []*cobra.Command {
 				}
 
 				// Unmarshal Tx from input file
-				txBz, err := utils.ReadInput(inputFile)
+				txProto, _, err := readTx(inputFile)
 				if err != nil {
 					return err
 				}
-				txProto := new(coreTypes.Transaction)
-				if err := codec.GetCodec().Unmarshal(txBz, txProto); err != nil {
-					return err
+
+				// Verify the partial signature of the key if the transaction is signed by a multisig
+				if multiSig := txProto.GetMultiSignature(); multiSig != nil {
+					if err := kb.Stop(); err != nil {
+						return err
+					}
+					result, err := verifyMultisigTx(txProto, pubKey)
+					if err != nil {
+						return err
+					}
+					return render(cmd, result)
+				}
+				if txProto.GetSignature() == nil {
+					return coreTypes.ErrEmptySignatureStructure()
 				}
 
 				// Extract signature and begin verification
@@ -636,14 +649,7 @@ func keysSignTxCommands() []*cobra.Command {
 					return err
 				}
 
-				if !valid {
-					fmt.Println("Signature is not valid ❌")
-					return nil
-				}
-
-				fmt.Println("Signature is valid ✅")
-
-				return nil
+				return render(cmd, verifyResult{Valid: valid})
 			},
 		},
 	}
@@ -652,12 +658,110 @@ func keysSignTxCommands() []*cobra.Command {
 	applySubcommandOptions(cmds, attachPwdFlagToSubcommands())
 	applySubcommandOptions(cmds, attachInputFlagToSubcommands())
 	applySubcommandOptions(cmds, attachOutputFlagToSubcommands())
+	// Add --multisig flag to SignTx
+	applySubcommandOptions(cmds[:1], attachMultisigFlagToSubcommands())
 	// Add --keybase flag
 	applySubcommandOptions(cmds, attachKeybaseFlagsToSubcommands())
 
 	return cmds
 }
 
+func keysMultisigCommands() []*cobra.Command {
+	cmds := []*cobra.Command{
+		{
+			Use:     "CreateMultisig <threshold> <pubKeyHex>... [--output_file]",
+			Short:   "Create a multisig from the public keys of its members",
+			Long:    "Creates the multisig requiring <threshold> signatures of the <pubKeyHex> members and writes it, along with its address, to [--output_file] or stdout. The file is used by `Keys SignTx --multisig` to sign transactions on behalf of the multisig",
+			Aliases: []string{"createmultisig"},
+			Args:    cobra.MinimumNArgs(2),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				// Unpack CLI args
+				threshold, err := strconv.ParseUint(args[0], 10, 32)
+				if err != nil {
+					return err
+				}
+				publicKeys := make([]crypto.PublicKey, 0, len(args)-1)
+				for _, pubKeyHex := range args[1:] {
+					publicKey, err := crypto.NewPublicKey(pubKeyHex)
+					if err != nil {
+						return err
+					}
+					publicKeys = append(publicKeys, publicKey)
+				}
+
+				multisigKey, err := crypto.NewMultisigPublicKey(uint32(threshold), publicKeys)
+				if err != nil {
+					return err
+				}
+
+				multisig := newMultisigFile(multisigKey)
+				if outputFile == "" {
+					return render(cmd, multisig)
+				}
+
+				multisigBz, err := json.MarshalIndent(multisig, "", "  ")
+				if err != nil {
+					return err
+				}
+				if err := utils.WriteOutput(multisigBz, outputFile); err != nil {
+					return err
+				}
+
+				return render(cmd, multisigFileResult{multisigFile: multisig, File: outputFile})
+			},
+		},
+		{
+			Use:     "CombineTx <txFile>... [--output_file]",
+			Short:   "Combine the partial signatures of a multi-signed transaction",
+			Long:    "Combines the partial signatures of the copies of the same transaction in the <txFile> files, each signed by members of the multisig with `Keys SignTx --multisig`, and writes the resulting transaction to [--output_file]",
+			Aliases: []string{"combinetx"},
+			Args:    cobra.MinimumNArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				if outputFile == "" {
+					return fmt.Errorf("no output file provided")
+				}
+
+				var txProto *coreTypes.Transaction
+				var isJSON bool
+				for _, txFile := range args {
+					tx, txIsJSON, err := readTx(txFile)
+					if err != nil {
+						return err
+					}
+					if txProto == nil {
+						if tx.GetMultiSignature() == nil {
+							return fmt.Errorf("%s is not signed by a multisig", txFile)
+						}
+						txProto, isJSON = tx, txIsJSON
+						continue
+					}
+					if err := txProto.CombineMultiSignature(tx); err != nil {
+						return fmt.Errorf("combining %s: %w", txFile, err)
+					}
+				}
+
+				// The combined transaction is written in the format of the first transaction file
+				if err := writeTx(txProto, outputFile, isJSON); err != nil {
+					return err
+				}
+
+				multisigKey := txProto.MultiSignature.GetPublicKey()
+				return render(cmd, txFileResult{
+					MultisigAddr: multisigAddress(multisigKey),
+					Signatures:   len(txProto.MultiSignature.GetSignatures()),
+					Threshold:    multisigKey.GetThreshold(),
+					File:         outputFile,
+				})
+			},
+		},
+	}
+
+	// Add --output_file flag
+	applySubcommandOptions(cmds, attachOutputFlagToSubcommands())
+
+	return cmds
+}
+
 func keysSlipCommands() []*cobra.Command {
 	cmds := []*cobra.Command{
 		{
@@ -693,12 +797,7 @@ func keysSlipCommands() []*cobra.Command {
 					return err
 				}
 
-				fmt.Printf("Child key created 🚸\nChild Address: %s\nParent Address: %s\n", kp.GetAddressString(), parentAddr)
-				if storeChild {
-					fmt.Println("Child key stored in the Keybase 🔐")
-				}
-
-				return nil
+				return render(cmd, childKeyResult{keyResult: newKeyResult(kp), ParentAddr: parentAddr, Stored: storeChild})
 			},
 		},
 	}
@@ -713,3 +812,228 @@ func keysSlipCommands() []*cobra.Command {
 
 	return cmds
 }
+
+func keysMnemonicCommands() []*cobra.Command {
+	cmds := []*cobra.Command{
+		{
+			Use:     "CreateFromMnemonic [--mnemonic_words] [--mnemonic_pwd] [--index]",
+			Short:   "Create new key from a new BIP-39 mnemonic",
+			Long:    "Generates a new BIP-39 mnemonic of [--mnemonic_words] words and stores the key derived from it at [--index] in the keybase, the mnemonic is printed once and must be kept safe to recover the key",
+			Aliases: []string{"createfrommnemonic"},
+			Args:    cobra.ExactArgs(0),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				kb, err := keybaseForCLI()
+				if err != nil {
+					return err
+				}
+
+				if !flags.NonInteractive {
+					pwd = readPassphrase(pwd)
+					confirmPassphrase(pwd)
+				}
+
+				mnemonic, kp, err := kb.CreateFromMnemonic(mnemonicWords, mnemonicPwd, mnemonicIndex, pwd, hint)
+				if err != nil {
+					return err
+				}
+
+				if err := kb.Stop(); err != nil {
+					return err
+				}
+
+				fmt.Fprintln(cmd.ErrOrStderr(), "⚠️  Write down the mnemonic and keep it safe: it is the only way to recover the key along with the mnemonic passphrase, if any ⚠️")
+
+				return render(cmd, mnemonicKeyResult{keyResult: newKeyResult(kp), Mnemonic: mnemonic})
+			},
+		},
+		{
+			Use:     "RecoverFromMnemonic [mnemonic] [--input_file] [--mnemonic_pwd] [--index]",
+			Short:   "Recover a key from a BIP-39 mnemonic",
+			Long:    "Stores the key derived at [--index] from the BIP-39 [mnemonic], read from [--input_file] or prompted for, in the keybase",
+			Aliases: []string{"recoverfrommnemonic"},
+			Args:    cobra.MaximumNArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				var mnemonic string
+				switch {
+				case len(args) == 1:
+					mnemonic = args[0]
+				case inputFile != "":
+					mnemonicBz, err := utils.ReadInput(inputFile)
+					if err != nil {
+						return err
+					}
+					mnemonic = string(mnemonicBz)
+				case !flags.NonInteractive:
+					mnemonic = readPassphraseMessage("", "Enter mnemonic: ")
+				default:
+					return fmt.Errorf("no mnemonic or input file provided")
+				}
+
+				kb, err := keybaseForCLI()
+				if err != nil {
+					return err
+				}
+
+				if !flags.NonInteractive {
+					pwd = readPassphrase(pwd)
+					confirmPassphrase(pwd)
+				}
+
+				kp, err := kb.RecoverFromMnemonic(mnemonic, mnemonicPwd, mnemonicIndex, pwd, hint)
+				if err != nil {
+					return err
+				}
+
+				if err := kb.Stop(); err != nil {
+					return err
+				}
+
+				return render(cmd, newKeyResult(kp))
+			},
+		},
+	}
+
+	// Add --pwd, --hint, --mnemonic_pwd and --index flags
+	applySubcommandOptions(cmds, attachPwdFlagToSubcommands())
+	applySubcommandOptions(cmds, attachHintFlagToSubcommands())
+	applySubcommandOptions(cmds, attachMnemonicFlagsToSubcommands())
+	// Add --mnemonic_words to CreateFromMnemonic and --input_file to RecoverFromMnemonic
+	cmds[0].Flags().IntVar(&mnemonicWords, "mnemonic_words", bip39.DefaultMnemonicWords, "number of words of the mnemonic: 12, 15, 18, 21 or 24")
+	applySubcommandOptions(cmds[1:], attachInputFlagToSubcommands())
+	// Add --keybase flag
+	applySubcommandOptions(cmds, attachKeybaseFlagsToSubcommands())
+
+	return cmds
+}
+
+// keyResult is the result of the commands on a key of the keybase
+type keyResult struct {
+	Address   string `json:"address"`
+	PublicKey string `json:"public_key,omitempty"`
+}
+
+func newKeyResult(kp crypto.KeyPair) keyResult {
+	return keyResult{Address: kp.GetAddressString(), PublicKey: kp.GetPublicKey().String()}
+}
+
+type keysListResult struct {
+	Keys []keyResult `json:"keys"`
+}
+
+// exportResult is the output of `Keys Export`, the private key is either in the result or written to the file
+type exportResult struct {
+	Address    string `json:"address"`
+	PrivateKey string `json:"private_key,omitempty"`
+	File       string `json:"file,omitempty"`
+}
+
+type signatureResult struct {
+	Address   string `json:"address"`
+	Signature string `json:"signature"`
+}
+
+// verifyResult is the output of `Keys Verify` and `Keys VerifyTx`, the multisig fields are only set for multi-signed transactions
+type verifyResult struct {
+	Valid        bool   `json:"valid"`
+	MultisigAddr string `json:"multisig_addr,omitempty"`
+	ThresholdMet *bool  `json:"threshold_met,omitempty"`
+}
+
+type childKeyResult struct {
+	keyResult
+	ParentAddr string `json:"parent_addr"`
+	Stored     bool   `json:"stored"`
+}
+
+type mnemonicKeyResult struct {
+	keyResult
+	Mnemonic string `json:"mnemonic"`
+}
+
+// multisigFileResult is the output of `Keys CreateMultisig` once the multisig is written to the file
+type multisigFileResult struct {
+	multisigFile
+	File string `json:"file"`
+}
+
+// multisigFile is the JSON representation of a multisig written by `Keys CreateMultisig` and read by `Keys SignTx --multisig`
+type multisigFile struct {
+	Address    string   `json:"address"`
+	Threshold  uint32   `json:"threshold"`
+	PublicKeys []string `json:"public_keys"`
+}
+
+func newMultisigFile(multisigKey *crypto.MultisigPublicKey) multisigFile {
+	publicKeys := make([]string, 0, len(multisigKey.PublicKeys))
+	for _, publicKey := range multisigKey.PublicKeys {
+		publicKeys = append(publicKeys, publicKey.String())
+	}
+	return multisigFile{
+		Address:    multisigKey.Address().String(),
+		Threshold:  multisigKey.Threshold,
+		PublicKeys: publicKeys,
+	}
+}
+
+// readMultisigFile reads the multisig written by `Keys CreateMultisig`, checking that its address matches its members and threshold
+func readMultisigFile(path string) (*coreTypes.MultisigPublicKey, error) {
+	multisigBz, err := utils.ReadInput(path)
+	if err != nil {
+		return nil, err
+	}
+	var file multisigFile
+	if err := json.Unmarshal(multisigBz, &file); err != nil {
+		return nil, err
+	}
+
+	publicKeys := make([]crypto.PublicKey, 0, len(file.PublicKeys))
+	for _, pubKeyHex := range file.PublicKeys {
+		publicKey, err := crypto.NewPublicKey(pubKeyHex)
+		if err != nil {
+			return nil, err
+		}
+		publicKeys = append(publicKeys, publicKey)
+	}
+	multisigKey, err := crypto.NewMultisigPublicKey(file.Threshold, publicKeys)
+	if err != nil {
+		return nil, err
+	}
+	if address := multisigKey.Address().String(); address != file.Address {
+		return nil, fmt.Errorf("the multisig address %s does not match its public keys and threshold, expected %s", file.Address, address)
+	}
+	return coreTypes.NewMultisigPublicKey(multisigKey), nil
+}
+
+// multisigAddress returns the address of the multisig, or an empty string if the multisig is not valid
+func multisigAddress(multisigKey *coreTypes.MultisigPublicKey) string {
+	key, err := multisigKey.ToCrypto()
+	if err != nil {
+		return ""
+	}
+	return key.Address().String()
+}
+
+// verifyMultisigTx verifies the partial signature of a member of the multisig of the transaction and
+// whether the transaction has enough valid signatures to be submitted
+func verifyMultisigTx(txProto *coreTypes.Transaction, pubKey crypto.PublicKey) (verifyResult, error) {
+	txSigBz, err := txProto.SignableBytes()
+	if err != nil {
+		return verifyResult{}, err
+	}
+
+	multiSig := txProto.GetMultiSignature()
+	valid := false
+	for _, sig := range multiSig.GetSignatures() {
+		if bytes.Equal(sig.GetPublicKey(), pubKey.Bytes()) {
+			valid = pubKey.Verify(txSigBz, sig.GetSignature())
+			break
+		}
+	}
+
+	thresholdMet := txProto.ValidateBasic() == nil
+	return verifyResult{
+		Valid:        valid,
+		MultisigAddr: multisigAddress(multiSig.GetPublicKey()),
+		ThresholdMet: &thresholdMet,
+	}, nil
+}
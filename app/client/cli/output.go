@@ -0,0 +1,398 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"sigs.k8s.io/yaml"
+
+	"github.com/pokt-network/pocket/app/client/cli/flags"
+)
+
+const (
+	outputFormatJSON  = "json"
+	outputFormatYAML  = "yaml"
+	outputFormatTable = "table"
+)
+
+// The codes of the errors rendered to stderr are stable: automation may rely on them and on the exit status they map to
+const (
+	errCodeCommandFailed   = "command_failed"
+	errCodeInvalidArgument = "invalid_argument"
+	errCodeRPCUnreachable  = "rpc_unreachable"
+	errCodeRPCError        = "rpc_error"
+	errCodeTxFailed        = "tx_failed"
+)
+
+var (
+	errExitStatuses = map[string]int{
+		errCodeCommandFailed:   1,
+		errCodeInvalidArgument: 2,
+		errCodeRPCUnreachable:  3,
+		errCodeRPCError:        4,
+		errCodeTxFailed:        5,
+	}
+
+	errInvalidOutputFormat = errors.New("invalid output format")
+	errUnknownColumn       = errors.New("unknown column")
+
+	// columns selects the columns of the table rendering of list queries, see `attachColumnsFlagToSubcommands`
+	columns []string
+)
+
+// cliError is an error returned by a command along with its stable code
+type cliError struct {
+	code string
+	err  error
+}
+
+func newCLIError(code string, err error) error {
+	return &cliError{code: code, err: err}
+}
+
+func (e *cliError) Error() string {
+	return e.err.Error()
+}
+
+func (e *cliError) Unwrap() error {
+	return e.err
+}
+
+// errorCode returns the code of the error returned by a command, errors without a code have the `command_failed` code
+func errorCode(err error) string {
+	var cliErr *cliError
+	if errors.As(err, &cliErr) {
+		return cliErr.code
+	}
+	return errCodeCommandFailed
+}
+
+// ExitCode returns the exit status of the CLI for the error returned by a command
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	return errExitStatuses[errorCode(err)]
+}
+
+// errorResult is the rendering of the error returned by a command
+type errorResult struct {
+	Error errorDetails `json:"error"`
+}
+
+type errorDetails struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// validateOutputFormat returns an `invalid_argument` error if [--output] is not a supported format
+func validateOutputFormat() error {
+	switch flags.OutputFormat {
+	case outputFormatJSON, outputFormatYAML, outputFormatTable:
+		return nil
+	default:
+		return newCLIError(errCodeInvalidArgument, fmt.Errorf("%w: %q, options are: %s, %s, %s",
+			errInvalidOutputFormat, flags.OutputFormat, outputFormatJSON, outputFormatYAML, outputFormatTable))
+	}
+}
+
+// render writes the typed result of a command to stdout in the [--output] format.
+//   - json: a single line of JSON, protobuf messages are serialized with their protobuf JSON representation
+//   - yaml: the YAML equivalent of the JSON
+//   - table: one row per item of the result of list queries, or one row per field of other results
+func render(cmd *cobra.Command, result any) error {
+	resultJSON, err := resultToJSON(result)
+	if err != nil {
+		return err
+	}
+	return renderJSON(cmd.OutOrStdout(), result, resultJSON)
+}
+
+// renderError writes the error returned by a command along with its code to stderr in the [--output] format
+func renderError(w io.Writer, err error) {
+	if validateOutputFormat() != nil {
+		flags.OutputFormat = outputFormatJSON
+	}
+	if flags.OutputFormat == outputFormatTable {
+		fmt.Fprintf(w, "❌ Error (%s): %s\n", errorCode(err), err.Error())
+		return
+	}
+
+	result := errorResult{Error: errorDetails{Code: errorCode(err), Message: err.Error()}}
+	resultJSON, _ := json.Marshal(result)
+	_ = renderJSON(w, result, resultJSON)
+}
+
+// renderRPCResponse renders the typed response of a successful RPC call, or returns an `rpc_error` error
+func renderRPCResponse[T any](cmd *cobra.Command, httpResp *http.Response, body []byte, result *T) error {
+	if httpResp.StatusCode != http.StatusOK {
+		return rpcResponseCodeUnhealthy(httpResp.StatusCode, body)
+	}
+	if result == nil {
+		return newCLIError(errCodeRPCError, fmt.Errorf("unexpected response from the RPC @ %s: %s", flags.RemoteCLIURL, body))
+	}
+	return render(cmd, result)
+}
+
+func resultToJSON(result any) ([]byte, error) {
+	if msg, ok := result.(proto.Message); ok {
+		msgJSON, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+		if err != nil {
+			return nil, err
+		}
+		// protojson randomizes its whitespaces
+		var compacted bytes.Buffer
+		if err := json.Compact(&compacted, msgJSON); err != nil {
+			return nil, err
+		}
+		return compacted.Bytes(), nil
+	}
+	return json.Marshal(result)
+}
+
+func renderJSON(w io.Writer, result any, resultJSON []byte) error {
+	switch flags.OutputFormat {
+	case outputFormatYAML:
+		resultYAML, err := yaml.JSONToYAML(resultJSON)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(resultYAML)
+		return err
+	case outputFormatTable:
+		return renderTable(w, result, resultJSON)
+	default:
+		_, err := fmt.Fprintln(w, string(resultJSON))
+		return err
+	}
+}
+
+// jsonField is a field of a JSON object, the fields are kept in the order they are serialized in
+type jsonField struct {
+	key   string
+	value json.RawMessage
+}
+
+func renderTable(w io.Writer, result any, resultJSON []byte) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	listKey, itemColumns, isList := listOf(reflect.TypeOf(result))
+	if !isList {
+		fields, err := decodeJSONObject(resultJSON)
+		if err != nil {
+			return err
+		}
+		fields, err = selectFields(fields)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(tw, "FIELD\tVALUE")
+		for _, field := range fields {
+			fmt.Fprintf(tw, "%s\t%s\n", field.key, tableCell(field.value))
+		}
+		return tw.Flush()
+	}
+
+	// The other fields of the result, e.g. the pagination, are rendered after the table
+	var otherFields []jsonField
+	itemsJSON := json.RawMessage(resultJSON)
+	if listKey != "" {
+		fields, err := decodeJSONObject(resultJSON)
+		if err != nil {
+			return err
+		}
+		itemsJSON = nil
+		for _, field := range fields {
+			if field.key == listKey {
+				itemsJSON = field.value
+				continue
+			}
+			otherFields = append(otherFields, field)
+		}
+	}
+
+	var items []json.RawMessage
+	if len(itemsJSON) > 0 {
+		if err := json.Unmarshal(itemsJSON, &items); err != nil {
+			return err
+		}
+	}
+
+	selected, err := selectColumns(itemColumns)
+	if err != nil {
+		return err
+	}
+	headers := make([]string, 0, len(selected))
+	for _, column := range selected {
+		headers = append(headers, strings.ToUpper(column))
+	}
+	fmt.Fprintln(tw, strings.Join(headers, "\t"))
+	for _, item := range items {
+		fields, err := decodeJSONObject(item)
+		if err != nil {
+			return err
+		}
+		values := make(map[string]json.RawMessage, len(fields))
+		for _, field := range fields {
+			values[field.key] = field.value
+		}
+		cells := make([]string, 0, len(selected))
+		for _, column := range selected {
+			cells = append(cells, tableCell(values[column]))
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if len(otherFields) > 0 {
+		fmt.Fprintln(w)
+	}
+	for _, field := range otherFields {
+		fmt.Fprintf(w, "%s: %s\n", field.key, tableCell(field.value))
+	}
+	return nil
+}
+
+// listOf returns whether a result of type `t` is rendered as a list, i.e. it is either a slice of structs or
+// a struct with a single slice of structs field, in which case the JSON key of the field is returned.
+// The columns are the JSON keys of the items.
+func listOf(t reflect.Type) (key string, columns []string, ok bool) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if itemColumns, ok := itemColumnsOf(t); ok {
+		return "", itemColumns, true
+	}
+	if t.Kind() != reflect.Struct {
+		return "", nil, false
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		itemColumns, isList := itemColumnsOf(field.Type)
+		if !field.IsExported() || !isList {
+			continue
+		}
+		if ok {
+			// Results with several lists are rendered field by field
+			return "", nil, false
+		}
+		key, columns, ok = jsonKey(field), itemColumns, true
+	}
+	return key, columns, ok
+}
+
+// itemColumnsOf returns the JSON keys of the items of `t` if it is a slice of structs
+func itemColumnsOf(t reflect.Type) ([]string, bool) {
+	if t.Kind() != reflect.Slice {
+		return nil, false
+	}
+	item := t.Elem()
+	for item.Kind() == reflect.Pointer {
+		item = item.Elem()
+	}
+	if item.Kind() != reflect.Struct {
+		return nil, false
+	}
+
+	itemColumns := make([]string, 0, item.NumField())
+	for i := 0; i < item.NumField(); i++ {
+		if field := item.Field(i); field.IsExported() && jsonKey(field) != "-" {
+			itemColumns = append(itemColumns, jsonKey(field))
+		}
+	}
+	return itemColumns, true
+}
+
+func jsonKey(field reflect.StructField) string {
+	if key, _, _ := strings.Cut(field.Tag.Get("json"), ","); key != "" {
+		return key
+	}
+	return field.Name
+}
+
+// selectColumns returns the [--columns] among the columns of the table, all of them if the flag is not set
+func selectColumns(all []string) ([]string, error) {
+	if len(columns) == 0 {
+		return all, nil
+	}
+	known := make(map[string]bool, len(all))
+	for _, column := range all {
+		known[column] = true
+	}
+	for _, column := range columns {
+		if !known[column] {
+			return nil, newCLIError(errCodeInvalidArgument, fmt.Errorf("%w: %q, options are: %s", errUnknownColumn, column, strings.Join(all, ", ")))
+		}
+	}
+	return columns, nil
+}
+
+// selectFields returns the [--columns] among the fields of a result rendered field by field
+func selectFields(fields []jsonField) ([]jsonField, error) {
+	keys := make([]string, 0, len(fields))
+	byKey := make(map[string]jsonField, len(fields))
+	for _, field := range fields {
+		keys = append(keys, field.key)
+		byKey[field.key] = field
+	}
+	selected, err := selectColumns(keys)
+	if err != nil {
+		return nil, err
+	}
+	selectedFields := make([]jsonField, 0, len(selected))
+	for _, key := range selected {
+		selectedFields = append(selectedFields, byKey[key])
+	}
+	return selectedFields, nil
+}
+
+// decodeJSONObject returns the fields of a JSON object in the order they are serialized in
+func decodeJSONObject(objectJSON []byte) ([]jsonField, error) {
+	dec := json.NewDecoder(bytes.NewReader(objectJSON))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return []jsonField{{key: "value", value: objectJSON}}, nil
+	}
+
+	var fields []jsonField
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, ok := tok.(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected JSON object key %v", tok)
+		}
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, err
+		}
+		fields = append(fields, jsonField{key: key, value: value})
+	}
+	return fields, nil
+}
+
+// tableCell returns strings unquoted, nested objects and arrays as JSON
+func tableCell(value json.RawMessage) string {
+	if len(value) == 0 || string(value) == "null" {
+		return ""
+	}
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		return s
+	}
+	return string(value)
+}
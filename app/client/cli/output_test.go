@@ -0,0 +1,184 @@
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/pocket/app/client/cli/flags"
+	"github.com/pokt-network/pocket/utility/types"
+)
+
+type testListResult struct {
+	Keys      []keyResult `json:"keys"`
+	TotalKeys int         `json:"total_keys"`
+}
+
+// renderWith renders `result` in the `format` with the `selected` columns and returns the output
+func renderWith(t *testing.T, format string, selected []string, result any) (string, error) {
+	t.Helper()
+	prevFormat, prevColumns := flags.OutputFormat, columns
+	t.Cleanup(func() { flags.OutputFormat, columns = prevFormat, prevColumns })
+	flags.OutputFormat, columns = format, selected
+
+	var out bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&out)
+	err := render(cmd, result)
+	return out.String(), err
+}
+
+func TestRender(t *testing.T) {
+	list := testListResult{
+		Keys: []keyResult{
+			{Address: "a1", PublicKey: "p1"},
+			{Address: "a2"},
+		},
+		TotalKeys: 2,
+	}
+	msg := &types.MessageSend{FromAddress: []byte{0x01}, ToAddress: []byte{0x02}, Amount: "10"}
+
+	testCases := []struct {
+		name     string
+		format   string
+		columns  []string
+		result   any
+		expected string
+	}{
+		{
+			name:     "json",
+			format:   outputFormatJSON,
+			result:   list,
+			expected: `{"keys":[{"address":"a1","public_key":"p1"},{"address":"a2"}],"total_keys":2}` + "\n",
+		},
+		{
+			name:     "json of a protobuf message",
+			format:   outputFormatJSON,
+			result:   msg,
+			expected: `{"from_address":"AQ==","to_address":"Ag==","amount":"10"}` + "\n",
+		},
+		{
+			name:   "yaml",
+			format: outputFormatYAML,
+			result: list,
+			expected: "keys:\n" +
+				"- address: a1\n" +
+				"  public_key: p1\n" +
+				"- address: a2\n" +
+				"total_keys: 2\n",
+		},
+		{
+			name:   "table of a list",
+			format: outputFormatTable,
+			result: list,
+			expected: "ADDRESS  PUBLIC_KEY\n" +
+				"a1       p1\n" +
+				"a2       \n" +
+				"\n" +
+				"total_keys: 2\n",
+		},
+		{
+			name:    "table of a list with columns",
+			format:  outputFormatTable,
+			columns: []string{"public_key", "address"},
+			result:  list.Keys,
+			expected: "PUBLIC_KEY  ADDRESS\n" +
+				"p1          a1\n" +
+				"            a2\n",
+		},
+		{
+			name:   "table of a result",
+			format: outputFormatTable,
+			result: verifyResult{Valid: true},
+			expected: "FIELD  VALUE\n" +
+				"valid  true\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := renderWith(t, tc.format, tc.columns, tc.result)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestRender_UnknownColumn(t *testing.T) {
+	_, err := renderWith(t, outputFormatTable, []string{"hint"}, keysListResult{Keys: []keyResult{{Address: "a1"}}})
+	require.ErrorIs(t, err, errUnknownColumn)
+	require.Equal(t, errCodeInvalidArgument, errorCode(err))
+	require.Equal(t, 2, ExitCode(err))
+}
+
+func TestRenderError(t *testing.T) {
+	prevFormat := flags.OutputFormat
+	t.Cleanup(func() { flags.OutputFormat = prevFormat })
+
+	err := fmt.Errorf("querying the height: %w", unableToConnectToRpc(errors.New("connection refused")))
+	require.Equal(t, errCodeRPCUnreachable, errorCode(err))
+	require.Equal(t, 3, ExitCode(err))
+
+	testCases := []struct {
+		name     string
+		format   string
+		err      error
+		expected string
+	}{
+		{
+			name:     "json",
+			format:   outputFormatJSON,
+			err:      newCLIError(errCodeTxFailed, errors.New("insufficient funds")),
+			expected: `{"error":{"code":"tx_failed","message":"insufficient funds"}}` + "\n",
+		},
+		{
+			name:     "yaml",
+			format:   outputFormatYAML,
+			err:      errors.New("keybase locked"),
+			expected: "error:\n  code: command_failed\n  message: keybase locked\n",
+		},
+		{
+			name:     "table",
+			format:   outputFormatTable,
+			err:      newCLIError(errCodeRPCError, errors.New("not found")),
+			expected: "❌ Error (rpc_error): not found\n",
+		},
+		{
+			name:     "invalid output format",
+			format:   "xml",
+			err:      validateOutputFormatOf(t, "xml"),
+			expected: `{"error":{"code":"invalid_argument","message":"invalid output format: \"xml\", options are: json, yaml, table"}}` + "\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			flags.OutputFormat = tc.format
+			var out bytes.Buffer
+			renderError(&out, tc.err)
+			require.Equal(t, tc.expected, out.String())
+		})
+	}
+}
+
+func TestExitCode(t *testing.T) {
+	require.Equal(t, 0, ExitCode(nil))
+	require.Equal(t, 1, ExitCode(errors.New("failed")))
+	require.Equal(t, 4, ExitCode(newCLIError(errCodeRPCError, errors.New("failed"))))
+	require.Equal(t, 5, ExitCode(fmt.Errorf("broadcasting: %w", newCLIError(errCodeTxFailed, errors.New("failed")))))
+}
+
+func validateOutputFormatOf(t *testing.T, format string) error {
+	t.Helper()
+	prevFormat := flags.OutputFormat
+	defer func() { flags.OutputFormat = prevFormat }()
+	flags.OutputFormat = format
+
+	err := validateOutputFormat()
+	require.ErrorIs(t, err, errInvalidOutputFormat)
+	return err
+}
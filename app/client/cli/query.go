@@ -1,11 +1,6 @@
 package cli
 
 import (
-	"fmt"
-	"io"
-	"net/http"
-	"os"
-
 	"github.com/spf13/cobra"
 
 	"github.com/pokt-network/pocket/app/client/cli/flags"
@@ -17,6 +12,14 @@ var (
 	page     int64
 	per_page int64
 	sort     string
+
+	statusFilter        string
+	chainFilter         string
+	minStakeFilter      string
+	outputAddressFilter string
+
+	blockHashFlag string
+	timestamp     timestampFlag
 )
 
 func init() {
@@ -34,6 +37,7 @@ func NewQueryCommand() *cobra.Command {
 
 	heightCmds := queryHeightCommands()
 	heightPaginatedCmds := queryHeightPaginatedCommands()
+	actorsPaginatedCmds := queryActorsPaginatedCommands()
 	heightPaginatedSortedCmds := queryHeightPaginatedSortedCommands()
 	paginatedSortedCmds := queryPaginatedSortedCommands()
 	getCmds := queryCommands()
@@ -41,19 +45,37 @@ func NewQueryCommand() *cobra.Command {
 	// attach --height flag
 	applySubcommandOptions(heightCmds, attachHeightFlagToSubcommands())
 	applySubcommandOptions(heightPaginatedCmds, attachHeightFlagToSubcommands())
+	applySubcommandOptions(actorsPaginatedCmds, attachHeightFlagToSubcommands())
 	applySubcommandOptions(heightPaginatedSortedCmds, attachHeightFlagToSubcommands())
 
+	// attach --block_hash, --timestamp flags
+	applySubcommandOptions(heightCmds, attachBlockSelectorFlagsToSubcommands())
+	applySubcommandOptions(heightPaginatedCmds, attachBlockSelectorFlagsToSubcommands())
+	applySubcommandOptions(actorsPaginatedCmds, attachBlockSelectorFlagsToSubcommands())
+	applySubcommandOptions(heightPaginatedSortedCmds, attachBlockSelectorFlagsToSubcommands())
+
 	// attach --page, --per_page flags
 	applySubcommandOptions(heightPaginatedCmds, attachPaginationFlagsToSubcommands())
+	applySubcommandOptions(actorsPaginatedCmds, attachPaginationFlagsToSubcommands())
 	applySubcommandOptions(paginatedSortedCmds, attachPaginationFlagsToSubcommands())
 	applySubcommandOptions(heightPaginatedSortedCmds, attachPaginationFlagsToSubcommands())
 
+	// attach --status, --chain, --min_stake, --output_address flags
+	applySubcommandOptions(actorsPaginatedCmds, attachActorFilterFlagsToSubcommands())
+
+	// attach --columns flag
+	applySubcommandOptions(heightPaginatedCmds, attachColumnsFlagToSubcommands())
+	applySubcommandOptions(actorsPaginatedCmds, attachColumnsFlagToSubcommands())
+	applySubcommandOptions(paginatedSortedCmds, attachColumnsFlagToSubcommands())
+	applySubcommandOptions(heightPaginatedSortedCmds, attachColumnsFlagToSubcommands())
+
 	// attach --sort flag
 	applySubcommandOptions(paginatedSortedCmds, attachSortFlagToSubcommands())
 	applySubcommandOptions(heightPaginatedSortedCmds, attachSortFlagToSubcommands())
 
 	cmd.AddCommand(heightCmds...)
 	cmd.AddCommand(heightPaginatedCmds...)
+	cmd.AddCommand(actorsPaginatedCmds...)
 	cmd.AddCommand(heightPaginatedSortedCmds...)
 	cmd.AddCommand(paginatedSortedCmds...)
 	cmd.AddCommand(getCmds...)
@@ -64,7 +86,7 @@ func NewQueryCommand() *cobra.Command {
 func queryHeightCommands() []*cobra.Command {
 	cmds := []*cobra.Command{
 		{
-			Use:     "Account <address> [--height]",
+			Use:     "Account <address> [--height] [--block_hash] [--timestamp]",
 			Short:   "Get the account data of an address",
 			Long:    "Queries the node RPC to obtain the account data of the speicifed account at the given (or latest if unspecified) height",
 			Args:    cobra.ExactArgs(1),
@@ -76,30 +98,21 @@ func queryHeightCommands() []*cobra.Command {
 				}
 
 				body := rpc.QueryAccountHeight{
-					Address: args[0],
-					Height:  height,
+					Address:   args[0],
+					Height:    height,
+					BlockHash: optionalBlockHash(),
+					Timestamp: timestamp.value,
 				}
 
-				response, err := client.PostV1QueryAccount(cmd.Context(), body)
+				response, err := client.PostV1QueryAccountWithResponse(cmd.Context(), body)
 				if err != nil {
 					return unableToConnectToRpc(err)
 				}
-				statusCode := response.StatusCode
-				resp, err := io.ReadAll(response.Body)
-				if err != nil {
-					fmt.Fprintf(os.Stderr, "❌ Error reading response body: %s\n", err.Error())
-					return err
-				}
-				if statusCode == http.StatusOK {
-					fmt.Println(string(resp))
-					return nil
-				}
-
-				return rpcResponseCodeUnhealthy(statusCode, resp)
+				return renderRPCResponse(cmd, response.HTTPResponse, response.Body, response.JSON200)
 			},
 		},
 		{
-			Use:     "App <address> [--height]",
+			Use:     "App <address> [--height] [--block_hash] [--timestamp]",
 			Short:   "Get the app data of an address",
 			Long:    "Queries the node RPC to obtain the app data of the speicifed address at the given (or latest if unspecified) height",
 			Args:    cobra.ExactArgs(1),
@@ -111,30 +124,21 @@ func queryHeightCommands() []*cobra.Command {
 				}
 
 				body := rpc.QueryAccountHeight{
-					Address: args[0],
-					Height:  height,
+					Address:   args[0],
+					Height:    height,
+					BlockHash: optionalBlockHash(),
+					Timestamp: timestamp.value,
 				}
 
-				response, err := client.PostV1QueryApp(cmd.Context(), body)
+				response, err := client.PostV1QueryAppWithResponse(cmd.Context(), body)
 				if err != nil {
 					return unableToConnectToRpc(err)
 				}
-				statusCode := response.StatusCode
-				resp, err := io.ReadAll(response.Body)
-				if err != nil {
-					fmt.Fprintf(os.Stderr, "❌ Error reading response body: %s\n", err.Error())
-					return err
-				}
-				if statusCode == http.StatusOK {
-					fmt.Println(string(resp))
-					return nil
-				}
-
-				return rpcResponseCodeUnhealthy(statusCode, resp)
+				return renderRPCResponse(cmd, response.HTTPResponse, response.Body, response.JSON200)
 			},
 		},
 		{
-			Use:     "Balance <address> [--height]",
+			Use:     "Balance <address> [--height] [--block_hash] [--timestamp]",
 			Short:   "Get the balance of an address",
 			Long:    "Queries the node RPC to obtain the balance of the account at the given (or latest if unspecified) height",
 			Args:    cobra.ExactArgs(1),
@@ -146,30 +150,47 @@ func queryHeightCommands() []*cobra.Command {
 				}
 
 				body := rpc.QueryAccountHeight{
-					Address: args[0],
-					Height:  height,
+					Address:   args[0],
+					Height:    height,
+					BlockHash: optionalBlockHash(),
+					Timestamp: timestamp.value,
 				}
 
-				response, err := client.PostV1QueryBalance(cmd.Context(), body)
+				response, err := client.PostV1QueryBalanceWithResponse(cmd.Context(), body)
 				if err != nil {
 					return unableToConnectToRpc(err)
 				}
-				statusCode := response.StatusCode
-				resp, err := io.ReadAll(response.Body)
+				return renderRPCResponse(cmd, response.HTTPResponse, response.Body, response.JSON200)
+			},
+		},
+		{
+			Use:     "Delegations <address> [--height] [--block_hash] [--timestamp]",
+			Short:   "Get the delegations of an address",
+			Long:    "Queries the node RPC to obtain the stake delegated by the account and the stake it is unbonding at the given (or latest if unspecified) height",
+			Args:    cobra.ExactArgs(1),
+			Aliases: []string{"delegations"},
+			RunE: func(cmd *cobra.Command, args []string) error {
+				client, err := rpc.NewClientWithResponses(flags.RemoteCLIURL)
 				if err != nil {
-					fmt.Fprintf(os.Stderr, "❌ Error reading response body: %s\n", err.Error())
 					return err
 				}
-				if statusCode == http.StatusOK {
-					fmt.Println(string(resp))
-					return nil
+
+				body := rpc.QueryAccountHeight{
+					Address:   args[0],
+					Height:    height,
+					BlockHash: optionalBlockHash(),
+					Timestamp: timestamp.value,
 				}
 
-				return rpcResponseCodeUnhealthy(statusCode, resp)
+				response, err := client.PostV1QueryDelegationsWithResponse(cmd.Context(), body)
+				if err != nil {
+					return unableToConnectToRpc(err)
+				}
+				return renderRPCResponse(cmd, response.HTTPResponse, response.Body, response.JSON200)
 			},
 		},
 		{
-			Use:     "Block [--height]",
+			Use:     "Block [--height] [--block_hash] [--timestamp]",
 			Short:   "Get the block data",
 			Long:    "Queries the node RPC to obtain the block data at the given (or latest if unspecified) height",
 			Args:    cobra.ExactArgs(0),
@@ -181,29 +202,20 @@ func queryHeightCommands() []*cobra.Command {
 				}
 
 				body := rpc.QueryHeight{
-					Height: height,
+					Height:    height,
+					BlockHash: optionalBlockHash(),
+					Timestamp: timestamp.value,
 				}
 
-				response, err := client.PostV1QueryBlock(cmd.Context(), body)
+				response, err := client.PostV1QueryBlockWithResponse(cmd.Context(), body)
 				if err != nil {
 					return unableToConnectToRpc(err)
 				}
-				statusCode := response.StatusCode
-				resp, err := io.ReadAll(response.Body)
-				if err != nil {
-					fmt.Fprintf(os.Stderr, "❌ Error reading response body: %s\n", err.Error())
-					return err
-				}
-				if statusCode == http.StatusOK {
-					fmt.Println(string(resp))
-					return nil
-				}
-
-				return rpcResponseCodeUnhealthy(statusCode, resp)
+				return renderRPCResponse(cmd, response.HTTPResponse, response.Body, response.JSON200)
 			},
 		},
 		{
-			Use:     "Fisherman <address> [--height]",
+			Use:     "Fisherman <address> [--height] [--block_hash] [--timestamp]",
 			Short:   "Get the fisherman data of an address",
 			Long:    "Queries the node RPC to obtain the fisherman data of the speicifed address at the given (or latest if unspecified) height",
 			Args:    cobra.ExactArgs(1),
@@ -215,30 +227,21 @@ func queryHeightCommands() []*cobra.Command {
 				}
 
 				body := rpc.QueryAccountHeight{
-					Address: args[0],
-					Height:  height,
+					Address:   args[0],
+					Height:    height,
+					BlockHash: optionalBlockHash(),
+					Timestamp: timestamp.value,
 				}
 
-				response, err := client.PostV1QueryFisherman(cmd.Context(), body)
+				response, err := client.PostV1QueryFishermanWithResponse(cmd.Context(), body)
 				if err != nil {
 					return unableToConnectToRpc(err)
 				}
-				statusCode := response.StatusCode
-				resp, err := io.ReadAll(response.Body)
-				if err != nil {
-					fmt.Fprintf(os.Stderr, "❌ Error reading response body: %s\n", err.Error())
-					return err
-				}
-				if statusCode == http.StatusOK {
-					fmt.Println(string(resp))
-					return nil
-				}
-
-				return rpcResponseCodeUnhealthy(statusCode, resp)
+				return renderRPCResponse(cmd, response.HTTPResponse, response.Body, response.JSON200)
 			},
 		},
 		{
-			Use:     "Param <parameter_name> [--height]",
+			Use:     "Param <parameter_name> [--height] [--block_hash] [--timestamp]",
 			Short:   "Get the value of the parameter",
 			Long:    "Queries the node RPC to obtain the value of the specified parameter at the given (or latest if unspecified) height",
 			Aliases: []string{"param"},
@@ -252,28 +255,19 @@ func queryHeightCommands() []*cobra.Command {
 				body := rpc.QueryParameter{
 					ParamName: args[0],
 					Height:    height,
+					BlockHash: optionalBlockHash(),
+					Timestamp: timestamp.value,
 				}
 
-				response, err := client.PostV1QueryParam(cmd.Context(), body)
+				response, err := client.PostV1QueryParamWithResponse(cmd.Context(), body)
 				if err != nil {
 					return unableToConnectToRpc(err)
 				}
-				statusCode := response.StatusCode
-				resp, err := io.ReadAll(response.Body)
-				if err != nil {
-					fmt.Fprintf(os.Stderr, "❌ Error reading response body: %s\n", err.Error())
-					return err
-				}
-				if statusCode == http.StatusOK {
-					fmt.Println(string(resp))
-					return nil
-				}
-
-				return rpcResponseCodeUnhealthy(statusCode, resp)
+				return renderRPCResponse(cmd, response.HTTPResponse, response.Body, response.JSON200)
 			},
 		},
 		{
-			Use:     "Servicer <address> [--height]",
+			Use:     "Servicer <address> [--height] [--block_hash] [--timestamp]",
 			Short:   "Get the servicer data of an address",
 			Long:    "Queries the node RPC to obtain the servicer data of the speicifed address at the given (or latest if unspecified) height",
 			Args:    cobra.ExactArgs(1),
@@ -285,30 +279,21 @@ func queryHeightCommands() []*cobra.Command {
 				}
 
 				body := rpc.QueryAccountHeight{
-					Address: args[0],
-					Height:  height,
+					Address:   args[0],
+					Height:    height,
+					BlockHash: optionalBlockHash(),
+					Timestamp: timestamp.value,
 				}
 
-				response, err := client.PostV1QueryServicer(cmd.Context(), body)
+				response, err := client.PostV1QueryServicerWithResponse(cmd.Context(), body)
 				if err != nil {
 					return unableToConnectToRpc(err)
 				}
-				statusCode := response.StatusCode
-				resp, err := io.ReadAll(response.Body)
-				if err != nil {
-					fmt.Fprintf(os.Stderr, "❌ Error reading response body: %s\n", err.Error())
-					return err
-				}
-				if statusCode == http.StatusOK {
-					fmt.Println(string(resp))
-					return nil
-				}
-
-				return rpcResponseCodeUnhealthy(statusCode, resp)
+				return renderRPCResponse(cmd, response.HTTPResponse, response.Body, response.JSON200)
 			},
 		},
 		{
-			Use:     "Supply [--height]",
+			Use:     "Supply [--height] [--block_hash] [--timestamp]",
 			Short:   "Get the token supply data from each pool",
 			Long:    "Queries the node RPC to obtain the token supply data of the pools at the given (or latest if unspecified) height",
 			Args:    cobra.ExactArgs(0),
@@ -320,29 +305,20 @@ func queryHeightCommands() []*cobra.Command {
 				}
 
 				body := rpc.QueryHeight{
-					Height: height,
+					Height:    height,
+					BlockHash: optionalBlockHash(),
+					Timestamp: timestamp.value,
 				}
 
-				response, err := client.PostV1QuerySupply(cmd.Context(), body)
+				response, err := client.PostV1QuerySupplyWithResponse(cmd.Context(), body)
 				if err != nil {
 					return unableToConnectToRpc(err)
 				}
-				statusCode := response.StatusCode
-				resp, err := io.ReadAll(response.Body)
-				if err != nil {
-					fmt.Fprintf(os.Stderr, "❌ Error reading response body: %s\n", err.Error())
-					return err
-				}
-				if statusCode == http.StatusOK {
-					fmt.Println(string(resp))
-					return nil
-				}
-
-				return rpcResponseCodeUnhealthy(statusCode, resp)
+				return renderRPCResponse(cmd, response.HTTPResponse, response.Body, response.JSON200)
 			},
 		},
 		{
-			Use:     "SupportedChains [--height]",
+			Use:     "SupportedChains [--height] [--block_hash] [--timestamp]",
 			Short:   "Get the supported chains",
 			Long:    "Queries the node RPC to obtain the supported chains at the given (or latest if unspecified) height",
 			Args:    cobra.ExactArgs(0),
@@ -354,31 +330,22 @@ func queryHeightCommands() []*cobra.Command {
 				}
 
 				body := rpc.QueryHeight{
-					Height: height,
+					Height:    height,
+					BlockHash: optionalBlockHash(),
+					Timestamp: timestamp.value,
 				}
 
-				response, err := client.PostV1QuerySupportedChains(cmd.Context(), body)
+				response, err := client.PostV1QuerySupportedChainsWithResponse(cmd.Context(), body)
 				if err != nil {
 					return unableToConnectToRpc(err)
 				}
-				statusCode := response.StatusCode
-				resp, err := io.ReadAll(response.Body)
-				if err != nil {
-					fmt.Fprintf(os.Stderr, "❌ Error reading response body: %s\n", err.Error())
-					return err
-				}
-				if statusCode == http.StatusOK {
-					fmt.Println(string(resp))
-					return nil
-				}
-
-				return rpcResponseCodeUnhealthy(statusCode, resp)
+				return renderRPCResponse(cmd, response.HTTPResponse, response.Body, response.JSON200)
 			},
 		},
 		{
-			Use:     "Upgrade [--height]",
-			Short:   "Get the upgrade version",
-			Long:    "Queries the node RPC to obtain the upgrade version for the given (or latest if unspecified) height",
+			Use:     "Upgrade [--height] [--block_hash] [--timestamp]",
+			Short:   "Get the protocol version and the pending upgrade",
+			Long:    "Queries the node RPC to obtain the protocol version active at the given (or latest if unspecified) height and the upgrade scheduled after it, if any",
 			Aliases: []string{"param"},
 			Args:    cobra.ExactArgs(0),
 			RunE: func(cmd *cobra.Command, args []string) error {
@@ -388,29 +355,20 @@ func queryHeightCommands() []*cobra.Command {
 				}
 
 				body := rpc.QueryHeight{
-					Height: height,
+					Height:    height,
+					BlockHash: optionalBlockHash(),
+					Timestamp: timestamp.value,
 				}
 
-				response, err := client.PostV1QueryUpgrade(cmd.Context(), body)
+				response, err := client.PostV1QueryUpgradeWithResponse(cmd.Context(), body)
 				if err != nil {
 					return unableToConnectToRpc(err)
 				}
-				statusCode := response.StatusCode
-				resp, err := io.ReadAll(response.Body)
-				if err != nil {
-					fmt.Fprintf(os.Stderr, "❌ Error reading response body: %s\n", err.Error())
-					return err
-				}
-				if statusCode == http.StatusOK {
-					fmt.Println(string(resp))
-					return nil
-				}
-
-				return rpcResponseCodeUnhealthy(statusCode, resp)
+				return renderRPCResponse(cmd, response.HTTPResponse, response.Body, response.JSON200)
 			},
 		},
 		{
-			Use:     "Validator <address> [--height]",
+			Use:     "Validator <address> [--height] [--block_hash] [--timestamp]",
 			Short:   "Get the validator data of an address",
 			Long:    "Queries the node RPC to obtain the validator data of the speicifed address at the given (or latest if unspecified) height",
 			Args:    cobra.ExactArgs(1),
@@ -422,26 +380,17 @@ func queryHeightCommands() []*cobra.Command {
 				}
 
 				body := rpc.QueryAccountHeight{
-					Address: args[0],
-					Height:  height,
+					Address:   args[0],
+					Height:    height,
+					BlockHash: optionalBlockHash(),
+					Timestamp: timestamp.value,
 				}
 
-				response, err := client.PostV1QueryValidator(cmd.Context(), body)
+				response, err := client.PostV1QueryValidatorWithResponse(cmd.Context(), body)
 				if err != nil {
 					return unableToConnectToRpc(err)
 				}
-				statusCode := response.StatusCode
-				resp, err := io.ReadAll(response.Body)
-				if err != nil {
-					fmt.Fprintf(os.Stderr, "❌ Error reading response body: %s\n", err.Error())
-					return err
-				}
-				if statusCode == http.StatusOK {
-					fmt.Println(string(resp))
-					return nil
-				}
-
-				return rpcResponseCodeUnhealthy(statusCode, resp)
+				return renderRPCResponse(cmd, response.HTTPResponse, response.Body, response.JSON200)
 			},
 		},
 	}
@@ -452,7 +401,7 @@ func queryHeightCommands() []*cobra.Command {
 func queryHeightPaginatedCommands() []*cobra.Command {
 	cmds := []*cobra.Command{
 		{
-			Use:     "Accounts [--height] [--page] [--per_page]",
+			Use:     "Accounts [--height] [--block_hash] [--timestamp] [--page] [--per_page]",
 			Short:   "Get the account data of all accounts",
 			Long:    "Queries the node RPC to obtain the paginated data for all accounts at the given (or latest if unspecified) height",
 			Args:    cobra.ExactArgs(0),
@@ -464,33 +413,31 @@ func queryHeightPaginatedCommands() []*cobra.Command {
 				}
 
 				body := rpc.QueryHeightPaginated{
-					Height:  height,
-					Page:    page,
-					PerPage: per_page,
+					Height:    height,
+					BlockHash: optionalBlockHash(),
+					Timestamp: timestamp.value,
+					Page:      page,
+					PerPage:   per_page,
 				}
 
-				response, err := client.PostV1QueryAccounts(cmd.Context(), body)
+				response, err := client.PostV1QueryAccountsWithResponse(cmd.Context(), body)
 				if err != nil {
 					return unableToConnectToRpc(err)
 				}
-				statusCode := response.StatusCode
-				resp, err := io.ReadAll(response.Body)
-				if err != nil {
-					fmt.Fprintf(os.Stderr, "❌ Error reading response body: %s\n", err.Error())
-					return err
-				}
-				if statusCode == http.StatusOK {
-					fmt.Println(string(resp))
-					return nil
-				}
-
-				return rpcResponseCodeUnhealthy(statusCode, resp)
+				return renderRPCResponse(cmd, response.HTTPResponse, response.Body, response.JSON200)
 			},
 		},
+	}
+
+	return cmds
+}
+
+func queryActorsPaginatedCommands() []*cobra.Command {
+	cmds := []*cobra.Command{
 		{
-			Use:     "Apps [--height] [--page] [--per_page]",
+			Use:     "Apps [--height] [--block_hash] [--timestamp] [--page] [--per_page] [--status] [--chain] [--min_stake] [--output_address]",
 			Short:   "Get all the data of all apps",
-			Long:    "Queries the node RPC to obtain the paginated data for all apps at the given (or latest if unspecified) height",
+			Long:    "Queries the node RPC to obtain the paginated data for all apps at the given (or latest if unspecified) height, optionally filtered by status, chain, minimum stake and output address",
 			Args:    cobra.ExactArgs(0),
 			Aliases: []string{"apps"},
 			RunE: func(cmd *cobra.Command, args []string) error {
@@ -499,34 +446,19 @@ func queryHeightPaginatedCommands() []*cobra.Command {
 					return err
 				}
 
-				body := rpc.QueryHeightPaginated{
-					Height:  height,
-					Page:    page,
-					PerPage: per_page,
-				}
+				body := actorsPaginatedQueryBody()
 
-				response, err := client.PostV1QueryApps(cmd.Context(), body)
+				response, err := client.PostV1QueryAppsWithResponse(cmd.Context(), body)
 				if err != nil {
 					return unableToConnectToRpc(err)
 				}
-				statusCode := response.StatusCode
-				resp, err := io.ReadAll(response.Body)
-				if err != nil {
-					fmt.Fprintf(os.Stderr, "❌ Error reading response body: %s\n", err.Error())
-					return err
-				}
-				if statusCode == http.StatusOK {
-					fmt.Println(string(resp))
-					return nil
-				}
-
-				return rpcResponseCodeUnhealthy(statusCode, resp)
+				return renderRPCResponse(cmd, response.HTTPResponse, response.Body, response.JSON200)
 			},
 		},
 		{
-			Use:     "Fishermen [--height] [--page] [--per_page]",
+			Use:     "Fishermen [--height] [--block_hash] [--timestamp] [--page] [--per_page] [--status] [--chain] [--min_stake] [--output_address]",
 			Short:   "Get all the data of all fishermen",
-			Long:    "Queries the node RPC to obtain the paginated data for all fishermen at the given (or latest if unspecified) height",
+			Long:    "Queries the node RPC to obtain the paginated data for all fishermen at the given (or latest if unspecified) height, optionally filtered by status, chain, minimum stake and output address",
 			Args:    cobra.ExactArgs(0),
 			Aliases: []string{"fishermen"},
 			RunE: func(cmd *cobra.Command, args []string) error {
@@ -535,34 +467,19 @@ func queryHeightPaginatedCommands() []*cobra.Command {
 					return err
 				}
 
-				body := rpc.QueryHeightPaginated{
-					Height:  height,
-					Page:    page,
-					PerPage: per_page,
-				}
+				body := actorsPaginatedQueryBody()
 
-				response, err := client.PostV1QueryFishermen(cmd.Context(), body)
+				response, err := client.PostV1QueryFishermenWithResponse(cmd.Context(), body)
 				if err != nil {
 					return unableToConnectToRpc(err)
 				}
-				statusCode := response.StatusCode
-				resp, err := io.ReadAll(response.Body)
-				if err != nil {
-					fmt.Fprintf(os.Stderr, "❌ Error reading response body: %s\n", err.Error())
-					return err
-				}
-				if statusCode == http.StatusOK {
-					fmt.Println(string(resp))
-					return nil
-				}
-
-				return rpcResponseCodeUnhealthy(statusCode, resp)
+				return renderRPCResponse(cmd, response.HTTPResponse, response.Body, response.JSON200)
 			},
 		},
 		{
-			Use:     "Servicers [--height] [--page] [--per_page]",
+			Use:     "Servicers [--height] [--block_hash] [--timestamp] [--page] [--per_page] [--status] [--chain] [--min_stake] [--output_address]",
 			Short:   "Get all the data of all servicers",
-			Long:    "Queries the node RPC to obtain the paginated data for all servicers at the given (or latest if unspecified) height",
+			Long:    "Queries the node RPC to obtain the paginated data for all servicers at the given (or latest if unspecified) height, optionally filtered by status, chain, minimum stake and output address",
 			Args:    cobra.ExactArgs(0),
 			Aliases: []string{"servicers"},
 			RunE: func(cmd *cobra.Command, args []string) error {
@@ -571,34 +488,19 @@ func queryHeightPaginatedCommands() []*cobra.Command {
 					return err
 				}
 
-				body := rpc.QueryHeightPaginated{
-					Height:  height,
-					Page:    page,
-					PerPage: per_page,
-				}
+				body := actorsPaginatedQueryBody()
 
-				response, err := client.PostV1QueryServicers(cmd.Context(), body)
+				response, err := client.PostV1QueryServicersWithResponse(cmd.Context(), body)
 				if err != nil {
 					return unableToConnectToRpc(err)
 				}
-				statusCode := response.StatusCode
-				resp, err := io.ReadAll(response.Body)
-				if err != nil {
-					fmt.Fprintf(os.Stderr, "❌ Error reading response body: %s\n", err.Error())
-					return err
-				}
-				if statusCode == http.StatusOK {
-					fmt.Println(string(resp))
-					return nil
-				}
-
-				return rpcResponseCodeUnhealthy(statusCode, resp)
+				return renderRPCResponse(cmd, response.HTTPResponse, response.Body, response.JSON200)
 			},
 		},
 		{
-			Use:     "Validators [--height] [--page] [--per_page]",
+			Use:     "Validators [--height] [--block_hash] [--timestamp] [--page] [--per_page] [--status] [--chain] [--min_stake] [--output_address]",
 			Short:   "Get all the data of all validators",
-			Long:    "Queries the node RPC to obtain the paginated data for all validators at the given (or latest if unspecified) height",
+			Long:    "Queries the node RPC to obtain the paginated data for all validators at the given (or latest if unspecified) height, optionally filtered by status, chain, minimum stake and output address",
 			Args:    cobra.ExactArgs(0),
 			Aliases: []string{"validators"},
 			RunE: func(cmd *cobra.Command, args []string) error {
@@ -607,28 +509,13 @@ func queryHeightPaginatedCommands() []*cobra.Command {
 					return err
 				}
 
-				body := rpc.QueryHeightPaginated{
-					Height:  height,
-					Page:    page,
-					PerPage: per_page,
-				}
+				body := actorsPaginatedQueryBody()
 
-				response, err := client.PostV1QueryValidators(cmd.Context(), body)
+				response, err := client.PostV1QueryValidatorsWithResponse(cmd.Context(), body)
 				if err != nil {
 					return unableToConnectToRpc(err)
 				}
-				statusCode := response.StatusCode
-				resp, err := io.ReadAll(response.Body)
-				if err != nil {
-					fmt.Fprintf(os.Stderr, "❌ Error reading response body: %s\n", err.Error())
-					return err
-				}
-				if statusCode == http.StatusOK {
-					fmt.Println(string(resp))
-					return nil
-				}
-
-				return rpcResponseCodeUnhealthy(statusCode, resp)
+				return renderRPCResponse(cmd, response.HTTPResponse, response.Body, response.JSON200)
 			},
 		},
 	}
@@ -636,10 +523,34 @@ func queryHeightPaginatedCommands() []*cobra.Command {
 	return cmds
 }
 
+// actorsPaginatedQueryBody builds the body of an actors query from the height, pagination and filter flags
+func actorsPaginatedQueryBody() rpc.QueryActorsPaginated {
+	body := rpc.QueryActorsPaginated{
+		Height:    height,
+		BlockHash: optionalBlockHash(),
+		Timestamp: timestamp.value,
+		Page:      page,
+		PerPage:   per_page,
+	}
+	if statusFilter != "" {
+		body.Status = &statusFilter
+	}
+	if chainFilter != "" {
+		body.Chain = &chainFilter
+	}
+	if minStakeFilter != "" {
+		body.MinStake = &minStakeFilter
+	}
+	if outputAddressFilter != "" {
+		body.OutputAddress = &outputAddressFilter
+	}
+	return body
+}
+
 func queryHeightPaginatedSortedCommands() []*cobra.Command {
 	cmds := []*cobra.Command{
 		{
-			Use:     "BlockTxs [--height] [--page] [--per_page] [--sort]",
+			Use:     "BlockTxs [--height] [--block_hash] [--timestamp] [--page] [--per_page] [--sort]",
 			Short:   "Get all the transactions in the block",
 			Long:    "Queries the node RPC to obtain the paginated transactions in the block at the given (or latest if unspecified) height",
 			Args:    cobra.ExactArgs(0),
@@ -651,28 +562,19 @@ func queryHeightPaginatedSortedCommands() []*cobra.Command {
 				}
 
 				body := rpc.QueryHeightPaginated{
-					Height:  height,
-					Page:    page,
-					PerPage: per_page,
-					Sort:    &sort,
+					Height:    height,
+					BlockHash: optionalBlockHash(),
+					Timestamp: timestamp.value,
+					Page:      page,
+					PerPage:   per_page,
+					Sort:      &sort,
 				}
 
-				response, err := client.PostV1QueryBlockTxs(cmd.Context(), body)
+				response, err := client.PostV1QueryBlockTxsWithResponse(cmd.Context(), body)
 				if err != nil {
 					return unableToConnectToRpc(err)
 				}
-				statusCode := response.StatusCode
-				resp, err := io.ReadAll(response.Body)
-				if err != nil {
-					fmt.Fprintf(os.Stderr, "❌ Error reading response body: %s\n", err.Error())
-					return err
-				}
-				if statusCode == http.StatusOK {
-					fmt.Println(string(resp))
-					return nil
-				}
-
-				return rpcResponseCodeUnhealthy(statusCode, resp)
+				return renderRPCResponse(cmd, response.HTTPResponse, response.Body, response.JSON200)
 			},
 		},
 	}
@@ -701,22 +603,11 @@ func queryPaginatedSortedCommands() []*cobra.Command {
 					Sort:    &sort,
 				}
 
-				response, err := client.PostV1QueryAccountTxs(cmd.Context(), body)
+				response, err := client.PostV1QueryAccountTxsWithResponse(cmd.Context(), body)
 				if err != nil {
 					return unableToConnectToRpc(err)
 				}
-				statusCode := response.StatusCode
-				resp, err := io.ReadAll(response.Body)
-				if err != nil {
-					fmt.Fprintf(os.Stderr, "❌ Error reading response body: %s\n", err.Error())
-					return err
-				}
-				if statusCode == http.StatusOK {
-					fmt.Println(string(resp))
-					return nil
-				}
-
-				return rpcResponseCodeUnhealthy(statusCode, resp)
+				return renderRPCResponse(cmd, response.HTTPResponse, response.Body, response.JSON200)
 			},
 		},
 		{
@@ -737,22 +628,11 @@ func queryPaginatedSortedCommands() []*cobra.Command {
 					Sort:    &sort,
 				}
 
-				response, err := client.PostV1QueryUnconfirmedTxs(cmd.Context(), body)
+				response, err := client.PostV1QueryUnconfirmedTxsWithResponse(cmd.Context(), body)
 				if err != nil {
 					return unableToConnectToRpc(err)
 				}
-				statusCode := response.StatusCode
-				resp, err := io.ReadAll(response.Body)
-				if err != nil {
-					fmt.Fprintf(os.Stderr, "❌ Error reading response body: %s\n", err.Error())
-					return err
-				}
-				if statusCode == http.StatusOK {
-					fmt.Println(string(resp))
-					return nil
-				}
-
-				return rpcResponseCodeUnhealthy(statusCode, resp)
+				return renderRPCResponse(cmd, response.HTTPResponse, response.Body, response.JSON200)
 			},
 		},
 	}
@@ -773,21 +653,11 @@ func queryCommands() []*cobra.Command {
 				if err != nil {
 					return err
 				}
-				response, err := client.GetV1QueryAllChainParams(cmd.Context())
+				response, err := client.GetV1QueryAllChainParamsWithResponse(cmd.Context())
 				if err != nil {
 					return unableToConnectToRpc(err)
 				}
-				statusCode := response.StatusCode
-				body, err := io.ReadAll(response.Body)
-				if err != nil {
-					fmt.Fprintf(os.Stderr, "❌ Error reading response body: %s\n", err.Error())
-					return err
-				}
-				if statusCode == http.StatusOK {
-					fmt.Println(string(body))
-					return nil
-				}
-				return rpcResponseCodeUnhealthy(statusCode, body)
+				return renderRPCResponse(cmd, response.HTTPResponse, response.Body, response.JSON200)
 			},
 		},
 		{
@@ -801,21 +671,61 @@ func queryCommands() []*cobra.Command {
 				if err != nil {
 					return err
 				}
-				response, err := client.GetV1QueryHeight(cmd.Context())
+				response, err := client.GetV1QueryHeightWithResponse(cmd.Context())
+				if err != nil {
+					return unableToConnectToRpc(err)
+				}
+				return renderRPCResponse(cmd, response.HTTPResponse, response.Body, response.JSON200)
+			},
+		},
+		{
+			Use:     "BlockByHash <hash>",
+			Short:   "Get the block data of the hash provided",
+			Long:    "Queries the node RPC to obtain the block data for the specified block hash",
+			Aliases: []string{"blockbyhash"},
+			Args:    cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				client, err := rpc.NewClientWithResponses(flags.RemoteCLIURL)
+				if err != nil {
+					return err
+				}
+
+				body := rpc.QueryHash{
+					Hash: args[0],
+				}
+
+				response, err := client.PostV1QueryBlockByHashWithResponse(cmd.Context(), body)
 				if err != nil {
 					return unableToConnectToRpc(err)
 				}
-				statusCode := response.StatusCode
-				body, err := io.ReadAll(response.Body)
+				return renderRPCResponse(cmd, response.HTTPResponse, response.Body, response.JSON200)
+			},
+		},
+		{
+			Use:     "HeightAtTime <timestamp>",
+			Short:   "Get the height of the latest block committed at or before a time",
+			Long:    "Queries the node RPC to obtain the height, hash and time of the latest block committed at or before the specified RFC 3339 timestamp (e.g. 2023-07-01T00:00:00Z)",
+			Aliases: []string{"heightattime"},
+			Args:    cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				client, err := rpc.NewClientWithResponses(flags.RemoteCLIURL)
 				if err != nil {
-					fmt.Fprintf(os.Stderr, "❌ Error reading response body: %s\n", err.Error())
 					return err
 				}
-				if statusCode == http.StatusOK {
-					fmt.Println(string(body))
-					return nil
+
+				var at timestampFlag
+				if err := at.Set(args[0]); err != nil {
+					return err
 				}
-				return rpcResponseCodeUnhealthy(statusCode, body)
+				body := rpc.QueryTimestamp{
+					Timestamp: *at.value,
+				}
+
+				response, err := client.PostV1QueryHeightAtTimeWithResponse(cmd.Context(), body)
+				if err != nil {
+					return unableToConnectToRpc(err)
+				}
+				return renderRPCResponse(cmd, response.HTTPResponse, response.Body, response.JSON200)
 			},
 		},
 		{
@@ -834,22 +744,11 @@ func queryCommands() []*cobra.Command {
 					Hash: args[0],
 				}
 
-				response, err := client.PostV1QueryTx(cmd.Context(), body)
+				response, err := client.PostV1QueryTxWithResponse(cmd.Context(), body)
 				if err != nil {
 					return unableToConnectToRpc(err)
 				}
-				statusCode := response.StatusCode
-				resp, err := io.ReadAll(response.Body)
-				if err != nil {
-					fmt.Fprintf(os.Stderr, "❌ Error reading response body: %s\n", err.Error())
-					return err
-				}
-				if statusCode == http.StatusOK {
-					fmt.Println(string(resp))
-					return nil
-				}
-
-				return rpcResponseCodeUnhealthy(statusCode, resp)
+				return renderRPCResponse(cmd, response.HTTPResponse, response.Body, response.JSON200)
 			},
 		},
 		{
@@ -868,22 +767,11 @@ func queryCommands() []*cobra.Command {
 					Hash: args[0],
 				}
 
-				response, err := client.PostV1QueryUnconfirmedTx(cmd.Context(), body)
+				response, err := client.PostV1QueryUnconfirmedTxWithResponse(cmd.Context(), body)
 				if err != nil {
 					return unableToConnectToRpc(err)
 				}
-				statusCode := response.StatusCode
-				resp, err := io.ReadAll(response.Body)
-				if err != nil {
-					fmt.Fprintf(os.Stderr, "❌ Error reading response body: %s\n", err.Error())
-					return err
-				}
-				if statusCode == http.StatusOK {
-					fmt.Println(string(resp))
-					return nil
-				}
-
-				return rpcResponseCodeUnhealthy(statusCode, resp)
+				return renderRPCResponse(cmd, response.HTTPResponse, response.Body, response.JSON200)
 			},
 		},
 		{
@@ -896,21 +784,11 @@ func queryCommands() []*cobra.Command {
 				if err != nil {
 					return err
 				}
-				response, err := client.PostV1QueryNodeRoles(cmd.Context())
+				response, err := client.PostV1QueryNodeRolesWithResponse(cmd.Context())
 				if err != nil {
 					return unableToConnectToRpc(err)
 				}
-				statusCode := response.StatusCode
-				body, err := io.ReadAll(response.Body)
-				if err != nil {
-					fmt.Fprintf(os.Stderr, "❌ Error reading response body: %s\n", err.Error())
-					return err
-				}
-				if statusCode == http.StatusOK {
-					fmt.Println(string(body))
-					return nil
-				}
-				return rpcResponseCodeUnhealthy(statusCode, body)
+				return renderRPCResponse(cmd, response.HTTPResponse, response.Body, response.JSON200)
 			},
 		},
 	}
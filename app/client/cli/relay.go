@@ -0,0 +1,259 @@
+package cli
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	stdsort "sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pokt-network/pocket/logger"
+	"github.com/pokt-network/pocket/rpc"
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+)
+
+const (
+	servicerSelectionRandom  = "random"
+	servicerSelectionLatency = "latency"
+
+	// stdinRelayPayload is the <relayPayload> argument used to read the relay payload from stdin
+	stdinRelayPayload = "-"
+	jsonRPCVersion    = "2.0"
+
+	// IMPROVE: make the timeouts configurable
+	servicerLatencyTimeout = 2 * time.Second
+)
+
+var (
+	errEmptyRelayPayload        = errors.New("empty relay payload")
+	errInvalidJSONRPCPayload    = errors.New("invalid JSON-RPC payload")
+	errInvalidServicerSelection = errors.New("invalid servicer selection")
+	errNoServicersInSession     = errors.New("no servicers in the session")
+	errInvalidServicerSignature = errors.New("invalid servicer signature on the relay response")
+)
+
+// jsonRPCRequest is a JSON-RPC 2.0 request object, see https://www.jsonrpc.org/specification#request_object
+type jsonRPCRequest struct {
+	JsonRpc string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	Id      json.RawMessage `json:"id,omitempty"`
+}
+
+// readRelayPayload returns the <relayPayload> argument, or the payload read from `stdin` if the argument is omitted or is "-"
+func readRelayPayload(args []string, stdin io.Reader) (string, error) {
+	if len(args) > 0 && args[0] != stdinRelayPayload {
+		return args[0], nil
+	}
+
+	bz, err := io.ReadAll(stdin)
+	if err != nil {
+		return "", fmt.Errorf("error reading the relay payload from stdin: %w", err)
+	}
+	payload := strings.TrimSpace(string(bz))
+	if payload == "" {
+		return "", errEmptyRelayPayload
+	}
+	return payload, nil
+}
+
+// parseRelayPayload parses a JSON-RPC request object into the relay payload.
+// Any other payload is used as the method of the JSON-RPC request.
+func parseRelayPayload(payload string) (rpc.Payload, error) {
+	payload = strings.TrimSpace(payload)
+	if payload == "" {
+		return rpc.Payload{}, errEmptyRelayPayload
+	}
+	if !strings.HasPrefix(payload, "{") {
+		return rpc.Payload{Jsonrpc: jsonRPCVersion, Method: payload}, nil
+	}
+
+	var req jsonRPCRequest
+	if err := json.Unmarshal([]byte(payload), &req); err != nil {
+		return rpc.Payload{}, fmt.Errorf("%w: %s", errInvalidJSONRPCPayload, err.Error())
+	}
+	if req.JsonRpc == "" {
+		req.JsonRpc = jsonRPCVersion
+	}
+	if req.JsonRpc != jsonRPCVersion {
+		return rpc.Payload{}, fmt.Errorf("%w: unsupported jsonrpc version %q", errInvalidJSONRPCPayload, req.JsonRpc)
+	}
+	if req.Method == "" {
+		return rpc.Payload{}, fmt.Errorf("%w: missing method", errInvalidJSONRPCPayload)
+	}
+
+	relayPayload := rpc.Payload{
+		Jsonrpc: req.JsonRpc,
+		Method:  req.Method,
+	}
+	if len(req.Params) > 0 {
+		params := []byte(req.Params)
+		relayPayload.Parameters = &params
+	}
+	if len(req.Id) > 0 {
+		id := []byte(req.Id)
+		relayPayload.Id = &id
+	}
+	return relayPayload, nil
+}
+
+// orderServicers returns the servicers of the session in the order the relay should be attempted in:
+//   - random: shuffled, to spread the relays of the application over the servicers of the session
+//   - latency: from the lowest to the highest latency of their health endpoint, unreachable servicers last
+func orderServicers(ctx context.Context, servicers []rpc.ProtocolActor, selection string) ([]rpc.ProtocolActor, error) {
+	if len(servicers) == 0 {
+		return nil, errNoServicersInSession
+	}
+
+	ordered := make([]rpc.ProtocolActor, len(servicers))
+	copy(ordered, servicers)
+
+	switch selection {
+	case servicerSelectionRandom:
+		rand.Shuffle(len(ordered), func(i, j int) { ordered[i], ordered[j] = ordered[j], ordered[i] })
+	case servicerSelectionLatency:
+		latencies := measureServicerLatencies(ctx, ordered)
+		stdsort.SliceStable(ordered, func(i, j int) bool {
+			return latencies[ordered[i].Address] < latencies[ordered[j].Address]
+		})
+	default:
+		return nil, fmt.Errorf("%w: %s, options are: %s, %s", errInvalidServicerSelection, selection, servicerSelectionRandom, servicerSelectionLatency)
+	}
+	return ordered, nil
+}
+
+// measureServicerLatencies returns the latency of the health endpoint of each servicer, keyed by address.
+// The latency of an unreachable servicer is the maximum duration.
+func measureServicerLatencies(ctx context.Context, servicers []rpc.ProtocolActor) map[string]time.Duration {
+	var (
+		mu        sync.Mutex
+		wg        sync.WaitGroup
+		latencies = make(map[string]time.Duration, len(servicers))
+	)
+	for i := range servicers {
+		wg.Add(1)
+		go func(servicer rpc.ProtocolActor) {
+			defer wg.Done()
+			latency, err := measureLatency(ctx, servicer.ServiceUrl)
+			if err != nil {
+				logger.Global.Debug().Err(err).Str("servicer", servicer.Address).Msg("servicer unreachable")
+				latency = time.Duration(math.MaxInt64)
+			}
+			mu.Lock()
+			latencies[servicer.Address] = latency
+			mu.Unlock()
+		}(servicers[i])
+	}
+	wg.Wait()
+	return latencies
+}
+
+func measureLatency(ctx context.Context, serviceUrl string) (time.Duration, error) {
+	client, err := rpc.NewClient(serviceUrl)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, servicerLatencyTimeout)
+	defer cancel()
+
+	start := time.Now()
+	resp, err := client.GetV1Health(ctx)
+	if err != nil {
+		return 0, err
+	}
+	latency := time.Since(start)
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("unexpected status code %d from the health endpoint", resp.StatusCode)
+	}
+	return latency, nil
+}
+
+// relayToServicers sends the relay to the servicers in order, until one of them returns a response with a valid signature
+func relayToServicers(ctx context.Context, payload rpc.Payload, appPrivateKey crypto.PrivateKey, session *rpc.Session, servicers []rpc.ProtocolActor) (*rpc.RelayResponse, *rpc.ProtocolActor, error) {
+	if len(servicers) == 0 {
+		return nil, nil, errNoServicersInSession
+	}
+
+	var errs []error
+	for i := range servicers {
+		servicer := &servicers[i]
+		resp, err := relayToServicer(ctx, payload, appPrivateKey, session, servicer)
+		if err == nil {
+			return resp, servicer, nil
+		}
+
+		logger.Global.Warn().Err(err).Str("servicer", servicer.Address).Msg("relay failed, trying the next servicer of the session")
+		errs = append(errs, fmt.Errorf("servicer %s: %w", servicer.Address, err))
+	}
+	return nil, nil, fmt.Errorf("relay failed on all %d servicers: %w", len(servicers), errors.Join(errs...))
+}
+
+func relayToServicer(ctx context.Context, payload rpc.Payload, appPrivateKey crypto.PrivateKey, session *rpc.Session, servicer *rpc.ProtocolActor) (*rpc.RelayResponse, error) {
+	relay, err := buildRelay(payload, appPrivateKey, session, servicer)
+	if err != nil {
+		return nil, fmt.Errorf("error building relay from payload: %w", err)
+	}
+
+	resp, err := sendTrustlessRelay(ctx, servicer.ServiceUrl, relay)
+	if err != nil {
+		return nil, err
+	}
+	if resp.HTTPResponse.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d: %s", resp.HTTPResponse.StatusCode, strings.TrimSpace(string(resp.Body)))
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected response %s", string(resp.Body))
+	}
+
+	if err := verifyRelayResponse(relay, resp.JSON200, servicer.PublicKey); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+// verifyRelayResponse verifies the signature of the servicer on the relay and its response.
+// The servicer signs the hash of the serialized relay and response, see `isRelayVolumeApplicable` of the servicer module.
+func verifyRelayResponse(relay *rpc.RelayRequest, resp *rpc.RelayResponse, servicerPublicKey string) error {
+	publicKey, err := crypto.NewPublicKey(servicerPublicKey)
+	if err != nil {
+		return fmt.Errorf("error parsing the public key of the servicer: %w", err)
+	}
+	signature, err := hex.DecodeString(resp.ServicerSignature)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errInvalidServicerSignature, err.Error())
+	}
+
+	digest, err := relayResponseDigest(relay, resp.Payload)
+	if err != nil {
+		return err
+	}
+	if !publicKey.Verify(digest, signature) {
+		return errInvalidServicerSignature
+	}
+	return nil
+}
+
+// relayResponseDigest returns the digest of the relay and its response, which the servicer signs
+func relayResponseDigest(relay *rpc.RelayRequest, responsePayload string) ([]byte, error) {
+	relayReqResBytes, err := codec.GetCodec().Marshal(&coreTypes.RelayReqRes{
+		Relay:    rpc.RelayFromRequest(relay),
+		Response: &coreTypes.RelayResponse{Payload: responsePayload},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling relay and response: %w", err)
+	}
+	return crypto.SHA3Hash(relayReqResBytes), nil
+}
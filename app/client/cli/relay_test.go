@@ -0,0 +1,212 @@
+package cli
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/pocket/rpc"
+	"github.com/pokt-network/pocket/shared/crypto"
+)
+
+func TestParseRelayPayload(t *testing.T) {
+	params := []byte(`["0x1",false]`)
+	id := []byte(`1`)
+
+	testCases := []struct {
+		name        string
+		payload     string
+		expected    rpc.Payload
+		expectedErr error
+	}{
+		{
+			name:     "method only",
+			payload:  "eth_blockNumber",
+			expected: rpc.Payload{Jsonrpc: "2.0", Method: "eth_blockNumber"},
+		},
+		{
+			name:     "JSON-RPC request",
+			payload:  `{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["0x1",false],"id":1}`,
+			expected: rpc.Payload{Jsonrpc: "2.0", Method: "eth_getBlockByNumber", Parameters: &params, Id: &id},
+		},
+		{
+			name:     "JSON-RPC request without version",
+			payload:  `{"method":"eth_blockNumber"}`,
+			expected: rpc.Payload{Jsonrpc: "2.0", Method: "eth_blockNumber"},
+		},
+		{
+			name:        "JSON-RPC request without method",
+			payload:     `{"jsonrpc":"2.0","id":1}`,
+			expectedErr: errInvalidJSONRPCPayload,
+		},
+		{
+			name:        "unsupported JSON-RPC version",
+			payload:     `{"jsonrpc":"1.0","method":"eth_blockNumber"}`,
+			expectedErr: errInvalidJSONRPCPayload,
+		},
+		{
+			name:        "malformed JSON-RPC request",
+			payload:     `{"jsonrpc":`,
+			expectedErr: errInvalidJSONRPCPayload,
+		},
+		{
+			name:        "empty payload",
+			payload:     " ",
+			expectedErr: errEmptyRelayPayload,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseRelayPayload(tc.payload)
+			require.ErrorIs(t, err, tc.expectedErr)
+			if tc.expectedErr == nil {
+				require.Equal(t, tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestReadRelayPayload(t *testing.T) {
+	const stdinPayload = `{"jsonrpc":"2.0","method":"eth_blockNumber"}`
+
+	payload, err := readRelayPayload([]string{"eth_blockNumber"}, strings.NewReader(stdinPayload))
+	require.NoError(t, err)
+	require.Equal(t, "eth_blockNumber", payload)
+
+	payload, err = readRelayPayload(nil, strings.NewReader(stdinPayload+"\n"))
+	require.NoError(t, err)
+	require.Equal(t, stdinPayload, payload)
+
+	payload, err = readRelayPayload([]string{stdinRelayPayload}, strings.NewReader(stdinPayload))
+	require.NoError(t, err)
+	require.Equal(t, stdinPayload, payload)
+
+	_, err = readRelayPayload(nil, strings.NewReader("\n"))
+	require.ErrorIs(t, err, errEmptyRelayPayload)
+}
+
+func TestRelayToServicers(t *testing.T) {
+	const responsePayload = `{"jsonrpc":"2.0","result":"0x10","id":1}`
+
+	appKey, err := crypto.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	failing := newTestServicer(t, func(w http.ResponseWriter, _ *rpc.RelayRequest, _ crypto.PrivateKey) {
+		http.Error(w, "relay chain unavailable", http.StatusInternalServerError)
+	})
+	badSignature := newTestServicer(t, func(w http.ResponseWriter, relay *rpc.RelayRequest, _ crypto.PrivateKey) {
+		otherKey, err := crypto.GeneratePrivateKey()
+		require.NoError(t, err)
+		writeSignedRelayResponse(t, w, relay, responsePayload, otherKey)
+	})
+	honest := newTestServicer(t, func(w http.ResponseWriter, relay *rpc.RelayRequest, servicerKey crypto.PrivateKey) {
+		writeSignedRelayResponse(t, w, relay, responsePayload, servicerKey)
+	})
+
+	session := testSession("app1Addr", testSessionHeight)
+	payload, err := parseRelayPayload(`{"jsonrpc":"2.0","method":"eth_blockNumber","id":1}`)
+	require.NoError(t, err)
+
+	resp, servicer, err := relayToServicers(context.Background(), payload, appKey, session, []rpc.ProtocolActor{failing, badSignature, honest})
+	require.NoError(t, err)
+	require.Equal(t, honest.Address, servicer.Address)
+	require.Equal(t, responsePayload, resp.Payload)
+
+	_, _, err = relayToServicers(context.Background(), payload, appKey, session, []rpc.ProtocolActor{failing, badSignature})
+	require.ErrorIs(t, err, errInvalidServicerSignature)
+
+	_, _, err = relayToServicers(context.Background(), payload, appKey, session, nil)
+	require.ErrorIs(t, err, errNoServicersInSession)
+}
+
+func TestOrderServicers(t *testing.T) {
+	slow := newTestServicerWithHealth(t, 200*time.Millisecond)
+	fast := newTestServicerWithHealth(t, 0)
+	unreachable := rpc.ProtocolActor{Address: "unreachable", ServiceUrl: "http://127.0.0.1:1"}
+	servicers := []rpc.ProtocolActor{unreachable, slow, fast}
+
+	ordered, err := orderServicers(context.Background(), servicers, servicerSelectionLatency)
+	require.NoError(t, err)
+	require.Equal(t, []string{fast.Address, slow.Address, unreachable.Address}, actorAddresses(ordered))
+
+	ordered, err = orderServicers(context.Background(), servicers, servicerSelectionRandom)
+	require.NoError(t, err)
+	require.ElementsMatch(t, actorAddresses(servicers), actorAddresses(ordered))
+
+	_, err = orderServicers(context.Background(), servicers, "closest")
+	require.ErrorIs(t, err, errInvalidServicerSelection)
+
+	_, err = orderServicers(context.Background(), nil, servicerSelectionRandom)
+	require.ErrorIs(t, err, errNoServicersInSession)
+}
+
+// newTestServicer starts a servicer serving the relays with `handler` and returns it as a servicer of a session
+func newTestServicer(t *testing.T, handler func(http.ResponseWriter, *rpc.RelayRequest, crypto.PrivateKey)) rpc.ProtocolActor {
+	t.Helper()
+	servicerKey, err := crypto.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var relay rpc.RelayRequest
+		if err := json.NewDecoder(r.Body).Decode(&relay); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		handler(w, &relay, servicerKey)
+	}))
+	t.Cleanup(server.Close)
+
+	return rpc.ProtocolActor{
+		ActorType:  rpc.Servicer,
+		Address:    servicerKey.Address().String(),
+		PublicKey:  servicerKey.PublicKey().String(),
+		ServiceUrl: server.URL,
+	}
+}
+
+// newTestServicerWithHealth starts a servicer whose health endpoint responds after `delay`
+func newTestServicerWithHealth(t *testing.T, delay time.Duration) rpc.ProtocolActor {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(delay)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	return rpc.ProtocolActor{
+		ActorType:  rpc.Servicer,
+		Address:    server.URL,
+		ServiceUrl: server.URL,
+	}
+}
+
+// writeSignedRelayResponse responds to the relay the same way the servicer module does, signing the relay and the response with `servicerKey`
+func writeSignedRelayResponse(t *testing.T, w http.ResponseWriter, relay *rpc.RelayRequest, payload string, servicerKey crypto.PrivateKey) {
+	t.Helper()
+	digest, err := relayResponseDigest(relay, payload)
+	require.NoError(t, err)
+	signature, err := servicerKey.Sign(digest)
+	require.NoError(t, err)
+
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(t, json.NewEncoder(w).Encode(rpc.RelayResponse{
+		Payload:           payload,
+		ServicerSignature: hex.EncodeToString(signature),
+	}))
+}
+
+func actorAddresses(actors []rpc.ProtocolActor) []string {
+	addresses := make([]string, 0, len(actors))
+	for i := range actors {
+		addresses = append(addresses, actors[i].Address)
+	}
+	return addresses
+}
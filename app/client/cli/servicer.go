@@ -47,7 +47,11 @@ func NewServicerCommand() *cobra.Command {
 		Args:    cobra.ExactArgs(0),
 	}
 
-	cmds := servicerCommands()
+	txCmds := servicerCommands()
+	applySubcommandOptions(txCmds, attachDryRunFlagToSubcommands())
+	applySubcommandOptions(txCmds, attachGenerateOnlyFlagsToSubcommands())
+
+	cmds := append(txCmds, newRelayCmd())
 	applySubcommandOptions(cmds, attachPwdFlagToSubcommands())
 	applySubcommandOptions(cmds, attachKeybaseFlagsToSubcommands())
 
@@ -56,6 +60,7 @@ func NewServicerCommand() *cobra.Command {
 	return cmd
 }
 
+// servicerCommands returns the transaction commands of the servicer actor
 func servicerCommands() []*cobra.Command {
 	cmdDef := actorCmdDef{"Servicer", coreTypes.ActorType_ACTOR_TYPE_SERVICER}
 	cmds := []*cobra.Command{
@@ -63,68 +68,112 @@ func servicerCommands() []*cobra.Command {
 		newEditStakeCmd(cmdDef),
 		newUnstakeCmd(cmdDef),
 		newUnpauseCmd(cmdDef),
-		{
-			// IMPROVE: allow reading the relay payload from a file with the serialized protobuf via [--input_file]
-			Use:   "Relay <applicationAddrHex> <servicerAddrHex> <relayChainID> <relayPayload>",
-			Short: "Relay <applicationAddrHex> <servicerAddrHex> <relayChainID> <relayPayload>",
-			Long: `Sends a trustless relay using <relayPayload> as contents, to the specified active <servicerAddrHex> in the the <applicationAddrHex>'s session.
-Will prompt the user for the *application* account passphrase`,
-			Aliases: []string{},
-			Args:    cobra.ExactArgs(4),
-			RunE: func(cmd *cobra.Command, args []string) error {
-				defer func() {
-					if err := sessionCache.Stop(); err != nil {
-						logger.Global.Warn().Err(err).Msg("failed to stop session cache")
-					}
-				}()
-
-				applicationAddr := args[0]
-				servicerAddr := args[1]
-				chain := args[2]
-				relayPayload := args[3]
-
-				// REFACTOR: decouple the client logic from the CLI
-				//	The client will: send the trustless relay and return the response (using a single function as entrypoint)
-				//	The CLI will:
-				//		1) extract the required input from the command arguments
-				//		2) call the client function (with the inputs above) that performs the trustless relay
-				pk, err := getPrivateKeyFromKeybase(applicationAddr)
-				if err != nil {
-					return fmt.Errorf("error getting application's private key: %w", err)
-				}
+	}
 
-				// TECHDEBT(#791): cache session data
-				session, err := getCurrentSession(cmd.Context(), applicationAddr, chain)
-				if err != nil {
-					return fmt.Errorf("Error getting current session: %w", err)
-				}
+	return cmds
+}
 
+func newRelayCmd() *cobra.Command {
+	var servicerAddr, servicerSelection string
+
+	cmd := &cobra.Command{
+		// IMPROVE: allow reading the relay payload from a file with the serialized protobuf via [--input_file]
+		Use:   "Relay <applicationAddrHex> <relayChainID> [relayPayload]",
+		Short: "Relay <applicationAddrHex> <relayChainID> [relayPayload]",
+		Long: `Sends a trustless relay using [relayPayload] as contents, to a servicer of the <applicationAddrHex>'s current session for <relayChainID>.
+The payload is either a JSON-RPC request object or the method of the JSON-RPC request. It is read from stdin if [relayPayload] is omitted or is "-".
+The session is cached until its last block. The servicer is picked from the session according to [--servicer_selection], unless [--servicer] is provided,
+and the relay is retried with the next servicer of the session on failure. The signature of the servicer on the response is verified.
+Will prompt the user for the *application* account passphrase, unless the payload is read from stdin: use [--pwd] or [--non_interactive] instead`,
+		Aliases: []string{},
+		Args:    cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			defer func() {
+				if err := sessionCache.Stop(); err != nil {
+					logger.Global.Warn().Err(err).Msg("failed to stop session cache")
+				}
+			}()
+
+			applicationAddr := args[0]
+			chain := args[1]
+
+			if (len(args) < 3 || args[2] == stdinRelayPayload) && pwd == "" && !flags.NonInteractive {
+				return errors.New("the passphrase cannot be prompted for when reading the relay payload from stdin: use --pwd or --non_interactive")
+			}
+			rawPayload, err := readRelayPayload(args[2:], cmd.InOrStdin())
+			if err != nil {
+				return err
+			}
+			relayPayload, err := parseRelayPayload(rawPayload)
+			if err != nil {
+				return newCLIError(errCodeInvalidArgument, err)
+			}
+
+			// REFACTOR: decouple the client logic from the CLI
+			//	The client will: send the trustless relay and return the response (using a single function as entrypoint)
+			//	The CLI will:
+			//		1) extract the required input from the command arguments
+			//		2) call the client function (with the inputs above) that performs the trustless relay
+			pk, err := getPrivateKeyFromKeybase(applicationAddr)
+			if err != nil {
+				return fmt.Errorf("error getting application's private key: %w", err)
+			}
+
+			session, err := getCurrentSession(cmd.Context(), applicationAddr, chain)
+			if err != nil {
+				return fmt.Errorf("Error getting current session: %w", err)
+			}
+
+			var servicers []rpc.ProtocolActor
+			if servicerAddr != "" {
 				servicer, err := validateServicer(session, servicerAddr)
 				if err != nil {
 					return fmt.Errorf("error getting servicer for the relay: %w", err)
 				}
-
-				relay, err := buildRelay(relayPayload, pk, session, servicer)
+				servicers = []rpc.ProtocolActor{*servicer}
+			} else {
+				servicers, err = orderServicers(cmd.Context(), session.Servicers, servicerSelection)
 				if err != nil {
-					return fmt.Errorf("error building relay from payload: %w", err)
+					return fmt.Errorf("error selecting servicer for the relay: %w", err)
 				}
+			}
 
-				fmt.Printf("sending trustless relay for %s to %s with payload: %s\n", applicationAddr, servicerAddr, relayPayload)
+			fmt.Fprintf(cmd.ErrOrStderr(), "sending trustless relay for %s on %s with payload: %s\n", applicationAddr, chain, rawPayload)
 
-				resp, err := sendTrustlessRelay(cmd.Context(), servicer.ServiceUrl, relay)
-				if err != nil {
-					return err
-				}
+			resp, servicer, err := relayToServicers(cmd.Context(), relayPayload, pk, session, servicers)
+			if err != nil {
+				return err
+			}
 
-				fmt.Printf("HTTP status code: %d\n", resp.HTTPResponse.StatusCode)
-				fmt.Println("Response: ", resp.JSON200)
+			fmt.Fprintf(cmd.ErrOrStderr(), "relay served by servicer %s, signature verified\n", servicer.Address)
 
-				return nil
-			},
+			return render(cmd, newRelayResult(servicer.Address, resp))
 		},
 	}
 
-	return cmds
+	cmd.Flags().StringVar(&servicerAddr, "servicer", "", "address of the servicer of the session to send the relay to, instead of selecting one")
+	cmd.Flags().StringVar(&servicerSelection, "servicer_selection", servicerSelectionRandom, "how the servicer is selected from the session: random or latency")
+
+	return cmd
+}
+
+// relayResult is the output of `Servicer Relay`, the payload of the response is embedded as is if it is JSON
+type relayResult struct {
+	Servicer          string          `json:"servicer"`
+	Payload           json.RawMessage `json:"payload"`
+	ServicerSignature string          `json:"servicer_signature"`
+}
+
+func newRelayResult(servicerAddr string, resp *rpc.RelayResponse) relayResult {
+	payload := json.RawMessage(resp.Payload)
+	if !json.Valid(payload) {
+		payload, _ = json.Marshal(resp.Payload)
+	}
+	return relayResult{
+		Servicer:          servicerAddr,
+		Payload:           payload,
+		ServicerSignature: resp.ServicerSignature,
+	}
 }
 
 // TODO: add a cli command for fetching sessions
@@ -147,17 +196,15 @@ func getSessionFromCache(c cache.SessionCache, appAddress, chain string, height
 		return nil, errNoSessionCache
 	}
 
-	session, err := c.Get(appAddress, chain)
-	if err != nil {
+	session, err := c.GetAtHeight(appAddress, chain, height)
+	switch {
+	case errors.Is(err, cache.ErrSessionExpired), errors.Is(err, cache.ErrSessionNotStarted):
+		return nil, fmt.Errorf("%w: %s", errNoMatchingSessionInCache, err.Error())
+	case err != nil:
 		return nil, fmt.Errorf("%w: %s", errSessionNotFoundInCache, err.Error())
 	}
 
-	// verify the cached session matches the provided height
-	if height >= session.SessionHeight && height < session.SessionHeight+session.NumSessionBlocks {
-		return session, nil
-	}
-
-	return nil, errNoMatchingSessionInCache
+	return session, nil
 }
 
 func getCurrentSession(ctx context.Context, appAddress, chain string) (*rpc.Session, error) {
@@ -243,15 +290,9 @@ func sendTrustlessRelay(ctx context.Context, servicerUrl string, relay *rpc.Rela
 	return client.PostV1ClientRelayWithResponse(ctx, *relay)
 }
 
-func buildRelay(payload string, appPrivateKey crypto.PrivateKey, session *rpc.Session, servicer *rpc.ProtocolActor) (*rpc.RelayRequest, error) {
+// INCOMPLETE: set Headers for HTTP relays
+func buildRelay(relayPayload rpc.Payload, appPrivateKey crypto.PrivateKey, session *rpc.Session, servicer *rpc.ProtocolActor) (*rpc.RelayRequest, error) {
 	// TECHDEBT: This is mostly COPIED from pocket-go: we should refactor pocket-go code and import this functionality from there instead.
-	relayPayload := rpc.Payload{
-		// INCOMPLETE(#803): need to unmarshal into JSONRPC and other supported relay formats once proto-generated custom types are added.
-		Jsonrpc: "2.0",
-		Method:  payload,
-		// INCOMPLETE: set Headers for HTTP relays
-	}
-
 	relayMeta := rpc.RelayRequestMeta{
 		BlockHeight: session.SessionHeight,
 		// TODO: Make Chain Identifier type consistent in Session and Meta use Identifiable for Chain in Session (or string for Chain in Relay Meta)
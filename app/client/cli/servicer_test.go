@@ -86,3 +86,19 @@ func testSession(appAddr string, height int64) *rpc.Session {
 		SessionNumber:    (height / numSessionBlocks), // assumes numSessionBlocks never changed
 	}
 }
+
+func TestNewServicerCommand_TxFlags(t *testing.T) {
+	cmd := NewServicerCommand()
+	for _, name := range []string{"Stake", "EditStake", "Unstake", "Unpause"} {
+		subCmd, _, err := cmd.Find([]string{name})
+		require.NoError(t, err)
+		for _, flag := range []string{"dry-run", "generate-only", "public_key", "output_file"} {
+			require.NotNil(t, subCmd.Flags().Lookup(flag), "%s is missing --%s", name, flag)
+		}
+	}
+
+	relayCmd, _, err := cmd.Find([]string{"Relay"})
+	require.NoError(t, err)
+	require.Nil(t, relayCmd.Flags().Lookup("dry-run"))
+	require.Nil(t, relayCmd.Flags().Lookup("generate-only"))
+}
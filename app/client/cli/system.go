@@ -1,7 +1,6 @@
 package cli
 
 import (
-	"fmt"
 	"net/http"
 
 	"github.com/spf13/cobra"
@@ -28,6 +27,16 @@ func NewSystemCommand() *cobra.Command {
 	return cmd
 }
 
+type healthResult struct {
+	Healthy      bool   `json:"healthy"`
+	RemoteCLIURL string `json:"remote_cli_url"`
+}
+
+type versionResult struct {
+	Version      string `json:"version"`
+	RemoteCLIURL string `json:"remote_cli_url"`
+}
+
 func systemCommands() []*cobra.Command {
 	cmds := []*cobra.Command{
 		{
@@ -38,7 +47,7 @@ func systemCommands() []*cobra.Command {
 			RunE: func(cmd *cobra.Command, args []string) error {
 				client, err := rpc.NewClientWithResponses(flags.RemoteCLIURL)
 				if err != nil {
-					return nil
+					return err
 				}
 				response, err := client.GetV1HealthWithResponse(cmd.Context())
 				if err != nil {
@@ -46,8 +55,7 @@ func systemCommands() []*cobra.Command {
 				}
 				statusCode := response.StatusCode()
 				if statusCode == http.StatusOK {
-					fmt.Printf("✅ RPC reporting healthy status for node @ %s\n\n%s", boldText(flags.RemoteCLIURL), response.Body)
-					return nil
+					return render(cmd, healthResult{Healthy: true, RemoteCLIURL: flags.RemoteCLIURL})
 				}
 
 				return rpcResponseCodeUnhealthy(statusCode, response.Body)
@@ -69,8 +77,7 @@ func systemCommands() []*cobra.Command {
 				}
 				statusCode := response.StatusCode()
 				if statusCode == http.StatusOK {
-					fmt.Printf("Node @ %s reports that it's running version: \n%s\n", boldText(flags.RemoteCLIURL), boldText(response.Body))
-					return nil
+					return render(cmd, versionResult{Version: string(response.Body), RemoteCLIURL: flags.RemoteCLIURL})
 				}
 
 				return rpcResponseCodeUnhealthy(statusCode, response.Body)
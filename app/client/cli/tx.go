@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+func init() {
+	rootCmd.AddCommand(NewTxCommand())
+}
+
+func NewTxCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "Tx",
+		Short:   "Commands to broadcast and inspect serialized transactions",
+		Aliases: []string{"tx"},
+		Args:    cobra.ExactArgs(0),
+	}
+
+	cmd.AddCommand(txCommands()...)
+
+	return cmd
+}
+
+// broadcastTxResult is the result of the transaction commands once the transaction is accepted by the mempool of the node
+type broadcastTxResult struct {
+	Hash       string `json:"hash"`
+	SignerAddr string `json:"signer_addr"`
+}
+
+// txFileResult is the result of the commands writing a transaction to a file
+type txFileResult struct {
+	SignerAddr   string `json:"signer_addr,omitempty"`
+	MultisigAddr string `json:"multisig_addr,omitempty"`
+	Signatures   int    `json:"signatures,omitempty"`
+	Threshold    uint32 `json:"threshold,omitempty"`
+	File         string `json:"file"`
+}
+
+// decodedTx is the output of `Tx Decode`
+type decodedTx struct {
+	Hash       string          `json:"hash"`
+	SignerAddr string          `json:"signer_addr,omitempty"`
+	Signed     bool            `json:"signed"`
+	Tx         json.RawMessage `json:"tx"`
+}
+
+func txCommands() []*cobra.Command {
+	cmds := []*cobra.Command{
+		{
+			Use:     "Broadcast <txFile>",
+			Short:   "Broadcast a signed transaction",
+			Long:    "Broadcasts the signed transaction in <txFile>, written as JSON by `Keys SignTx` or as protobuf bytes, or simulates it with [--dry-run]",
+			Aliases: []string{"broadcast"},
+			Args:    cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				tx, _, err := readTx(args[0])
+				if err != nil {
+					return err
+				}
+
+				// Catch unsigned or incompletely signed transactions before sending them to the node
+				if err := tx.ValidateBasic(); err != nil {
+					return err
+				}
+				signerAddr, err := tx.SignerAddress()
+				if err != nil {
+					return err
+				}
+				txBz, err := codec.GetCodec().Marshal(tx)
+				if err != nil {
+					return err
+				}
+
+				if dryRun {
+					return simulateRawTx(cmd, signerAddr, txBz)
+				}
+
+				return broadcastRawTx(cmd, signerAddr, txBz)
+			},
+		},
+		{
+			Use:     "Decode <tx>",
+			Short:   "Pretty-print a serialized transaction",
+			Long:    "Prints the hash, signer and JSON representation of <tx>, which is either a file or a hex or base64 encoded transaction, e.g. as returned by the RPC. The file may contain protobuf JSON, hex, base64 or raw protobuf bytes",
+			Aliases: []string{"decode"},
+			Args:    cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				txBz, err := os.ReadFile(args[0])
+				if err != nil {
+					if !os.IsNotExist(err) {
+						return err
+					}
+					txBz = []byte(args[0])
+				}
+
+				tx, _, err := decodeTx(txBz)
+				if err != nil {
+					return fmt.Errorf("unable to decode the transaction: %w", err)
+				}
+
+				txProtoBz, err := codec.GetCodec().Marshal(tx)
+				if err != nil {
+					return err
+				}
+				txJSON, err := resultToJSON(tx)
+				if err != nil {
+					return err
+				}
+				decoded := decodedTx{
+					Hash:   coreTypes.TxHash(txProtoBz),
+					Signed: tx.GetSignature() != nil || tx.GetMultiSignature() != nil,
+					Tx:     txJSON,
+				}
+				if signerAddr, err := tx.SignerAddress(); err == nil {
+					decoded.SignerAddr = signerAddr.String()
+				}
+
+				return render(cmd, decoded)
+			},
+		},
+	}
+
+	// Add --dry-run flag to Broadcast
+	applySubcommandOptions(cmds[:1], attachDryRunFlagToSubcommands())
+
+	return cmds
+}
@@ -4,16 +4,20 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/hex"
 	"fmt"
 	"io"
 	"math/big"
+	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"golang.org/x/term"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/pokt-network/pocket/app/client/cli/flags"
 	"github.com/pokt-network/pocket/app/client/keybase"
@@ -28,10 +32,11 @@ import (
 )
 
 var (
-	kbTypeStrFromCLI        string
-	kbVaultAddrFromCLI      string
-	kbVaultTokenFromCLI     string
-	kbVaultMountPathFromCLI string
+	kbTypeStrFromCLI         string
+	kbVaultAddrFromCLI       string
+	kbVaultTokenFromCLI      string
+	kbVaultMountPathFromCLI  string
+	kbLedgerTransportFromCLI string
 )
 
 func parseEd25519PrivateKeyFromReader(reader io.Reader) (pk crypto.Ed25519PrivateKey, err error) {
@@ -53,6 +58,8 @@ func parseEd25519PrivateKeyFromReader(reader io.Reader) (pk crypto.Ed25519Privat
 	return
 }
 
+// The prompts are written to stderr, so stdout only contains the rendered result of the command
+
 // credentials reads a password from the prompt and returns the trimmed version
 //
 // If pwd is provided (via flag to the command), it uses that one instead of asking via prompt
@@ -77,10 +84,10 @@ func confirmation(pwd string) bool {
 	reader := bufio.NewReader(os.Stdin)
 
 	for {
-		fmt.Println("yes | no")
+		fmt.Fprintln(os.Stderr, "yes | no")
 		response, err := reader.ReadString('\n')
 		if err != nil {
-			fmt.Println("Error reading string: ", err.Error())
+			fmt.Fprintln(os.Stderr, "Error reading string: ", err.Error())
 			return false
 		}
 		response = strings.ToLower(strings.TrimSpace(response))
@@ -92,34 +99,28 @@ func confirmation(pwd string) bool {
 	}
 }
 
-// prepareTxBytes wraps a Message into a Transaction and signs it with the provided pk
+// prepareTxBytes wraps a Message into a Transaction and signs it on behalf of the signer provided
 //
 // returns the raw protobuf bytes of the signed transaction
-func prepareTxBytes(msg typesUtil.Message, pk crypto.PrivateKey) ([]byte, error) {
-	var err error
-	anyMsg, err := codec.GetCodec().ToAny(msg)
+func prepareTxBytes(msg typesUtil.Message, signer *txSigner) ([]byte, error) {
+	tx, err := newUnsignedTx(msg)
 	if err != nil {
 		return nil, err
 	}
 
-	tx := &coreTypes.Transaction{
-		Msg:   anyMsg,
-		Nonce: fmt.Sprintf("%d", crypto.GetNonce()),
-	}
-
 	signBytes, err := tx.SignableBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	signature, err := pk.Sign(signBytes)
+	signature, err := signer.sign(signBytes)
 	if err != nil {
 		return nil, err
 	}
 
 	tx.Signature = &coreTypes.Signature{
 		Signature: signature,
-		PublicKey: pk.PublicKey().Bytes(),
+		PublicKey: signer.publicKey.Bytes(),
 	}
 
 	bz, err := codec.GetCodec().Marshal(tx)
@@ -130,13 +131,13 @@ func prepareTxBytes(msg typesUtil.Message, pk crypto.PrivateKey) ([]byte, error)
 }
 
 // postRawTx posts a signed transaction
-func postRawTx(ctx context.Context, pk crypto.PrivateKey, j []byte) (*rpc.PostV1ClientBroadcastTxSyncResponse, error) {
+func postRawTx(ctx context.Context, signerAddr crypto.Address, j []byte) (*rpc.PostV1ClientBroadcastTxSyncResponse, error) {
 	client, err := rpc.NewClientWithResponses(flags.RemoteCLIURL)
 	if err != nil {
 		return nil, err
 	}
 	req := rpc.RawTXRequest{
-		Address:     pk.Address().String(),
+		Address:     signerAddr.String(),
 		RawHexBytes: hex.EncodeToString(j),
 	}
 
@@ -147,11 +148,213 @@ func postRawTx(ctx context.Context, pk crypto.PrivateKey, j []byte) (*rpc.PostV1
 	return resp, nil
 }
 
+// broadcastRawTx broadcasts a signed transaction and renders its hash once it is accepted by the mempool of the node
+func broadcastRawTx(cmd *cobra.Command, signerAddr crypto.Address, txBz []byte) error {
+	resp, err := postRawTx(cmd.Context(), signerAddr, txBz)
+	if err != nil {
+		return unableToConnectToRpc(err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return rpcResponseCodeUnhealthy(resp.StatusCode(), resp.Body)
+	}
+
+	return render(cmd, broadcastTxResult{
+		Hash:       coreTypes.TxHash(txBz),
+		SignerAddr: signerAddr.String(),
+	})
+}
+
+// simulateRawTx dry runs a signed transaction against the node's latest state and renders the outcome
+func simulateRawTx(cmd *cobra.Command, signerAddr crypto.Address, j []byte) error {
+	client, err := rpc.NewClientWithResponses(flags.RemoteCLIURL)
+	if err != nil {
+		return err
+	}
+	signerAddrHex := signerAddr.String()
+	req := rpc.SimulateTXRequest{
+		RawHexBytes: hex.EncodeToString(j),
+		SignerAddr:  &signerAddrHex,
+	}
+
+	resp, err := client.PostV1ClientSimulateTxWithResponse(cmd.Context(), req)
+	if err != nil {
+		return unableToConnectToRpc(err)
+	}
+	if err := renderRPCResponse(cmd, resp.HTTPResponse, resp.Body, resp.JSON200); err != nil {
+		return err
+	}
+	if resp.JSON200.ResultCode != 0 {
+		return newCLIError(errCodeTxFailed, fmt.Errorf("the transaction would fail with code %d: %s", resp.JSON200.ResultCode, resp.JSON200.Error))
+	}
+	return nil
+}
+
+// txSigner is the key a transaction command acts on behalf of. With --generate-only, the keybase is not
+// accessed and the public key is only known if it was provided with --public_key.
+type txSigner struct {
+	address   crypto.Address
+	publicKey crypto.PublicKey
+}
+
+// getTxSigner returns the signer of the transactions sent from <fromAddrHex>, reading its public key from the keybase unless --generate-only is set
+func getTxSigner(fromAddrHex string) (*txSigner, error) {
+	if generateOnly {
+		address, err := crypto.NewAddress(fromAddrHex)
+		if err != nil {
+			return nil, err
+		}
+		signer := &txSigner{address: address}
+		if signerPubKeyHex != "" {
+			publicKey, err := crypto.NewPublicKey(signerPubKeyHex)
+			if err != nil {
+				return nil, err
+			}
+			if !publicKey.Address().Equals(address) {
+				return nil, fmt.Errorf("the public key %s does not match the address %s", signerPubKeyHex, fromAddrHex)
+			}
+			signer.publicKey = publicKey
+		}
+		return signer, nil
+	}
+
+	kb, err := keybaseForCLI()
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, err := kb.GetPubKey(fromAddrHex)
+	if err != nil {
+		return nil, err
+	}
+	if err := kb.Stop(); err != nil {
+		return nil, err
+	}
+
+	readKeybasePassphrase()
+
+	return &txSigner{
+		address:   publicKey.Address(),
+		publicKey: publicKey,
+	}, nil
+}
+
+// sign signs the message with the key of the signer through the keybase, so the private key never leaves
+// the keybases that hold it outside of the CLI, e.g. a hardware wallet
+func (s *txSigner) sign(msg []byte) ([]byte, error) {
+	kb, err := keybaseForCLI()
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := kb.Sign(s.address.String(), pwd, msg)
+	if err != nil {
+		return nil, err
+	}
+	if err := kb.Stop(); err != nil {
+		return nil, err
+	}
+
+	return signature, nil
+}
+
+// submitTx renders the unsigned transaction of the message, or writes it to [--output_file], with --generate-only.
+// Otherwise, the transaction is signed and simulated with --dry-run or broadcast.
+func submitTx(cmd *cobra.Command, signer *txSigner, msg typesUtil.Message) error {
+	if generateOnly {
+		tx, err := newUnsignedTx(msg)
+		if err != nil {
+			return err
+		}
+		if outputFile == "" {
+			return render(cmd, tx)
+		}
+		if err := writeTx(tx, outputFile, true); err != nil {
+			return err
+		}
+		return render(cmd, txFileResult{SignerAddr: signer.address.String(), File: outputFile})
+	}
+
+	tx, err := prepareTxBytes(msg, signer)
+	if err != nil {
+		return err
+	}
+
+	if dryRun {
+		return simulateRawTx(cmd, signer.address, tx)
+	}
+
+	return broadcastRawTx(cmd, signer.address, tx)
+}
+
+// newUnsignedTx wraps a Message into a Transaction with a new nonce
+func newUnsignedTx(msg typesUtil.Message) (*coreTypes.Transaction, error) {
+	anyMsg, err := codec.GetCodec().ToAny(msg)
+	if err != nil {
+		return nil, err
+	}
+	return &coreTypes.Transaction{
+		Msg:   anyMsg,
+		Nonce: fmt.Sprintf("%d", crypto.GetNonce()),
+	}, nil
+}
+
+// txToJSON returns the indented protobuf JSON representation of the transaction
+func txToJSON(tx *coreTypes.Transaction) ([]byte, error) {
+	return protojson.MarshalOptions{Multiline: true, Indent: "  ", UseProtoNames: true}.Marshal(tx)
+}
+
+// decodeTx decodes a transaction serialized as protobuf JSON, hex or base64 encoded protobuf bytes or raw protobuf bytes,
+// returning whether it was serialized as JSON
+func decodeTx(txBz []byte) (tx *coreTypes.Transaction, isJSON bool, err error) {
+	tx = new(coreTypes.Transaction)
+	trimmed := bytes.TrimSpace(txBz)
+	if bytes.HasPrefix(trimmed, []byte("{")) {
+		if err := protojson.Unmarshal(trimmed, tx); err != nil {
+			return nil, false, err
+		}
+		return tx, true, nil
+	}
+	if decoded, err := hex.DecodeString(string(trimmed)); err == nil && len(decoded) > 0 {
+		txBz = decoded
+	} else if decoded, err := base64.StdEncoding.DecodeString(string(trimmed)); err == nil && len(decoded) > 0 {
+		txBz = decoded
+	}
+	if err := codec.GetCodec().Unmarshal(txBz, tx); err != nil {
+		return nil, false, err
+	}
+	return tx, false, nil
+}
+
+// readTx reads a transaction file written by the transaction commands, either as protobuf JSON or protobuf bytes
+func readTx(path string) (tx *coreTypes.Transaction, isJSON bool, err error) {
+	txBz, err := utils.ReadInput(path)
+	if err != nil {
+		return nil, false, err
+	}
+	return decodeTx(txBz)
+}
+
+// writeTx writes the transaction to the file as protobuf JSON or protobuf bytes. Unlike utils.WriteOutput,
+// the file is overwritten so a transaction file can be signed in place.
+func writeTx(tx *coreTypes.Transaction, path string, asJSON bool) error {
+	var txBz []byte
+	var err error
+	if asJSON {
+		txBz, err = txToJSON(tx)
+	} else {
+		txBz, err = codec.GetCodec().Marshal(tx)
+	}
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, txBz, 0o644)
+}
+
 func readPassphrase(currPwd string) string {
 	if strings.TrimSpace(currPwd) == "" {
-		fmt.Println("Enter Passphrase: ")
+		fmt.Fprintln(os.Stderr, "Enter Passphrase: ")
 	} else {
-		fmt.Println("Using Passphrase provided via flag")
+		fmt.Fprintln(os.Stderr, "Using Passphrase provided via flag")
 	}
 
 	return credentials(currPwd)
@@ -159,9 +362,9 @@ func readPassphrase(currPwd string) string {
 
 func readPassphraseMessage(currPwd, prompt string) string {
 	if strings.TrimSpace(currPwd) == "" {
-		fmt.Println(prompt)
+		fmt.Fprintln(os.Stderr, prompt)
 	} else {
-		fmt.Println("Using Passphrase provided via flag")
+		fmt.Fprintln(os.Stderr, "Using Passphrase provided via flag")
 	}
 
 	return credentials(currPwd)
@@ -175,7 +378,7 @@ func validateStakeAmount(amount string) error {
 
 	sr := big.NewInt(stakingRecommendationAmount)
 	if utils.BigIntLessThan(am, sr) {
-		fmt.Printf("The amount you are staking for is below the recommendation of %d POKT, would you still like to continue? y|n\n", sr.Div(sr, oneMillion).Int64())
+		fmt.Fprintf(os.Stderr, "The amount you are staking for is below the recommendation of %d POKT, would you still like to continue? y|n\n", sr.Div(sr, oneMillion).Int64())
 		if !confirmation(pwd) {
 			return fmt.Errorf("aborted")
 		}
@@ -251,19 +454,101 @@ func attachChildPwdFlagToSubcommands() []cmdOption {
 	}}
 }
 
+func attachMnemonicFlagsToSubcommands() []cmdOption {
+	return []cmdOption{func(c *cobra.Command) {
+		c.Flags().StringVar(&mnemonicPwd, "mnemonic_pwd", "", "optional BIP-39 passphrase of the mnemonic, a different passphrase derives a different key")
+		c.Flags().Uint32Var(&mnemonicIndex, "index", 0, "index of the key derived from the mnemonic")
+	}}
+}
+
+func attachMultisigFlagToSubcommands() []cmdOption {
+	return []cmdOption{func(c *cobra.Command) {
+		c.Flags().StringVar(&multisigPath, "multisig", "", "multisig file created by Keys CreateMultisig, adds a partial signature of the multisig instead of signing the transaction with the key")
+	}}
+}
+
 func attachHeightFlagToSubcommands() []cmdOption {
 	return []cmdOption{func(c *cobra.Command) {
 		c.Flags().Int64Var(&height, "height", 0, "block height to query, (default = 0, latest)")
 	}}
 }
 
+func attachBlockSelectorFlagsToSubcommands() []cmdOption {
+	return []cmdOption{func(c *cobra.Command) {
+		c.Flags().StringVar(&blockHashFlag, "block_hash", "", "query the state at the block with this hash instead of the height")
+		c.Flags().Var(&timestamp, "timestamp", "query the state at the latest block committed at or before this RFC 3339 time (e.g. 2023-07-01T00:00:00Z) instead of the height")
+	}}
+}
+
+// optionalBlockHash returns the --block_hash flag if it was provided
+func optionalBlockHash() *string {
+	if blockHashFlag == "" {
+		return nil
+	}
+	return &blockHashFlag
+}
+
+// timestampFlag is a flag value parsing an RFC 3339 timestamp, its value is nil unless the flag was provided
+type timestampFlag struct {
+	value *time.Time
+}
+
+func (f *timestampFlag) String() string {
+	if f.value == nil {
+		return ""
+	}
+	return f.value.Format(time.RFC3339)
+}
+
+func (f *timestampFlag) Set(s string) error {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return fmt.Errorf("invalid RFC 3339 timestamp %q: %w", s, err)
+	}
+	f.value = &t
+	return nil
+}
+
+func (*timestampFlag) Type() string {
+	return "timestamp"
+}
+
 func attachPaginationFlagsToSubcommands() []cmdOption {
 	return []cmdOption{func(c *cobra.Command) {
-		c.Flags().Int64Var(&page, "page", 1, "page number to return of paginated query (default 1)")
+		c.Flags().Int64Var(&page, "page", 1, "page number to return of paginated query, pages past the last one are rejected (default 1)")
 		c.Flags().Int64Var(&per_page, "per_page", 1000, "number of results to show per page in a paginated query (default 1000, max=1000)")
 	}}
 }
 
+func attachDryRunFlagToSubcommands() []cmdOption {
+	return []cmdOption{func(c *cobra.Command) {
+		c.Flags().BoolVar(&dryRun, "dry-run", false, "simulate the transaction against the latest state to get its fee and outcome without broadcasting it")
+	}}
+}
+
+func attachGenerateOnlyFlagsToSubcommands() []cmdOption {
+	return []cmdOption{func(c *cobra.Command) {
+		c.Flags().BoolVar(&generateOnly, "generate-only", false, "write the unsigned transaction as JSON to [--output_file] or stdout instead of signing it, to be signed offline with Keys SignTx")
+		c.Flags().StringVar(&signerPubKeyHex, "public_key", "", "public key of <fromAddr>, required to generate stake transactions with --generate-only")
+		c.Flags().StringVar(&outputFile, "output_file", "", "output file to write results to")
+	}}
+}
+
+func attachActorFilterFlagsToSubcommands() []cmdOption {
+	return []cmdOption{func(c *cobra.Command) {
+		c.Flags().StringVar(&statusFilter, "status", "", "only return actors with this stake status: staked, unstaking or unstaked")
+		c.Flags().StringVar(&chainFilter, "chain", "", "only return actors staked for this relay chain (not supported for validators)")
+		c.Flags().StringVar(&minStakeFilter, "min_stake", "", "only return actors with at least this many tokens staked")
+		c.Flags().StringVar(&outputAddressFilter, "output_address", "", "only return actors with this output address")
+	}}
+}
+
+func attachColumnsFlagToSubcommands() []cmdOption {
+	return []cmdOption{func(c *cobra.Command) {
+		c.Flags().StringSliceVar(&columns, "columns", nil, "comma separated columns of the table rendered with --output table, e.g. address,staked_amount (default all)")
+	}}
+}
+
 func attachSortFlagToSubcommands() []cmdOption {
 	return []cmdOption{func(c *cobra.Command) {
 		c.Flags().StringVar(&sort, "sort", "desc", "order to sort results in  ('asc' or default 'desc')")
@@ -272,10 +557,11 @@ func attachSortFlagToSubcommands() []cmdOption {
 
 func attachKeybaseFlagsToSubcommands() []cmdOption {
 	return []cmdOption{func(c *cobra.Command) {
-		c.Flags().StringVar(&kbTypeStrFromCLI, "keybase", "", "keybase type used by the cmd, options are: file, vault")
+		c.Flags().StringVar(&kbTypeStrFromCLI, "keybase", "", "keybase type used by the cmd, options are: file, vault, ledger")
 		c.Flags().StringVar(&kbVaultAddrFromCLI, "vault-addr", "", "Vault address used by the cmd. Defaults to https://127.0.0.1:8200 or VAULT_ADDR env var")
 		c.Flags().StringVar(&kbVaultTokenFromCLI, "vault-token", "", "Vault token used by the cmd. Defaults to VAULT_TOKEN env var")
 		c.Flags().StringVar(&kbVaultMountPathFromCLI, "vault-mount", "", "Vault mount path used by the cmd. Defaults to secret")
+		c.Flags().StringVar(&kbLedgerTransportFromCLI, "ledger-transport", "", "Ledger transport used by the cmd, hid or tcp://<host>:<port> for an emulator. Defaults to hid")
 
 		// override the PersistentPreRunE to set the keybase flags before initializing the config
 		c.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
@@ -299,6 +585,9 @@ func attachKeybaseFlagsToSubcommands() []cmdOption {
 			if err := viper.BindPFlag("keybase.vault_mount_path", c.Flags().Lookup("vault-mount")); err != nil {
 				return err
 			}
+			if err := viper.BindPFlag("keybase.ledger_transport", c.Flags().Lookup("ledger-transport")); err != nil {
+				return err
+			}
 
 			// call the root PersistentPreRunE to finally initialize the config
 			if err := rootCmd.PersistentPreRunE(cmd, args); err != nil {
@@ -314,18 +603,21 @@ func keybaseForCLI() (keybase.Keybase, error) {
 	return keybase.NewKeybase(cfg.Keybase)
 }
 
-func unableToConnectToRpc(err error) error {
-	fmt.Printf("❌ Unable to connect to the RPC @ %s\n\nError: %s", boldText(flags.RemoteCLIURL), err)
-	return nil
+// readKeybasePassphrase prompts for the passphrase of the key in interactive mode, unless the keys are held
+// by a ledger device which has the user approve the signatures on the device instead
+func readKeybasePassphrase() {
+	if flags.NonInteractive || cfg.Keybase.GetType() == types.KeybaseType_LEDGER {
+		return
+	}
+	pwd = readPassphrase(pwd)
 }
 
-func rpcResponseCodeUnhealthy(statusCode int, response []byte) error {
-	fmt.Printf("❌ RPC reporting unhealthy status HTTP %d @ %s\n\n%s", statusCode, boldText(flags.RemoteCLIURL), response)
-	return nil
+func unableToConnectToRpc(err error) error {
+	return newCLIError(errCodeRPCUnreachable, fmt.Errorf("unable to connect to the RPC @ %s: %w", flags.RemoteCLIURL, err))
 }
 
-func boldText[T string | []byte](s T) string {
-	return fmt.Sprintf("\033[1m%s\033[0m", s)
+func rpcResponseCodeUnhealthy(statusCode int, response []byte) error {
+	return newCLIError(errCodeRPCError, fmt.Errorf("RPC reporting unhealthy status HTTP %d @ %s: %s", statusCode, flags.RemoteCLIURL, bytes.TrimSpace(response)))
 }
 
 // confirmPassphrase should be used when a new key is being created or a raw unarmored key is being imported
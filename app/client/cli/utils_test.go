@@ -2,13 +2,19 @@ package cli
 
 import (
 	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"path/filepath"
 	"reflect"
 	"strconv"
 	"strings"
 	"testing"
 
+	"github.com/pokt-network/pocket/shared/codec"
 	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/utility/types"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 )
 
 func Test_parseEd25519PrivateKeyFromReader_NilInput(t *testing.T) {
@@ -35,3 +41,65 @@ func Test_parseEd25519PrivateKeyFromReader_ValidPrivateKey(t *testing.T) {
 		t.Errorf("parseEd25519PrivateKeyFromFile() = %v, want %v", gotPk, validPk)
 	}
 }
+
+func Test_decodeTx(t *testing.T) {
+	pk, err := crypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	tx, err := newUnsignedTx(&types.MessageSend{
+		FromAddress: pk.Address(),
+		ToAddress:   pk.Address(),
+		Amount:      "1000",
+	})
+	require.NoError(t, err)
+	require.NoError(t, tx.Sign(pk))
+
+	txBz, err := codec.GetCodec().Marshal(tx)
+	require.NoError(t, err)
+	txJSON, err := txToJSON(tx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		txBz   []byte
+		isJSON bool
+	}{
+		{"protobuf bytes", txBz, false},
+		{"hex", []byte(hex.EncodeToString(txBz)), false},
+		{"base64", []byte(base64.StdEncoding.EncodeToString(txBz) + "\n"), false},
+		{"json", txJSON, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decoded, isJSON, err := decodeTx(tt.txBz)
+			require.NoError(t, err)
+			require.Equal(t, tt.isJSON, isJSON)
+			require.True(t, proto.Equal(tx, decoded))
+			require.NoError(t, decoded.ValidateBasic(), "the signature must survive the round trip")
+		})
+	}
+}
+
+func Test_readTx_writeTx(t *testing.T) {
+	pk, err := crypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	tx, err := newUnsignedTx(&types.MessageUnstake{
+		Address: pk.Address(),
+		Signer:  pk.Address(),
+	})
+	require.NoError(t, err)
+
+	// The unsigned transaction written by --generate-only is signed offline and written back as JSON
+	path := filepath.Join(t.TempDir(), "tx.json")
+	require.NoError(t, writeTx(tx, path, true))
+	unsigned, isJSON, err := readTx(path)
+	require.NoError(t, err)
+	require.True(t, isJSON)
+	require.Error(t, unsigned.ValidateBasic())
+
+	require.NoError(t, unsigned.Sign(pk))
+	require.NoError(t, writeTx(unsigned, path, isJSON))
+	signed, _, err := readTx(path)
+	require.NoError(t, err)
+	require.NoError(t, signed.ValidateBasic())
+	require.Equal(t, tx.GetNonce(), signed.GetNonce())
+}
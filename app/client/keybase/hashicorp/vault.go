@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/vault/api"
 	"github.com/pokt-network/pocket/runtime/configs"
 	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/crypto/bip39"
 	"github.com/pokt-network/pocket/shared/crypto/slip"
 )
 
@@ -236,6 +237,42 @@ func (vk *vaultKeybase) DeriveChildFromKey(masterAddrHex, passphrase string, chi
 	return vk.DeriveChildFromSeed(seed, childIndex, childPassphrase, childHint, shouldStore)
 }
 
+// CreateFromMnemonic generates a new BIP-39 mnemonic and writes the key derived from it at the given index to vault, see RecoverFromMnemonic
+func (vk *vaultKeybase) CreateFromMnemonic(numWords int, mnemonicPassphrase string, childIndex uint32, passphrase, hint string) (string, crypto.KeyPair, error) {
+	mnemonic, err := bip39.NewMnemonic(numWords)
+	if err != nil {
+		return "", nil, err
+	}
+
+	keyPair, err := vk.RecoverFromMnemonic(mnemonic, mnemonicPassphrase, childIndex, passphrase, hint)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return mnemonic, keyPair, nil
+}
+
+// RecoverFromMnemonic derives the key at the given index from the seed of the BIP-39 mnemonic and the optional mnemonic passphrase
+// and writes it to vault encrypted with the passphrase
+func (vk *vaultKeybase) RecoverFromMnemonic(mnemonic, mnemonicPassphrase string, childIndex uint32, passphrase, hint string) (crypto.KeyPair, error) {
+	seed, err := bip39.NewSeed(mnemonic, mnemonicPassphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	childKey, err := slip.DeriveChild(fmt.Sprintf(slip.PoktAccountPathFormat, childIndex), seed)
+	if err != nil {
+		return nil, err
+	}
+
+	privKeyHex, err := childKey.ExportString("") // No passphrase by default
+	if err != nil {
+		return nil, err
+	}
+
+	return vk.ImportFromString(privKeyHex, passphrase, hint)
+}
+
 // ExportPrivString exports a private key as a hex string
 func (vk *vaultKeybase) ExportPrivString(address, passphrase string) (string, error) {
 	privKey, err := vk.Get(address)
@@ -167,4 +167,17 @@ func TestVaultKeybase(t *testing.T) {
 
 	err = vk.Delete(keyPair.GetAddressString(), "new-passphrase")
 	require.NoError(t, err, "error deleting keypair")
+
+	// Test CreateFromMnemonic
+	mnemonic, mnemonicKeyPair, err := vk.CreateFromMnemonic(24, "mnemonic-passphrase", 0, "passphrase", "hint")
+	require.NoError(t, err, "error creating keypair from mnemonic")
+	err = vk.Delete(mnemonicKeyPair.GetAddressString(), "passphrase")
+	require.NoError(t, err, "error deleting keypair")
+
+	// Test RecoverFromMnemonic
+	recoveredKeyPair, err := vk.RecoverFromMnemonic(mnemonic, "mnemonic-passphrase", 0, "passphrase", "hint")
+	require.NoError(t, err, "error recovering keypair from mnemonic")
+	assert.Equal(t, mnemonicKeyPair.GetAddressString(), recoveredKeyPair.GetAddressString())
+	_, err = vk.GetPrivKey(recoveredKeyPair.GetAddressString(), "passphrase")
+	require.NoError(t, err, "error getting private key")
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/dgraph-io/badger/v3"
 	"github.com/pokt-network/pocket/app/client/keybase/hashicorp"
+	"github.com/pokt-network/pocket/app/client/keybase/ledger"
 	"github.com/pokt-network/pocket/runtime/configs"
 	"github.com/pokt-network/pocket/runtime/configs/types"
 	"github.com/pokt-network/pocket/shared/crypto"
@@ -31,6 +32,12 @@ type Keybase interface {
 	DeriveChildFromKey(masterAddrHex, passphrase string, childIndex uint32, childPassphrase, childHint string, shouldStore bool) (crypto.KeyPair, error)
 	DeriveChildFromSeed(seed []byte, childIndex uint32, childPassphrase, childHint string, shouldStore bool) (crypto.KeyPair, error)
 
+	// BIP-39 Mnemonics
+	// Generate a new mnemonic of `numWords` words and store the key derived from it at the given index, the mnemonic passphrase is optional
+	CreateFromMnemonic(numWords int, mnemonicPassphrase string, childIndex uint32, passphrase, hint string) (mnemonic string, keyPair crypto.KeyPair, err error)
+	// Store the key derived at the given index from the mnemonic provided and the optional mnemonic passphrase
+	RecoverFromMnemonic(mnemonic, mnemonicPassphrase string, childIndex uint32, passphrase, hint string) (crypto.KeyPair, error)
+
 	// Accessors
 	Get(address string) (crypto.KeyPair, error)
 	GetPubKey(address string) (crypto.PublicKey, error)
@@ -63,6 +70,8 @@ func NewKeybase(conf *configs.KeybaseConfig) (Keybase, error) {
 		return NewBadgerKeybase(conf.FilePath)
 	case types.KeybaseType_VAULT:
 		return hashicorp.NewVaultKeybase(conf)
+	case types.KeybaseType_LEDGER:
+		return ledger.NewLedgerKeybase(conf)
 	default:
 		return nil, fmt.Errorf("invalid keybase type: %d", conf.Type)
 	}
@@ -2,6 +2,7 @@ package keybase
 
 import (
 	"encoding/hex"
+	"strings"
 	"testing"
 
 	"github.com/pokt-network/pocket/runtime/test_artifacts/keygen"
@@ -17,6 +18,13 @@ const (
 	testAddr          = "26e16ccab7a898400022476332e2972b8199f2f9"
 	testChildAddrIdx1 = "8b83d7057df7ac1d20a2f0aa0edadf206eb6764d"
 
+	// BIP-39 mnemonic
+	testMnemonic                       = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	testMnemonicPassphrase             = "TREZOR"
+	testMnemonicAddrIdx0               = "bd269de25aabd18550a68c8ccb0d2f1995d5695a"
+	testMnemonicAddrIdx1               = "bfd3cfb855321b70e8c15df04e20aae57bd83cd3"
+	testMnemonicWithPassphraseAddrIdx0 = "0d44038d3af8775082843b8d800ec84fd033aa11"
+
 	// Other
 	testPassphrase    = "Testing@Testing123"
 	testNewPassphrase = "321gnitsetgnitset"
@@ -432,6 +440,83 @@ func TestKeybase_StoreChildFromSeed(t *testing.T) {
 	require.Equal(t, childKey.GetAddressString(), testChildAddrIdx1)
 }
 
+func TestKeybase_RecoverFromMnemonic(t *testing.T) {
+	tests := []struct {
+		name               string
+		mnemonic           string
+		mnemonicPassphrase string
+		childIndex         uint32
+		wantAddr           string
+		wantErr            bool
+	}{
+		{
+			name:     "key is recovered from the mnemonic",
+			mnemonic: testMnemonic,
+			wantAddr: testMnemonicAddrIdx0,
+		},
+		{
+			name:       "key is recovered at the given index",
+			mnemonic:   testMnemonic,
+			childIndex: 1,
+			wantAddr:   testMnemonicAddrIdx1,
+		},
+		{
+			name:               "mnemonic passphrase derives a different key",
+			mnemonic:           testMnemonic,
+			mnemonicPassphrase: testMnemonicPassphrase,
+			wantAddr:           testMnemonicWithPassphraseAddrIdx0,
+		},
+		{
+			name:     "invalid mnemonic is rejected",
+			mnemonic: testMnemonic + " abandon",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := initDB(t)
+			defer stopDB(t, db)
+
+			kp, err := db.RecoverFromMnemonic(tt.mnemonic, tt.mnemonicPassphrase, tt.childIndex, testPassphrase, testHint)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantAddr, kp.GetAddressString())
+
+			// The key is stored encrypted with the passphrase
+			_, err = db.GetPrivKey(tt.wantAddr, testNewPassphrase)
+			require.Error(t, err)
+			privKey, err := db.GetPrivKey(tt.wantAddr, testPassphrase)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantAddr, privKey.Address().String())
+		})
+	}
+}
+
+func TestKeybase_CreateFromMnemonic(t *testing.T) {
+	db := initDB(t)
+	defer stopDB(t, db)
+
+	mnemonic, kp, err := db.CreateFromMnemonic(12, testMnemonicPassphrase, 0, testPassphrase, testHint)
+	require.NoError(t, err)
+	require.Len(t, strings.Fields(mnemonic), 12)
+
+	key, err := db.Get(kp.GetAddressString())
+	require.NoError(t, err)
+	require.Equal(t, kp.GetPublicKey(), key.GetPublicKey())
+
+	require.NoError(t, db.Delete(kp.GetAddressString(), testPassphrase))
+	recovered, err := db.RecoverFromMnemonic(mnemonic, testMnemonicPassphrase, 0, testPassphrase, testHint)
+	require.NoError(t, err)
+	require.Equal(t, kp.GetAddressString(), recovered.GetAddressString())
+
+	_, _, err = db.CreateFromMnemonic(10, "", 0, testPassphrase, testHint)
+	require.Error(t, err)
+}
+
 func initDB(t *testing.T) Keybase {
 	db, err := NewKeybaseInMemory()
 	require.NoError(t, err)
@@ -9,6 +9,7 @@ import (
 
 	"github.com/dgraph-io/badger/v3"
 	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/crypto/bip39"
 	"github.com/pokt-network/pocket/shared/crypto/slip"
 	"github.com/pokt-network/pocket/shared/utils"
 )
@@ -308,6 +309,44 @@ func (keybase *badgerKeybase) DeriveChildFromKey(masterAddrHex, passphrase strin
 	return keybase.DeriveChildFromSeed(seed, childIndex, childPassphrase, childHint, shouldStore)
 }
 
+// CreateFromMnemonic generates a new BIP-39 mnemonic and stores the key derived from it at the given index, see RecoverFromMnemonic
+// Returns the mnemonic, which must be kept safe to recover the key, the KeyPair created and any error
+func (keybase *badgerKeybase) CreateFromMnemonic(numWords int, mnemonicPassphrase string, childIndex uint32, passphrase, hint string) (string, crypto.KeyPair, error) {
+	mnemonic, err := bip39.NewMnemonic(numWords)
+	if err != nil {
+		return "", nil, err
+	}
+
+	keyPair, err := keybase.RecoverFromMnemonic(mnemonic, mnemonicPassphrase, childIndex, passphrase, hint)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return mnemonic, keyPair, nil
+}
+
+// RecoverFromMnemonic derives the key at the given index from the seed of the BIP-39 mnemonic and the optional mnemonic passphrase
+// and stores it in the DB encrypted with the passphrase, as ImportFromString does
+// Returns the KeyPair stored and any error
+func (keybase *badgerKeybase) RecoverFromMnemonic(mnemonic, mnemonicPassphrase string, childIndex uint32, passphrase, hint string) (crypto.KeyPair, error) {
+	seed, err := bip39.NewSeed(mnemonic, mnemonicPassphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	childKey, err := slip.DeriveChild(fmt.Sprintf(slip.PoktAccountPathFormat, childIndex), seed)
+	if err != nil {
+		return nil, err
+	}
+
+	privKeyHex, err := childKey.ExportString("") // No passphrase by default
+	if err != nil {
+		return nil, err
+	}
+
+	return keybase.ImportFromString(privKeyHex, passphrase, hint)
+}
+
 // ExportPrivString exports the raw private key string of the given address
 func (keybase *badgerKeybase) ExportPrivString(address, passphrase string) (string, error) {
 	kp, err := keybase.Get(address)
@@ -0,0 +1,173 @@
+package ledger
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// APDU commands of the Pocket application of the Ledger device
+// Ref: https://developers.ledger.com/docs/transport/open-source/apdu
+const (
+	claPocket = byte(0xE0)
+
+	insGetVersion   = byte(0x01)
+	insGetPublicKey = byte(0x02)
+	insSign         = byte(0x03)
+
+	// GET_PUBLIC_KEY: whether the device shows the address to the user before returning it
+	p1Silent  = byte(0x00)
+	p1Display = byte(0x01)
+
+	// SIGN: the payload is split in chunks, the first one starting with the serialized derivation path
+	p1FirstChunk = byte(0x00)
+	p1NextChunk  = byte(0x80)
+	p2LastChunk  = byte(0x00)
+	p2MoreChunks = byte(0x80)
+
+	// The payload of an APDU command is at most 255 bytes as its length is encoded in a single byte
+	maxApduPayloadLen = 255
+	apduHeaderLen     = 5
+
+	hardenedKeyOffset = uint32(1 << 31)
+	// m/44'/635'/<index>' where 635 is the SLIP-0044 coin type of Pocket
+	purposeBIP44  = uint32(44)
+	coinTypePokt  = uint32(635)
+	maxPathLength = 10
+)
+
+// Status words terminating the responses of the device
+const (
+	swOK                 = uint16(0x9000)
+	swUserRejected       = uint16(0x6985)
+	swInvalidData        = uint16(0x6A80)
+	swWrongP1P2          = uint16(0x6B00)
+	swInsNotSupported    = uint16(0x6D00)
+	swClaNotSupported    = uint16(0x6E00)
+	swDeviceLocked       = uint16(0x5515)
+	swWrongResponseLen   = uint16(0x6700)
+	swConditionsNotValid = uint16(0x6986)
+)
+
+var (
+	ErrUserRejected       = errors.New("the request was rejected on the ledger device")
+	ErrDeviceLocked       = errors.New("the ledger device is locked")
+	ErrAppNotOpen         = errors.New("the Pocket application is not open on the ledger device")
+	ErrInvalidResponse    = errors.New("invalid response from the ledger device")
+	ErrNotSupported       = errors.New("not supported by the ledger keybase: the private keys never leave the device")
+	ErrAddressNotOnDevice = errors.New("address not found among the accounts of the ledger device")
+)
+
+// apduError is returned for the status words without a dedicated error
+type apduError struct {
+	sw uint16
+}
+
+func (e *apduError) Error() string {
+	switch e.sw {
+	case swInvalidData:
+		return "ledger device error: invalid data"
+	case swWrongP1P2:
+		return "ledger device error: wrong P1/P2"
+	case swInsNotSupported:
+		return "ledger device error: instruction not supported"
+	case swWrongResponseLen:
+		return "ledger device error: wrong length"
+	case swConditionsNotValid:
+		return "ledger device error: conditions of use not satisfied"
+	}
+	return fmt.Sprintf("ledger device error: status word 0x%04X", e.sw)
+}
+
+// errFromStatusWord maps the status word of a response to an error, nil for swOK
+func errFromStatusWord(sw uint16) error {
+	switch sw {
+	case swOK:
+		return nil
+	case swUserRejected:
+		return ErrUserRejected
+	case swDeviceLocked:
+		return ErrDeviceLocked
+	case swClaNotSupported:
+		return ErrAppNotOpen
+	default:
+		return &apduError{sw: sw}
+	}
+}
+
+// newAPDU serializes a command: CLA | INS | P1 | P2 | Lc | payload
+func newAPDU(ins, p1, p2 byte, payload []byte) []byte {
+	apdu := make([]byte, apduHeaderLen, apduHeaderLen+len(payload))
+	apdu[0] = claPocket
+	apdu[1] = ins
+	apdu[2] = p1
+	apdu[3] = p2
+	apdu[4] = byte(len(payload))
+	return append(apdu, payload...)
+}
+
+// splitResponse separates the data of a response from its trailing status word
+func splitResponse(resp []byte) ([]byte, error) {
+	if len(resp) < 2 {
+		return nil, ErrInvalidResponse
+	}
+	sw := binary.BigEndian.Uint16(resp[len(resp)-2:])
+	if err := errFromStatusWord(sw); err != nil {
+		return nil, err
+	}
+	return resp[:len(resp)-2], nil
+}
+
+// accountPath returns the hardened m/44'/635'/<index>' derivation path of an account
+func accountPath(index uint32) []uint32 {
+	return []uint32{
+		purposeBIP44 + hardenedKeyOffset,
+		coinTypePokt + hardenedKeyOffset,
+		index + hardenedKeyOffset,
+	}
+}
+
+// serializePath encodes a derivation path as its number of components followed by the big endian components
+func serializePath(path []uint32) []byte {
+	bz := make([]byte, 1+4*len(path))
+	bz[0] = byte(len(path))
+	for i, component := range path {
+		binary.BigEndian.PutUint32(bz[1+4*i:], component)
+	}
+	return bz
+}
+
+// deserializePath is the inverse of serializePath, it returns the path and the remaining bytes
+func deserializePath(bz []byte) ([]uint32, []byte, error) {
+	if len(bz) == 0 {
+		return nil, nil, &apduError{sw: swInvalidData}
+	}
+	n := int(bz[0])
+	if n == 0 || n > maxPathLength || len(bz) < 1+4*n {
+		return nil, nil, &apduError{sw: swInvalidData}
+	}
+	path := make([]uint32, n)
+	for i := range path {
+		path[i] = binary.BigEndian.Uint32(bz[1+4*i:])
+	}
+	return path, bz[1+4*n:], nil
+}
+
+// signChunks splits the payload of a SIGN command, the serialized path followed by the message, into APDUs
+func signChunks(path []uint32, msg []byte) [][]byte {
+	payload := append(serializePath(path), msg...)
+	apdus := make([][]byte, 0, len(payload)/maxApduPayloadLen+1)
+	for offset := 0; offset < len(payload); offset += maxApduPayloadLen {
+		end := offset + maxApduPayloadLen
+		p1, p2 := p1NextChunk, p2MoreChunks
+		if offset == 0 {
+			p1 = p1FirstChunk
+		}
+		if end >= len(payload) {
+			end = len(payload)
+			p2 = p2LastChunk
+		}
+		apdus = append(apdus, newAPDU(insSign, p1, p2, payload[offset:end]))
+	}
+	return apdus
+}
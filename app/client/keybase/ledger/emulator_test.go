@@ -0,0 +1,166 @@
+package ledger
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/crypto/slip"
+	"github.com/stretchr/testify/require"
+)
+
+// emulator is a software stand-in for the Pocket application of a Ledger device: it derives the accounts
+// from a seed and serves the APDU commands over TCP with the framing of the Speculos emulator.
+type emulator struct {
+	seed     []byte
+	listener net.Listener
+
+	m sync.Mutex
+	// Whether the user rejects the requests made to the device
+	reject bool
+	// Payload of the SIGN command being received
+	signPayload []byte
+}
+
+func newEmulator(t *testing.T, seed []byte) *emulator {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	e := &emulator{seed: seed, listener: listener}
+	go e.serve()
+	t.Cleanup(func() { listener.Close() })
+	return e
+}
+
+func (e *emulator) transport() string {
+	return transportTCPPrefix + e.listener.Addr().String()
+}
+
+func (e *emulator) setReject(reject bool) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	e.reject = reject
+}
+
+func (e *emulator) serve() {
+	for {
+		conn, err := e.listener.Accept()
+		if err != nil {
+			return
+		}
+		go e.handleConn(conn)
+	}
+}
+
+func (e *emulator) handleConn(conn net.Conn) {
+	defer conn.Close()
+	for {
+		lenBz := make([]byte, 4)
+		if _, err := io.ReadFull(conn, lenBz); err != nil {
+			return
+		}
+		apdu := make([]byte, binary.BigEndian.Uint32(lenBz))
+		if _, err := io.ReadFull(conn, apdu); err != nil {
+			return
+		}
+
+		data, sw := e.handleAPDU(apdu)
+		resp := make([]byte, 4, 4+len(data)+2)
+		binary.BigEndian.PutUint32(resp, uint32(len(data)))
+		resp = append(resp, data...)
+		resp = binary.BigEndian.AppendUint16(resp, sw)
+		if _, err := conn.Write(resp); err != nil {
+			return
+		}
+	}
+}
+
+func (e *emulator) handleAPDU(apdu []byte) ([]byte, uint16) {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	if len(apdu) < apduHeaderLen || int(apdu[4]) != len(apdu)-apduHeaderLen {
+		return nil, swWrongResponseLen
+	}
+	if apdu[0] != claPocket {
+		return nil, swClaNotSupported
+	}
+	ins, p1, p2, payload := apdu[1], apdu[2], apdu[3], apdu[apduHeaderLen:]
+
+	switch ins {
+	case insGetVersion:
+		return []byte{0, 1, 0}, swOK
+	case insGetPublicKey:
+		if p1 != p1Silent && p1 != p1Display {
+			return nil, swWrongP1P2
+		}
+		if p1 == p1Display && e.reject {
+			return nil, swUserRejected
+		}
+		path, _, err := deserializePath(payload)
+		if err != nil {
+			return nil, swInvalidData
+		}
+		kp, err := e.derive(path)
+		if err != nil {
+			return nil, swInvalidData
+		}
+		return kp.GetPublicKey().Bytes(), swOK
+	case insSign:
+		switch p1 {
+		case p1FirstChunk:
+			e.signPayload = append([]byte{}, payload...)
+		case p1NextChunk:
+			if e.signPayload == nil {
+				return nil, swConditionsNotValid
+			}
+			e.signPayload = append(e.signPayload, payload...)
+		default:
+			return nil, swWrongP1P2
+		}
+		if p2 == p2MoreChunks {
+			return nil, swOK
+		}
+
+		signPayload := e.signPayload
+		e.signPayload = nil
+		if e.reject {
+			return nil, swUserRejected
+		}
+		path, msg, err := deserializePath(signPayload)
+		if err != nil {
+			return nil, swInvalidData
+		}
+		kp, err := e.derive(path)
+		if err != nil {
+			return nil, swInvalidData
+		}
+		privKey, err := kp.Unarmour("")
+		if err != nil {
+			return nil, swInvalidData
+		}
+		sig, err := privKey.Sign(msg)
+		if err != nil {
+			return nil, swInvalidData
+		}
+		return sig, swOK
+	default:
+		return nil, swInsNotSupported
+	}
+}
+
+// derive only supports the m/44'/635'/<index>' paths of the Pocket accounts
+func (e *emulator) derive(path []uint32) (crypto.KeyPair, error) {
+	if len(path) != 3 ||
+		path[0] != purposeBIP44+hardenedKeyOffset ||
+		path[1] != coinTypePokt+hardenedKeyOffset ||
+		path[2] < hardenedKeyOffset {
+		return nil, fmt.Errorf("unsupported derivation path")
+	}
+	return slip.DeriveChild(fmt.Sprintf(slip.PoktAccountPathFormat, path[2]-hardenedKeyOffset), e.seed)
+}
@@ -0,0 +1,100 @@
+package ledger
+
+import (
+	"encoding/binary"
+	"io"
+	"sync"
+)
+
+// Framing of the APDUs over the USB HID reports of the Ledger devices: every 64 bytes report starts
+// with the channel, the tag and the sequence number of the report. The first report of a message
+// also carries the length of the APDU.
+// Ref: https://github.com/LedgerHQ/ledger-live/tree/develop/libs/ledgerjs/packages/devices/src/hid-framing.ts
+const (
+	hidReportSize = 64
+	hidChannel    = uint16(0x0101)
+	hidTagAPDU    = byte(0x05)
+
+	hidHeaderLen = 5 // channel (2) | tag (1) | sequence (2)
+
+	ledgerVendorID  = 0x2c97
+	ledgerUsagePage = 0xffa0
+)
+
+// hidDevice is the raw HID device, a report is read or written at a time
+type hidDevice interface {
+	io.ReadWriteCloser
+}
+
+var _ Transport = &hidTransport{}
+
+type hidTransport struct {
+	m      sync.Mutex
+	device hidDevice
+}
+
+func (t *hidTransport) Exchange(apdu []byte) ([]byte, error) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	for _, report := range wrapHIDReports(apdu) {
+		if _, err := t.device.Write(report); err != nil {
+			return nil, err
+		}
+	}
+	return readHIDReports(t.device)
+}
+
+func (t *hidTransport) Close() error {
+	return t.device.Close()
+}
+
+// wrapHIDReports splits a message into the zero padded reports sent to the device
+func wrapHIDReports(msg []byte) [][]byte {
+	data := make([]byte, 2, 2+len(msg))
+	binary.BigEndian.PutUint16(data, uint16(len(msg)))
+	data = append(data, msg...)
+
+	var reports [][]byte
+	for seq := uint16(0); len(data) > 0 || seq == 0; seq++ {
+		report := make([]byte, hidReportSize)
+		binary.BigEndian.PutUint16(report[0:], hidChannel)
+		report[2] = hidTagAPDU
+		binary.BigEndian.PutUint16(report[3:], seq)
+		n := copy(report[hidHeaderLen:], data)
+		data = data[n:]
+		reports = append(reports, report)
+	}
+	return reports
+}
+
+// readHIDReports reads the reports of a response and reassembles the message they carry
+func readHIDReports(r io.Reader) ([]byte, error) {
+	var (
+		msg    []byte
+		msgLen = -1
+	)
+	for seq := uint16(0); msgLen < 0 || len(msg) < msgLen; seq++ {
+		report := make([]byte, hidReportSize)
+		n, err := r.Read(report)
+		if err != nil {
+			return nil, err
+		}
+		if n < hidHeaderLen ||
+			binary.BigEndian.Uint16(report[0:]) != hidChannel ||
+			report[2] != hidTagAPDU ||
+			binary.BigEndian.Uint16(report[3:]) != seq {
+			return nil, ErrInvalidResponse
+		}
+		data := report[hidHeaderLen:n]
+		if seq == 0 {
+			if len(data) < 2 {
+				return nil, ErrInvalidResponse
+			}
+			msgLen = int(binary.BigEndian.Uint16(data))
+			data = data[2:]
+		}
+		msg = append(msg, data...)
+	}
+	return msg[:msgLen], nil
+}
@@ -0,0 +1,92 @@
+//go:build linux
+
+package ledger
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const sysClassHIDRaw = "/sys/class/hidraw"
+
+// newHIDTransport opens the first Ledger device found among the hidraw devices of the kernel
+func newHIDTransport() (*hidTransport, error) {
+	devices, err := filepath.Glob(filepath.Join(sysClassHIDRaw, "hidraw*"))
+	if err != nil {
+		return nil, err
+	}
+	for _, device := range devices {
+		if !isLedgerHIDRaw(device) {
+			continue
+		}
+		f, err := os.OpenFile(filepath.Join("/dev", filepath.Base(device)), os.O_RDWR, 0)
+		if err != nil {
+			return nil, fmt.Errorf("opening the ledger device: %w", err)
+		}
+		return &hidTransport{device: &hidRawDevice{f: f}}, nil
+	}
+	return nil, errors.New("no ledger device found, make sure it is connected and unlocked")
+}
+
+// isLedgerHIDRaw checks the vendor of the device and that the interface is the one exchanging the APDUs,
+// which uses the 0xFFA0 vendor defined usage page
+func isLedgerHIDRaw(sysPath string) bool {
+	uevent, err := os.Open(filepath.Join(sysPath, "device", "uevent"))
+	if err != nil {
+		return false
+	}
+	defer uevent.Close()
+
+	isLedger := false
+	scanner := bufio.NewScanner(uevent)
+	for scanner.Scan() {
+		// HID_ID=<bus>:<vendor>:<product>
+		hidID, ok := strings.CutPrefix(scanner.Text(), "HID_ID=")
+		if !ok {
+			continue
+		}
+		parts := strings.Split(hidID, ":")
+		if len(parts) != 3 {
+			return false
+		}
+		vendor, err := strconv.ParseUint(parts[1], 16, 32)
+		isLedger = err == nil && vendor == ledgerVendorID
+	}
+	if !isLedger {
+		return false
+	}
+
+	descriptor, err := os.ReadFile(filepath.Join(sysPath, "device", "report_descriptor"))
+	if err != nil {
+		return false
+	}
+	// Usage Page (0xFFA0): the 0x06 item is followed by the little endian usage page
+	return bytes.Contains(descriptor, []byte{0x06, ledgerUsagePage & 0xff, ledgerUsagePage >> 8})
+}
+
+// hidRawDevice prefixes the reports written with the report number, 0 as the device does not use report IDs
+type hidRawDevice struct {
+	f *os.File
+}
+
+func (d *hidRawDevice) Read(p []byte) (int, error) {
+	return d.f.Read(p)
+}
+
+func (d *hidRawDevice) Write(p []byte) (int, error) {
+	n, err := d.f.Write(append([]byte{0x00}, p...))
+	if n > 0 {
+		n--
+	}
+	return n, err
+}
+
+func (d *hidRawDevice) Close() error {
+	return d.f.Close()
+}
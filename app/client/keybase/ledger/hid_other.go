@@ -0,0 +1,10 @@
+//go:build !linux
+
+package ledger
+
+import "errors"
+
+// newHIDTransport is only supported on linux, the emulator can be reached over TCP on the other platforms
+func newHIDTransport() (*hidTransport, error) {
+	return nil, errors.New("the hid ledger transport is only supported on linux")
+}
@@ -0,0 +1,47 @@
+package ledger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLedger_HIDFraming(t *testing.T) {
+	tests := []struct {
+		name       string
+		msgLen     int
+		numReports int
+	}{
+		{name: "empty message", msgLen: 0, numReports: 1},
+		{name: "single report", msgLen: hidReportSize - hidHeaderLen - 2, numReports: 1},
+		{name: "two reports", msgLen: hidReportSize - hidHeaderLen - 1, numReports: 2},
+		{name: "maximum APDU", msgLen: apduHeaderLen + maxApduPayloadLen, numReports: 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := make([]byte, tt.msgLen)
+			for i := range msg {
+				msg[i] = byte(i)
+			}
+
+			reports := wrapHIDReports(msg)
+			require.Len(t, reports, tt.numReports)
+			for _, report := range reports {
+				require.Len(t, report, hidReportSize)
+			}
+
+			unwrapped, err := readHIDReports(bytes.NewReader(bytes.Join(reports, nil)))
+			require.NoError(t, err)
+			require.Equal(t, msg, unwrapped)
+		})
+	}
+}
+
+func TestLedger_HIDFramingWrongSequence(t *testing.T) {
+	reports := wrapHIDReports(make([]byte, 2*hidReportSize))
+	reports[0], reports[1] = reports[1], reports[0]
+
+	_, err := readHIDReports(bytes.NewReader(bytes.Join(reports, nil)))
+	require.ErrorIs(t, err, ErrInvalidResponse)
+}
@@ -0,0 +1,63 @@
+package ledger
+
+import (
+	"github.com/pokt-network/pocket/shared/crypto"
+)
+
+var _ crypto.KeyPair = &ledgerKeyPair{}
+
+// ledgerKeyPair is the public half of an account of the device, the operations on the private key are not supported
+type ledgerKeyPair struct {
+	publicKey crypto.PublicKey
+}
+
+func newLedgerKeyPair(publicKey crypto.PublicKey) *ledgerKeyPair {
+	return &ledgerKeyPair{publicKey: publicKey}
+}
+
+func (kp *ledgerKeyPair) GetPublicKey() crypto.PublicKey {
+	return kp.publicKey
+}
+
+// GetPrivArmour returns an empty string as the private key is held by the device
+func (kp *ledgerKeyPair) GetPrivArmour() string {
+	return ""
+}
+
+func (kp *ledgerKeyPair) GetAddressBytes() []byte {
+	return kp.publicKey.Address().Bytes()
+}
+
+func (kp *ledgerKeyPair) GetAddressString() string {
+	return kp.publicKey.Address().String()
+}
+
+func (kp *ledgerKeyPair) Unarmour(passphrase string) (crypto.PrivateKey, error) {
+	return nil, ErrNotSupported
+}
+
+func (kp *ledgerKeyPair) ExportString(passphrase string) (string, error) {
+	return "", ErrNotSupported
+}
+
+func (kp *ledgerKeyPair) ExportJSON(passphrase string) (string, error) {
+	return "", ErrNotSupported
+}
+
+func (kp *ledgerKeyPair) GetSeed(passphrase string) ([]byte, error) {
+	return nil, ErrNotSupported
+}
+
+// Marshal serializes the public key of the account
+func (kp *ledgerKeyPair) Marshal() ([]byte, error) {
+	return kp.publicKey.Bytes(), nil
+}
+
+func (kp *ledgerKeyPair) Unmarshal(bz []byte) error {
+	publicKey, err := crypto.NewPublicKeyFromBytes(bz)
+	if err != nil {
+		return err
+	}
+	kp.publicKey = publicKey
+	return nil
+}
@@ -0,0 +1,258 @@
+// Keybase using a Ledger hardware wallet
+package ledger
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/pokt-network/pocket/runtime/configs"
+	"github.com/pokt-network/pocket/shared/crypto"
+)
+
+const (
+	defaultNumAccounts = uint32(10)
+)
+
+// ledgerKeybase implements the Keybase interface using the Pocket application of a Ledger device: the
+// keys are the accounts of the device at the m/44'/635'/<index>' derivation paths, their private keys
+// never leave the device and every signature has to be approved on it.
+type ledgerKeybase struct {
+	transport   Transport
+	numAccounts uint32
+
+	m sync.Mutex
+	// Cache of the accounts of the device already read, by address
+	accounts map[string]uint32
+	pubKeys  map[uint32]crypto.PublicKey
+}
+
+// NewLedgerKeybase connects to the device through the transport configured and checks the Pocket application is open
+func NewLedgerKeybase(cfg *configs.KeybaseConfig) (*ledgerKeybase, error) {
+	transport, err := NewTransport(cfg.LedgerTransport)
+	if err != nil {
+		return nil, err
+	}
+	return newLedgerKeybase(transport, cfg.LedgerNumAccounts)
+}
+
+func newLedgerKeybase(transport Transport, numAccounts uint32) (*ledgerKeybase, error) {
+	if numAccounts == 0 {
+		numAccounts = defaultNumAccounts
+	}
+	lk := &ledgerKeybase{
+		transport:   transport,
+		numAccounts: numAccounts,
+		accounts:    make(map[string]uint32),
+		pubKeys:     make(map[uint32]crypto.PublicKey),
+	}
+	if _, err := lk.GetVersion(); err != nil {
+		transport.Close()
+		return nil, err
+	}
+	return lk, nil
+}
+
+// GetVersion returns the version of the Pocket application of the device
+func (lk *ledgerKeybase) GetVersion() (string, error) {
+	data, err := lk.exchange(newAPDU(insGetVersion, 0x00, 0x00, nil))
+	if err != nil {
+		return "", err
+	}
+	if len(data) < 3 {
+		return "", ErrInvalidResponse
+	}
+	return fmt.Sprintf("%d.%d.%d", data[0], data[1], data[2]), nil
+}
+
+// GetPubKeyAtIndex returns the public key of the account at the index provided. When `display` is set,
+// the device shows the address to the user so it can be checked against the one returned.
+func (lk *ledgerKeybase) GetPubKeyAtIndex(index uint32, display bool) (crypto.PublicKey, error) {
+	lk.m.Lock()
+	defer lk.m.Unlock()
+
+	if pubKey, ok := lk.pubKeys[index]; ok && !display {
+		return pubKey, nil
+	}
+
+	p1 := p1Silent
+	if display {
+		p1 = p1Display
+	}
+	data, err := lk.exchange(newAPDU(insGetPublicKey, p1, 0x00, serializePath(accountPath(index))))
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := crypto.NewPublicKeyFromBytes(data)
+	if err != nil {
+		return nil, ErrInvalidResponse
+	}
+
+	lk.pubKeys[index] = pubKey
+	lk.accounts[pubKey.Address().String()] = index
+	return pubKey, nil
+}
+
+// Debug
+func (lk *ledgerKeybase) GetBadgerDB() (*badger.DB, error) {
+	return nil, errors.New("not implemented")
+}
+
+// Close the connection to the device
+func (lk *ledgerKeybase) Stop() error {
+	return lk.transport.Close()
+}
+
+// Create is not supported: the accounts are derived by the device from its recovery phrase
+func (lk *ledgerKeybase) Create(passphrase, hint string) (crypto.KeyPair, error) {
+	return nil, ErrNotSupported
+}
+
+// ImportFromString is not supported: the keys of the device can not be imported or exported
+func (lk *ledgerKeybase) ImportFromString(privStr, passphrase, hint string) (crypto.KeyPair, error) {
+	return nil, ErrNotSupported
+}
+
+// ImportFromJSON is not supported: the keys of the device can not be imported or exported
+func (lk *ledgerKeybase) ImportFromJSON(jsonStr, passphrase string) (crypto.KeyPair, error) {
+	return nil, ErrNotSupported
+}
+
+// DeriveChildFromKey is not supported: the accounts of the device are addressed by their index
+func (lk *ledgerKeybase) DeriveChildFromKey(masterAddrHex, passphrase string, childIndex uint32, childPassphrase, childHint string, shouldStore bool) (crypto.KeyPair, error) {
+	return nil, ErrNotSupported
+}
+
+// DeriveChildFromSeed is not supported: the seed of the device never leaves it
+func (lk *ledgerKeybase) DeriveChildFromSeed(seed []byte, childIndex uint32, childPassphrase, childHint string, shouldStore bool) (crypto.KeyPair, error) {
+	return nil, ErrNotSupported
+}
+
+// CreateFromMnemonic is not supported: the mnemonic of the device is created when setting it up
+func (lk *ledgerKeybase) CreateFromMnemonic(numWords int, mnemonicPassphrase string, childIndex uint32, passphrase, hint string) (string, crypto.KeyPair, error) {
+	return "", nil, ErrNotSupported
+}
+
+// RecoverFromMnemonic is not supported: the mnemonic is recovered on the device itself
+func (lk *ledgerKeybase) RecoverFromMnemonic(mnemonic, mnemonicPassphrase string, childIndex uint32, passphrase, hint string) (crypto.KeyPair, error) {
+	return nil, ErrNotSupported
+}
+
+// Get the public key pair of the account of the device with the address provided
+func (lk *ledgerKeybase) Get(address string) (crypto.KeyPair, error) {
+	pubKey, err := lk.GetPubKey(address)
+	if err != nil {
+		return nil, err
+	}
+	return newLedgerKeyPair(pubKey), nil
+}
+
+// GetPubKey returns the public key of the account of the device with the address provided
+func (lk *ledgerKeybase) GetPubKey(address string) (crypto.PublicKey, error) {
+	index, err := lk.accountIndex(address)
+	if err != nil {
+		return nil, err
+	}
+	return lk.GetPubKeyAtIndex(index, false)
+}
+
+// GetPrivKey is not supported: the private keys never leave the device
+func (lk *ledgerKeybase) GetPrivKey(address, passphrase string) (crypto.PrivateKey, error) {
+	return nil, ErrNotSupported
+}
+
+// GetAll returns the first `ledger_num_accounts` accounts of the device
+func (lk *ledgerKeybase) GetAll() (addresses []string, keyPairs []crypto.KeyPair, err error) {
+	for index := uint32(0); index < lk.numAccounts; index++ {
+		pubKey, err := lk.GetPubKeyAtIndex(index, false)
+		if err != nil {
+			return nil, nil, err
+		}
+		addresses = append(addresses, pubKey.Address().String())
+		keyPairs = append(keyPairs, newLedgerKeyPair(pubKey))
+	}
+	return addresses, keyPairs, nil
+}
+
+// ExportPrivString is not supported: the private keys never leave the device
+func (lk *ledgerKeybase) ExportPrivString(address, passphrase string) (string, error) {
+	return "", ErrNotSupported
+}
+
+// ExportPrivJSON is not supported: the private keys never leave the device
+func (lk *ledgerKeybase) ExportPrivJSON(address, passphrase string) (string, error) {
+	return "", ErrNotSupported
+}
+
+// UpdatePassphrase is not supported: the device is protected by its PIN
+func (lk *ledgerKeybase) UpdatePassphrase(address, oldPassphrase, newPassphrase, hint string) error {
+	return ErrNotSupported
+}
+
+// Sign a message with the account of the device with the address provided, once approved on the device.
+// The passphrase is ignored as the device is unlocked with its PIN.
+func (lk *ledgerKeybase) Sign(address, passphrase string, msg []byte) ([]byte, error) {
+	index, err := lk.accountIndex(address)
+	if err != nil {
+		return nil, err
+	}
+
+	var sig []byte
+	for _, apdu := range signChunks(accountPath(index), msg) {
+		if sig, err = lk.exchange(apdu); err != nil {
+			return nil, err
+		}
+	}
+	// Only the response to the last chunk carries the signature
+	if len(sig) != ed25519.SignatureSize {
+		return nil, ErrInvalidResponse
+	}
+	return sig, nil
+}
+
+// Verify a message signature using the public key of the account of the device
+func (lk *ledgerKeybase) Verify(address string, msg, sig []byte) (bool, error) {
+	pubKey, err := lk.GetPubKey(address)
+	if err != nil {
+		return false, err
+	}
+	return pubKey.Verify(msg, sig), nil
+}
+
+// Delete is not supported: the accounts are derived by the device
+func (lk *ledgerKeybase) Delete(address, passphrase string) error {
+	return ErrNotSupported
+}
+
+// accountIndex finds the index of the account of the device with the address provided, reading the
+// public keys of the first `ledger_num_accounts` accounts of the device until it is found
+func (lk *ledgerKeybase) accountIndex(address string) (uint32, error) {
+	lk.m.Lock()
+	cached, ok := lk.accounts[address]
+	lk.m.Unlock()
+	if ok {
+		return cached, nil
+	}
+
+	for index := uint32(0); index < lk.numAccounts; index++ {
+		pubKey, err := lk.GetPubKeyAtIndex(index, false)
+		if err != nil {
+			return 0, err
+		}
+		if pubKey.Address().String() == address {
+			return index, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %s", ErrAddressNotOnDevice, address)
+}
+
+// exchange sends a command to the device and returns the data of the response
+func (lk *ledgerKeybase) exchange(apdu []byte) ([]byte, error) {
+	resp, err := lk.transport.Exchange(apdu)
+	if err != nil {
+		return nil, err
+	}
+	return splitResponse(resp)
+}
@@ -0,0 +1,166 @@
+package ledger
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/pokt-network/pocket/runtime/configs"
+	"github.com/pokt-network/pocket/runtime/configs/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/crypto/slip"
+	"github.com/stretchr/testify/require"
+)
+
+const testNumAccounts = 3
+
+var testSeed = bytes.Repeat([]byte{0x2a}, 64)
+
+func initTestLedgerKeybase(t *testing.T) (*ledgerKeybase, *emulator) {
+	t.Helper()
+	emu := newEmulator(t, testSeed)
+	lk, err := NewLedgerKeybase(&configs.KeybaseConfig{
+		Type:              types.KeybaseType_LEDGER,
+		LedgerTransport:   emu.transport(),
+		LedgerNumAccounts: testNumAccounts,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { lk.Stop() })
+	return lk, emu
+}
+
+func testAccount(t *testing.T, index uint32) crypto.KeyPair {
+	t.Helper()
+	kp, err := slip.DeriveChild(fmt.Sprintf(slip.PoktAccountPathFormat, index), testSeed)
+	require.NoError(t, err)
+	return kp
+}
+
+func TestLedgerKeybase_GetVersion(t *testing.T) {
+	lk, _ := initTestLedgerKeybase(t)
+
+	version, err := lk.GetVersion()
+	require.NoError(t, err)
+	require.Equal(t, "0.1.0", version)
+}
+
+func TestLedgerKeybase_GetAll(t *testing.T) {
+	lk, _ := initTestLedgerKeybase(t)
+
+	addresses, keyPairs, err := lk.GetAll()
+	require.NoError(t, err)
+	require.Len(t, addresses, testNumAccounts)
+	require.Len(t, keyPairs, testNumAccounts)
+	for i := range addresses {
+		expected := testAccount(t, uint32(i))
+		require.Equal(t, expected.GetAddressString(), addresses[i])
+		require.Equal(t, expected.GetPublicKey().Bytes(), keyPairs[i].GetPublicKey().Bytes())
+	}
+}
+
+func TestLedgerKeybase_GetPubKey(t *testing.T) {
+	lk, _ := initTestLedgerKeybase(t)
+	expected := testAccount(t, 2)
+
+	pubKey, err := lk.GetPubKey(expected.GetAddressString())
+	require.NoError(t, err)
+	require.Equal(t, expected.GetPublicKey().Bytes(), pubKey.Bytes())
+
+	kp, err := lk.Get(expected.GetAddressString())
+	require.NoError(t, err)
+	require.Equal(t, expected.GetAddressString(), kp.GetAddressString())
+	_, err = kp.Unarmour("")
+	require.ErrorIs(t, err, ErrNotSupported)
+}
+
+func TestLedgerKeybase_GetPubKeyNotOnDevice(t *testing.T) {
+	lk, _ := initTestLedgerKeybase(t)
+
+	// Beyond the accounts searched
+	outOfRange := testAccount(t, testNumAccounts)
+	_, err := lk.GetPubKey(outOfRange.GetAddressString())
+	require.ErrorIs(t, err, ErrAddressNotOnDevice)
+}
+
+func TestLedgerKeybase_GetPubKeyAtIndexDisplay(t *testing.T) {
+	lk, emu := initTestLedgerKeybase(t)
+	expected := testAccount(t, testNumAccounts+1)
+
+	pubKey, err := lk.GetPubKeyAtIndex(testNumAccounts+1, true)
+	require.NoError(t, err)
+	require.Equal(t, expected.GetPublicKey().Bytes(), pubKey.Bytes())
+
+	emu.setReject(true)
+	_, err = lk.GetPubKeyAtIndex(testNumAccounts+1, true)
+	require.ErrorIs(t, err, ErrUserRejected)
+}
+
+func TestLedgerKeybase_SignMessage(t *testing.T) {
+	lk, _ := initTestLedgerKeybase(t)
+	account := testAccount(t, 1)
+
+	tests := []struct {
+		name   string
+		msgLen int
+	}{
+		{name: "single chunk", msgLen: 32},
+		{name: "exactly one chunk", msgLen: maxApduPayloadLen - len(serializePath(accountPath(1)))},
+		{name: "several chunks", msgLen: 3*maxApduPayloadLen + 17},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := bytes.Repeat([]byte{0x07}, tt.msgLen)
+
+			sig, err := lk.Sign(account.GetAddressString(), "", msg)
+			require.NoError(t, err)
+			require.True(t, account.GetPublicKey().Verify(msg, sig))
+
+			valid, err := lk.Verify(account.GetAddressString(), msg, sig)
+			require.NoError(t, err)
+			require.True(t, valid)
+		})
+	}
+}
+
+func TestLedgerKeybase_SignMessageRejected(t *testing.T) {
+	lk, emu := initTestLedgerKeybase(t)
+	account := testAccount(t, 0)
+	emu.setReject(true)
+
+	_, err := lk.Sign(account.GetAddressString(), "", []byte("message"))
+	require.ErrorIs(t, err, ErrUserRejected)
+}
+
+func TestLedgerKeybase_PrivateKeyOperationsNotSupported(t *testing.T) {
+	lk, _ := initTestLedgerKeybase(t)
+	address := testAccount(t, 0).GetAddressString()
+
+	_, err := lk.GetPrivKey(address, "")
+	require.ErrorIs(t, err, ErrNotSupported)
+	_, err = lk.ExportPrivString(address, "")
+	require.ErrorIs(t, err, ErrNotSupported)
+	_, err = lk.Create("", "")
+	require.ErrorIs(t, err, ErrNotSupported)
+	require.ErrorIs(t, lk.Delete(address, ""), ErrNotSupported)
+}
+
+func TestLedger_NewTransportInvalid(t *testing.T) {
+	_, err := NewTransport("usb")
+	require.Error(t, err)
+}
+
+func TestLedger_SerializePath(t *testing.T) {
+	path := accountPath(5)
+	bz := serializePath(path)
+	require.Equal(t, []byte{
+		3,
+		0x80, 0x00, 0x00, 0x2c,
+		0x80, 0x00, 0x02, 0x7b,
+		0x80, 0x00, 0x00, 0x05,
+	}, bz)
+
+	deserialized, rest, err := deserializePath(append(bz, 0xff))
+	require.NoError(t, err)
+	require.Equal(t, path, deserialized)
+	require.Equal(t, []byte{0xff}, rest)
+}
@@ -0,0 +1,83 @@
+package ledger
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	transportHID       = "hid"
+	transportTCPPrefix = "tcp://"
+
+	tcpDialTimeout = 5 * time.Second
+)
+
+// Transport exchanges APDUs with a Ledger device, the response includes the trailing status word
+type Transport interface {
+	Exchange(apdu []byte) ([]byte, error)
+	Close() error
+}
+
+// NewTransport opens the transport described by `transport`: "hid" (the default) for a device
+// connected over USB or "tcp://<host>:<port>" for the APDU server of an emulator such as Speculos
+func NewTransport(transport string) (Transport, error) {
+	switch {
+	case transport == "" || transport == transportHID:
+		return newHIDTransport()
+	case strings.HasPrefix(transport, transportTCPPrefix):
+		return newTCPTransport(strings.TrimPrefix(transport, transportTCPPrefix))
+	default:
+		return nil, fmt.Errorf("invalid ledger transport %q: options are hid or tcp://<host>:<port>", transport)
+	}
+}
+
+var _ Transport = &tcpTransport{}
+
+// tcpTransport speaks the APDU protocol of the Speculos emulator: the commands and the responses are
+// prefixed with their big endian 4 bytes length, which excludes the status word for the responses.
+type tcpTransport struct {
+	m    sync.Mutex
+	conn net.Conn
+}
+
+func newTCPTransport(addr string) (*tcpTransport, error) {
+	conn, err := net.DialTimeout("tcp", addr, tcpDialTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to the ledger emulator at %s: %w", addr, err)
+	}
+	return &tcpTransport{conn: conn}, nil
+}
+
+func (t *tcpTransport) Exchange(apdu []byte) ([]byte, error) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	req := make([]byte, 4, 4+len(apdu))
+	binary.BigEndian.PutUint32(req, uint32(len(apdu)))
+	if _, err := t.conn.Write(append(req, apdu...)); err != nil {
+		return nil, err
+	}
+
+	lenBz := make([]byte, 4)
+	if _, err := io.ReadFull(t.conn, lenBz); err != nil {
+		return nil, err
+	}
+	dataLen := binary.BigEndian.Uint32(lenBz)
+	if dataLen > maxApduPayloadLen+1 {
+		return nil, ErrInvalidResponse
+	}
+	resp := make([]byte, dataLen+2)
+	if _, err := io.ReadFull(t.conn, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (t *tcpTransport) Close() error {
+	return t.conn.Close()
+}
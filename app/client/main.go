@@ -18,8 +18,9 @@ func main() {
 		return
 	}
 
+	// The error has been rendered to stderr along with its code
 	if err != nil {
-		logger.Global.Fatal().Err(err).Msg("failed to execute command")
+		os.Exit(cli.ExitCode(err))
 	}
 }
 
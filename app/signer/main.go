@@ -0,0 +1,70 @@
+package main
+
+import (
+	"flag"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/pokt-network/pocket/logger"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/signer"
+)
+
+// The signer daemon holds a validator key and signs the consensus votes and the P2P envelopes of the node
+// configured with `ValidatorConfig.RemoteSigner`, see `signer/README.md`.
+func main() {
+	listenAddr := flag.String("listen", "127.0.0.1:7070", "Address the remote signer listens on.")
+	privateKeyFile := flag.String("private_key_file", "", "Path to the file containing the hex encoded validator private key.")
+	stateFile := flag.String("state_file", "", "Path to the file persisting the last vote signed, which protects against double signing.")
+	certFile := flag.String("cert_file", "", "Path to the PEM encoded certificate of the remote signer.")
+	keyFile := flag.String("key_file", "", "Path to the PEM encoded key of the certificate of the remote signer.")
+	clientCAFile := flag.String("client_ca_file", "", "Path to the PEM encoded certificate authority of the certificates the nodes authenticate with.")
+	flag.Parse()
+
+	if *privateKeyFile == "" || *stateFile == "" || *certFile == "" || *keyFile == "" || *clientCAFile == "" {
+		logger.Global.Fatal().Msg("The private_key_file, state_file, cert_file, key_file and client_ca_file flags are required")
+	}
+
+	privateKeyHex, err := os.ReadFile(*privateKeyFile)
+	if err != nil {
+		logger.Global.Fatal().Err(err).Msg("Failed to read the private key")
+	}
+	privateKey, err := crypto.NewPrivateKey(strings.TrimSpace(string(privateKeyHex)))
+	if err != nil {
+		logger.Global.Fatal().Err(err).Msg("Failed to parse the private key")
+	}
+
+	protection, err := signer.NewSlashingProtection(*stateFile)
+	if err != nil {
+		logger.Global.Fatal().Err(err).Msg("Failed to load the slashing protection state")
+	}
+
+	tlsConfig, err := signer.ServerTLSConfig(*certFile, *keyFile, *clientCAFile)
+	if err != nil {
+		logger.Global.Fatal().Err(err).Msg("Failed to load the TLS configuration")
+	}
+
+	listener, err := net.Listen("tcp", *listenAddr)
+	if err != nil {
+		logger.Global.Fatal().Err(err).Msg("Failed to listen")
+	}
+
+	grpcServer := signer.NewGRPCServer(signer.NewServer(privateKey, protection), tlsConfig)
+	go func() {
+		sigCh := make(chan os.Signal, 1)
+		signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+		<-sigCh
+		grpcServer.GracefulStop()
+	}()
+
+	logger.Global.Info().
+		Str("address", privateKey.Address().String()).
+		Str("listen", listener.Addr().String()).
+		Msg("Remote signer started")
+	if err := grpcServer.Serve(listener); err != nil {
+		logger.Global.Fatal().Err(err).Msg("Remote signer failed")
+	}
+}
@@ -9,8 +9,9 @@ BUILD_DIR ?= bin
 BINARY_NAME_client ?= p1
 BINARY_NAME_pocket ?= pocket
 POST_BUILD_TARGETS = rename-binaries
-## When issuing make build against a branch/state that doesn't have a tag referencing the supplied commit hash, the version will be set to the branch name.
-VERSION ?= $(shell git describe --tags --exact-match 2>/dev/null || git symbolic-ref -q --short HEAD)
+## When issuing make build against a branch/state that doesn't have a tag referencing the supplied commit hash, the version defaults to app.AppVersion.
+## The version must be a semantic version (e.g. v1.0.0): the node refuses to start otherwise, so a branch name cannot be used.
+VERSION ?= $(shell git describe --tags --exact-match 2>/dev/null)
 COMMIT_HASH ?= $(shell git rev-parse --short HEAD 2>/dev/null)
 DATE_FMT = +%FT%T%z
 
@@ -23,7 +24,10 @@ else
 endif
 ## LDFLAGS are going to be used by the linker to set the version, commit hash and build date.
 ## debug.ReadBuildInfo() is not going to be used because currently a customised version string is needed.
-LDFLAGS += -X github.com/pokt-network/pocket/app.AppVersion=${VERSION} -X github.com/pokt-network/pocket/app.CommitHash=${COMMIT_HASH} -X github.com/pokt-network/pocket/app.BuildDate=${BUILD_DATE}
+LDFLAGS += -X github.com/pokt-network/pocket/app.CommitHash=${COMMIT_HASH} -X github.com/pokt-network/pocket/app.BuildDate=${BUILD_DATE}
+ifneq (${VERSION},)
+LDFLAGS += -X github.com/pokt-network/pocket/app.AppVersion=${VERSION}
+endif
 export CGO_ENABLED ?= 0
 ifeq (${VERBOSE}, 1)
 ifeq ($(filter -v,${GOARGS}),)
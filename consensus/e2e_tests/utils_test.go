@@ -588,6 +588,7 @@ func baseRpcMock(t *testing.T, _ modules.EventsChannel) *mockModules.MockRPCModu
 	rpcMock.EXPECT().Start().Return(nil).AnyTimes()
 	rpcMock.EXPECT().SetBus(gomock.Any()).Return().AnyTimes()
 	rpcMock.EXPECT().GetModuleName().Return(modules.RPCModuleName).AnyTimes()
+	rpcMock.EXPECT().HandleEvent(gomock.Any()).Return(nil).AnyTimes()
 
 	return rpcMock
 }
@@ -94,7 +94,7 @@ func (handler *HotstuffLeaderMessageHandler) HandleNewRoundMessage(m *consensusM
 	m.broadcastToValidators(prepareProposeMessage)
 
 	// Leader also acts like a replica
-	prepareVoteMessage, err := CreateVoteMessage(m.height, m.round, Prepare, m.block, m.privateKey)
+	prepareVoteMessage, err := CreateVoteMessage(m.height, m.round, Prepare, m.block, m.signer)
 	if err != nil {
 		m.logger.Error().Err(err).Msg(typesCons.ErrCreateVoteMessage(Prepare).Error())
 		return
@@ -145,7 +145,7 @@ func (handler *HotstuffLeaderMessageHandler) HandlePrepareMessage(m *consensusMo
 	m.broadcastToValidators(preCommitProposeMessage)
 
 	// Leader also acts like a replica
-	precommitVoteMessage, err := CreateVoteMessage(m.height, m.round, PreCommit, m.block, m.privateKey)
+	precommitVoteMessage, err := CreateVoteMessage(m.height, m.round, PreCommit, m.block, m.signer)
 	if err != nil {
 		m.logger.Error().Err(err).Msg(typesCons.ErrCreateVoteMessage(PreCommit).Error())
 		return
@@ -196,7 +196,7 @@ func (handler *HotstuffLeaderMessageHandler) HandlePrecommitMessage(m *consensus
 	m.broadcastToValidators(commitProposeMessage)
 
 	// Leader also acts like a replica
-	commitVoteMessage, err := CreateVoteMessage(m.height, m.round, Commit, m.block, m.privateKey)
+	commitVoteMessage, err := CreateVoteMessage(m.height, m.round, Commit, m.block, m.signer)
 	if err != nil {
 		m.logger.Error().Err(err).Msg(typesCons.ErrCreateVoteMessage(Commit).Error())
 		return
@@ -399,7 +399,7 @@ func (m *consensusModule) prepareBlock(qc *typesCons.QuorumCertificate) (*coreTy
 	}
 
 	// Reap the mempool for transactions to be applied in this block
-	stateHash, txs, err := leaderUOW.CreateProposalBlock(m.privateKey.Address(), maxTxBytes)
+	stateHash, txs, err := leaderUOW.CreateProposalBlock(m.signer.PublicKey().Address(), maxTxBytes)
 
 	if err != nil {
 		return nil, err
@@ -427,7 +427,7 @@ func (m *consensusModule) prepareBlock(qc *typesCons.QuorumCertificate) (*coreTy
 		Height:            m.height,
 		StateHash:         stateHash,
 		PrevStateHash:     prevBlockHash,
-		ProposerAddress:   m.privateKey.Address().Bytes(),
+		ProposerAddress:   m.signer.PublicKey().Address().Bytes(),
 		QuorumCertificate: qcBytes,
 	}
 	block := &coreTypes.Block{
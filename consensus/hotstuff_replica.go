@@ -68,7 +68,7 @@ func (handler *HotstuffReplicaMessageHandler) HandlePrepareMessage(m *consensusM
 	m.block = block
 	m.step = PreCommit
 
-	prepareVoteMessage, err := CreateVoteMessage(m.height, m.round, Prepare, m.block, m.privateKey)
+	prepareVoteMessage, err := CreateVoteMessage(m.height, m.round, Prepare, m.block, m.signer)
 	if err != nil {
 		m.logger.Error().Err(err).Msg(typesCons.ErrCreateVoteMessage(Prepare).Error())
 		return // Not interrupting the round because liveness could continue with one failed vote
@@ -97,7 +97,7 @@ func (handler *HotstuffReplicaMessageHandler) HandlePrecommitMessage(m *consensu
 	m.step = Commit
 	m.prepareQC = quorumCert // INVESTIGATE: Why are we never using this for validation?
 
-	preCommitVoteMessage, err := CreateVoteMessage(m.height, m.round, PreCommit, m.block, m.privateKey)
+	preCommitVoteMessage, err := CreateVoteMessage(m.height, m.round, PreCommit, m.block, m.signer)
 	if err != nil {
 		m.logger.Error().Err(err).Msg(typesCons.ErrCreateVoteMessage(PreCommit).Error())
 		return // Not interrupting the round because liveness could continue with one failed vote
@@ -126,7 +126,7 @@ func (handler *HotstuffReplicaMessageHandler) HandleCommitMessage(m *consensusMo
 	m.step = Decide
 	m.lockedQC = quorumCert // DISCUSS: How does the replica recover if it's locked? Replica `formally` agrees on the QC while the rest of the network `verbally` agrees on the QC.
 
-	commitVoteMessage, err := CreateVoteMessage(m.height, m.round, Commit, m.block, m.privateKey)
+	commitVoteMessage, err := CreateVoteMessage(m.height, m.round, Commit, m.block, m.signer)
 	if err != nil {
 		m.logger.Error().Err(err).Msg(typesCons.ErrCreateVoteMessage(Commit).Error())
 		return // Not interrupting the round because liveness could continue with one failed vote
@@ -1,11 +1,11 @@
 package consensus
 
 import (
+	"fmt"
+
 	typesCons "github.com/pokt-network/pocket/consensus/types"
-	"github.com/pokt-network/pocket/logger"
-	"github.com/pokt-network/pocket/shared/codec"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
-	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/modules"
 )
 
 func CreateProposeMessage(
@@ -49,7 +49,7 @@ func CreateVoteMessage(
 	round uint64,
 	step typesCons.HotstuffStep,
 	block *coreTypes.Block,
-	privKey crypto.PrivateKey, // used to sign the vote
+	signer modules.Signer, // used to sign the vote
 ) (*typesCons.HotstuffMessage, error) {
 	if block == nil {
 		return nil, typesCons.ErrNilBlockVote
@@ -64,10 +64,14 @@ func CreateVoteMessage(
 		Justification: nil, // signature is computed below
 	}
 
+	signature, err := getMessageSignature(msg, signer)
+	if err != nil {
+		return nil, err
+	}
 	msg.Justification = &typesCons.HotstuffMessage_PartialSignature{
 		PartialSignature: &typesCons.PartialSignature{
-			Signature: getMessageSignature(msg, privKey),
-			Address:   privKey.PublicKey().Address().String(),
+			Signature: signature,
+			Address:   signer.PublicKey().Address().String(),
 		},
 	}
 
@@ -75,32 +79,17 @@ func CreateVoteMessage(
 }
 
 // Returns "partial" signature of the hotstuff message from one of the validators.
-// If there is an error signing the bytes, nil is returned instead.
-func getMessageSignature(msg *typesCons.HotstuffMessage, privKey crypto.PrivateKey) []byte {
-	bytesToSign, err := getSignableBytes(msg)
+// A remote signer refuses to sign a vote conflicting with one it already signed.
+func getMessageSignature(msg *typesCons.HotstuffMessage, signer modules.Signer) ([]byte, error) {
+	bytesToSign, err := typesCons.GetSignableBytes(msg)
 	if err != nil {
-		logger.Global.Warn().Err(err).Msgf("error getting bytes to sign")
-		return nil
+		return nil, fmt.Errorf("error getting bytes to sign: %w", err)
 	}
 
-	signature, err := privKey.Sign(bytesToSign)
+	signature, err := signer.SignVote(bytesToSign)
 	if err != nil {
-		logger.Global.Warn().Err(err).Msgf("error signing message")
-		return nil
+		return nil, fmt.Errorf("error signing message: %w", err)
 	}
 
-	return signature
-}
-
-// Signature only over subset of fields in HotstuffMessage
-// For reference, see section 4.3 of the the hotstuff whitepaper, partial signatures are
-// computed over `tsignr(hm.type, m.viewNumber , m.nodei)`. https://arxiv.org/pdf/1803.05069.pdf
-func getSignableBytes(msg *typesCons.HotstuffMessage) ([]byte, error) {
-	msgToSign := &typesCons.HotstuffMessage{
-		Height: msg.GetHeight(),
-		Step:   msg.GetStep(),
-		Round:  msg.GetRound(),
-		Block:  msg.GetBlock(),
-	}
-	return codec.GetCodec().Marshal(msgToSign)
+	return signature, nil
 }
@@ -1,7 +1,9 @@
 package consensus
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"sort"
 	"sync"
 
@@ -20,6 +22,7 @@ import (
 	"github.com/pokt-network/pocket/shared/messaging"
 	"github.com/pokt-network/pocket/shared/modules"
 	"github.com/pokt-network/pocket/shared/modules/base_modules"
+	"github.com/pokt-network/pocket/signer"
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
@@ -34,7 +37,8 @@ const (
 type consensusModule struct {
 	base_modules.IntegrableModule
 
-	privateKey cryptoPocket.Ed25519PrivateKey
+	// signer signs the votes with the validator key, which is held by a remote signer when `ValidatorConfig.RemoteSigner` is enabled
+	signer modules.Signer
 
 	consCfg      *configs.ConsensusConfig
 	genesisState *genesis.GenesisState
@@ -149,11 +153,11 @@ func (*consensusModule) Create(bus modules.Bus, options ...modules.ModuleOption)
 		return nil, fmt.Errorf("genesis validation failed: %w", err)
 	}
 
-	privateKey, err := cryptoPocket.NewPrivateKey(consensusCfg.GetPrivateKey())
+	validatorSigner, err := newSigner(runtimeMgr.GetConfig())
 	if err != nil {
 		return nil, err
 	}
-	address := privateKey.Address().String()
+	address := validatorSigner.PublicKey().Address().String()
 
 	validators, err := m.getValidatorsAtHeight(m.CurrentHeight())
 	if err != nil {
@@ -162,7 +166,7 @@ func (*consensusModule) Create(bus modules.Bus, options ...modules.ModuleOption)
 
 	valAddrToIdMap := typesCons.NewActorMapper(validators).GetValAddrToIdMap()
 
-	m.privateKey = privateKey.(cryptoPocket.Ed25519PrivateKey)
+	m.signer = validatorSigner
 	m.consCfg = consensusCfg
 	m.genesisState = genesisState
 
@@ -207,6 +211,10 @@ func (m *consensusModule) Start() error {
 }
 
 func (m *consensusModule) Stop() error {
+	// Close the connection to the remote signer, if any
+	if closer, ok := m.signer.(io.Closer); ok {
+		return closer.Close()
+	}
 	return nil
 }
 
@@ -250,9 +258,28 @@ func (*consensusModule) ValidateGenesis(gen *genesis.GenesisState) error {
 }
 
 func (m *consensusModule) GetPrivateKey() (cryptoPocket.PrivateKey, error) {
+	if m.GetBus().GetRuntimeMgr().GetConfig().Validator.GetRemoteSigner().GetEnabled() {
+		return nil, errors.New("the validator key is held by the remote signer")
+	}
 	return cryptoPocket.NewPrivateKey(m.consCfg.PrivateKey)
 }
 
+func (m *consensusModule) GetSigner() modules.Signer {
+	return m.signer
+}
+
+// newSigner returns the remote signer if `ValidatorConfig.RemoteSigner` is enabled, a signer of `ConsensusConfig.PrivateKey` otherwise
+func newSigner(cfg *configs.Config) (modules.Signer, error) {
+	if remoteSignerCfg := cfg.Validator.GetRemoteSigner(); remoteSignerCfg.GetEnabled() {
+		return signer.NewRemoteSigner(remoteSignerCfg)
+	}
+	privateKey, err := cryptoPocket.NewPrivateKey(cfg.Consensus.GetPrivateKey())
+	if err != nil {
+		return nil, err
+	}
+	return signer.NewLocalSigner(privateKey), nil
+}
+
 func (m *consensusModule) HandleMessage(message *anypb.Any) error {
 	m.m.Lock()
 	defer m.m.Unlock()
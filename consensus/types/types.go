@@ -2,6 +2,7 @@ package types
 
 // TODO: Split this file into multiple types files.
 import (
+	"github.com/pokt-network/pocket/shared/codec"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 )
 
@@ -30,3 +31,17 @@ func ActorListToValidatorMap(actors []*coreTypes.Actor) (m ValidatorMap) {
 	}
 	return
 }
+
+// GetSignableBytes returns the bytes of a HotstuffMessage signed by the validators.
+// Signature only over subset of fields in HotstuffMessage
+// For reference, see section 4.3 of the the hotstuff whitepaper, partial signatures are
+// computed over `tsignr(hm.type, m.viewNumber , m.nodei)`. https://arxiv.org/pdf/1803.05069.pdf
+func GetSignableBytes(msg *HotstuffMessage) ([]byte, error) {
+	msgToSign := &HotstuffMessage{
+		Height: msg.GetHeight(),
+		Step:   msg.GetStep(),
+		Round:  msg.GetRound(),
+		Block:  msg.GetBlock(),
+	}
+	return codec.GetCodec().Marshal(msgToSign)
+}
@@ -3,6 +3,8 @@
 package e2e
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"os/exec"
 
@@ -63,12 +65,19 @@ func (n *nodePod) RunCommandOnHost(rpcUrl string, args ...string) (*commandResul
 	}
 	args = append(base, args...)
 	cmd := exec.Command("kubectl", args...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
 	r := &commandResult{}
-	out, err := cmd.Output()
-	if err != nil {
-		return nil, err
+	// A command exiting with a non-zero status is a result: its error is rendered to stderr along with its code
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) {
+			return nil, err
+		}
+		r.Err = err
 	}
-	r.Stdout = string(out)
+	r.Stdout = stdout.String()
+	r.Stderr = stderr.String()
 	n.result = r
 	// IMPROVE: make targetPodName configurable
 	n.targetPodName = targetDevClientPod
@@ -162,8 +162,14 @@ func (s *rootSuite) TheNetworkHasActorsOfType(num int64, actor string) {
 }
 
 func (s *rootSuite) ShouldBeUnreachable(pod string) {
-	validate := func(res string) bool {
-		return strings.Contains(res, "Unable to connect to the RPC")
+	type expectedResponse struct {
+		Error *struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	validate := func(res *expectedResponse) bool {
+		return res != nil && res.Error != nil
 	}
 	args := []string{
 		"Query",
@@ -172,11 +178,14 @@ func (s *rootSuite) ShouldBeUnreachable(pod string) {
 	rpcURL := fmt.Sprintf("http://%s-pocket:%s", pod, defaults.DefaultRPCPort)
 	resRaw, err := s.node.RunCommandOnHost(rpcURL, args...)
 	require.NoError(s, err)
+	require.Error(s, resRaw.Err)
 
-	res := getStrFromStdout(s, resRaw.Stdout, validate)
+	// The error is rendered to stderr
+	res := getResponseFromStdout[expectedResponse](s, resRaw.Stderr, validate)
 	require.NotNil(s, res)
 
-	require.Equal(s, fmt.Sprintf("❌ Unable to connect to the RPC @ \x1b[1mhttp://%s-pocket:%s\x1b[0m", pod, defaults.DefaultRPCPort), *res)
+	require.Equal(s, "rpc_unreachable", res.Error.Code)
+	require.True(s, strings.HasPrefix(res.Error.Message, fmt.Sprintf("unable to connect to the RPC @ http://%s-pocket:%s", pod, defaults.DefaultRPCPort)), res.Error.Message)
 }
 
 func (s *rootSuite) ShouldBeAtHeight(pod string, height int64) {
@@ -329,14 +338,3 @@ func getResponseFromStdout[T any](t gocuke.TestingT, stdout string, validate fun
 	}
 	return nil
 }
-
-func getStrFromStdout(t gocuke.TestingT, stdout string, validate func(res string) bool) *string {
-	t.Helper()
-	for _, s := range strings.Split(stdout, "\n") {
-		if !validate(s) {
-			continue
-		}
-		return &s
-	}
-	return nil
-}
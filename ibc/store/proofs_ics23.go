@@ -59,6 +59,23 @@ func VerifyNonMembership(root ics23.CommitmentRoot, proof *ics23.CommitmentProof
 	return ics23.VerifyNonMembership(smtSpec, root, proof, key)
 }
 
+// CreateCommitmentProof generates a CommitmentProof for the key in the SMT provided, proving the
+// membership of the value digest stored at the key if present and its non-membership otherwise.
+// NB: SMTs that hash their values (e.g. the state trees in the TreeStore) only store the value's digest
+// so it is the digest, returned alongside the proof, that must be provided to `VerifyMembership`.
+func CreateCommitmentProof(tree *smt.SMT, key []byte) (valueHash []byte, proof *ics23.CommitmentProof, err error) {
+	valueHash, err = tree.Get(key)
+	if err != nil {
+		return nil, nil, coreTypes.ErrIBCCreatingProof(err)
+	}
+	if valueHash == nil {
+		proof, err = createNonMembershipProof(tree, key)
+		return nil, proof, err
+	}
+	proof, err = createMembershipProof(tree, key, valueHash)
+	return valueHash, proof, err
+}
+
 // createMembershipProof generates a CommitmentProof object verifying the membership of a key-value pair
 // in the SMT provided
 func createMembershipProof(tree *smt.SMT, key, value []byte) (*ics23.CommitmentProof, error) {
@@ -178,3 +178,33 @@ func TestICS23Proofs_VerifyCommitmentProofs(t *testing.T) {
 	err = nodeStore.Stop()
 	require.NoError(t, err)
 }
+
+func TestICS23Proofs_CreateCommitmentProof(t *testing.T) {
+	nodeStore := kvstore.NewMemKVStore()
+	// Use the default value hasher as the state trees in the TreeStore do
+	tree := smt.NewSparseMerkleTree(nodeStore, sha256.New())
+	require.NotNil(t, tree)
+
+	err := tree.Update([]byte("foo"), []byte("bar"))
+	require.NoError(t, err)
+	err = tree.Update([]byte("bar"), []byte("foo"))
+	require.NoError(t, err)
+	root := ics23.CommitmentRoot(tree.Root())
+
+	valueHash, proof, err := CreateCommitmentProof(tree, []byte("foo"))
+	require.NoError(t, err)
+	barHash := sha256.Sum256([]byte("bar"))
+	require.Equal(t, barHash[:], valueHash)
+	require.NotNil(t, proof.GetExist())
+	require.True(t, VerifyMembership(root, proof, []byte("foo"), valueHash))
+	require.False(t, VerifyMembership(root, proof, []byte("foo"), []byte("bar")))
+
+	valueHash, proof, err = CreateCommitmentProof(tree, []byte("baz"))
+	require.NoError(t, err)
+	require.Nil(t, valueHash)
+	require.NotNil(t, proof.GetExclusion())
+	require.True(t, VerifyNonMembership(root, proof, []byte("baz")))
+
+	err = nodeStore.Stop()
+	require.NoError(t, err)
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	dht "github.com/libp2p/go-libp2p-kad-dht"
@@ -30,6 +31,7 @@ import (
 
 var (
 	_ typesP2P.Router         = &backgroundRouter{}
+	_ typesP2P.PubSubRouter   = &backgroundRouter{}
 	_ backgroundRouterFactory = &backgroundRouter{}
 )
 
@@ -56,8 +58,8 @@ type backgroundRouter struct {
 	// (see: https://pkg.go.dev/github.com/libp2p/go-libp2p#section-readme)
 	host libp2pHost.Host
 	// cancelReadSubscription is the cancel function for the context which is
-	// monitored in the `#readSubscription()` go routine. Call to terminate it.
-	// only one read subscription exists per router at any point in time
+	// used by gossipsub, peer discovery and is monitored in the default topic's
+	// `#readSubscription()` go routine. Call to terminate them.
 	cancelReadSubscription context.CancelFunc
 
 	// Fields below are assigned during creation via `#setupDependencies()`.
@@ -66,17 +68,31 @@ type backgroundRouter struct {
 	// (i.e. multiple, unidentified receivers)
 	// TECHDEBT: investigate diff between randomSub and gossipSub
 	gossipSub *pubsub.PubSub
-	// topic is similar to pubsub but received messages are filtered by a "topic" string.
-	// Published messages are also given the respective topic before broadcast.
-	topic *pubsub.Topic
-	// subscription provides an interface to continuously read messages from.
-	subscription *pubsub.Subscription
+	// topicsMu guards `topics`.
+	topicsMu sync.Mutex
+	// topics maps pubsub topic strings to the respective joined topic and its
+	// subscription, if any. Received messages are filtered by "topic" string;
+	// published messages are also given the respective topic before broadcast.
+	// The default topic (`protocol.BackgroundTopicStr`) is always joined and
+	// subscribed to; other topics are joined on `#Publish()` or `#Subscribe()`.
+	topics map[string]*topicSubscription
 	// kadDHT is a kademlia distributed hash table used for routing and peer discovery.
 	kadDHT *dht.IpfsDHT
 	// TECHDEBT: `pstore` will likely be removed in future refactoring / simplification
 	// of the `Router` interface.
 	// pstore is the background router's peerstore. Assigned in `backgroundRouter#setupPeerstore()`.
 	pstore typesP2P.Peerstore
+
+	// requireSignedEnvelopes determines whether received `PocketEnvelope`s
+	// which are not signed are rejected.
+	requireSignedEnvelopes bool
+	// stakedPStoreMu guards `stakedPStore` & `stakedPStoreHeight`.
+	stakedPStoreMu sync.Mutex
+	// stakedPStore caches the staked actor peerstore at `stakedPStoreHeight`.
+	// It is used to verify `PocketEnvelope` signatures against the on-chain
+	// public keys of staked actors (see: `#getStakedPeerstore()`).
+	stakedPStore       typesP2P.Peerstore
+	stakedPStoreHeight uint64
 }
 
 // Create returns a `backgroundRouter` as a `typesP2P.Router`
@@ -100,6 +116,8 @@ func (*backgroundRouter) Create(bus modules.Bus, cfg *config.BackgroundConfig) (
 		handler:                cfg.Handler,
 		host:                   cfg.Host,
 		cancelReadSubscription: cancel,
+		topics:                 make(map[string]*topicSubscription),
+		requireSignedEnvelopes: cfg.RequireSignedEnvelopes,
 	}
 	bus.RegisterModule(rtr)
 
@@ -113,8 +131,6 @@ func (*backgroundRouter) Create(bus modules.Bus, cfg *config.BackgroundConfig) (
 		return nil, err
 	}
 
-	go rtr.readSubscription(ctx)
-
 	return rtr, nil
 }
 
@@ -122,15 +138,24 @@ func (rtr *backgroundRouter) Close() error {
 	rtr.logger.Debug().Msg("closing background router")
 
 	rtr.cancelReadSubscription()
-	rtr.subscription.Cancel()
 
-	var topicCloseErr error
-	if err := rtr.topic.Close(); err != context.Canceled {
-		topicCloseErr = err
+	rtr.topicsMu.Lock()
+	defer rtr.topicsMu.Unlock()
+
+	var topicCloseErrs error
+	for topicStr, topicSub := range rtr.topics {
+		topicSub.unsubscribe()
+		if err := topicSub.topic.Close(); err != nil && err != context.Canceled {
+			topicCloseErrs = errors.Join(
+				topicCloseErrs,
+				fmt.Errorf("closing topic: %q: %w", topicStr, err),
+			)
+		}
+		delete(rtr.topics, topicStr)
 	}
 
 	return errors.Join(
-		topicCloseErr,
+		topicCloseErrs,
 		rtr.kadDHT.Close(),
 	)
 }
@@ -142,6 +167,12 @@ func (rtr *backgroundRouter) GetModuleName() string {
 
 // Broadcast implements the respective `typesP2P.Router` interface  method.
 func (rtr *backgroundRouter) Broadcast(pocketEnvelopeBz []byte) error {
+	return rtr.Publish(protocol.BackgroundTopicStr, pocketEnvelopeBz)
+}
+
+// Publish implements the respective `typesP2P.PubSubRouter` interface method.
+// The topic is joined (without subscribing) if it hasn't been already.
+func (rtr *backgroundRouter) Publish(topicStr string, pocketEnvelopeBz []byte) error {
 	backgroundMsg := &typesP2P.BackgroundMessage{
 		Data: pocketEnvelopeBz,
 	}
@@ -150,8 +181,43 @@ func (rtr *backgroundRouter) Broadcast(pocketEnvelopeBz []byte) error {
 		return err
 	}
 
+	rtr.topicsMu.Lock()
+	topicSub, err := rtr.getOrJoinTopic(topicStr, nil)
+	rtr.topicsMu.Unlock()
+	if err != nil {
+		return err
+	}
+
 	// TECHDEBT(#595): add ctx to interface methods and propagate down.
-	return rtr.topic.Publish(context.TODO(), backgroundMsgBz)
+	return topicSub.topic.Publish(context.TODO(), backgroundMsgBz)
+}
+
+// Subscribe implements the respective `typesP2P.PubSubRouter` interface method.
+func (rtr *backgroundRouter) Subscribe(topicStr string, validator typesP2P.TopicValidator) error {
+	if topicStr == protocol.BackgroundTopicStr {
+		return fmt.Errorf("%w: %q", typesP2P.ErrReservedTopic, topicStr)
+	}
+
+	return rtr.subscribe(topicStr, validator)
+}
+
+// Unsubscribe implements the respective `typesP2P.PubSubRouter` interface method.
+// The topic remains joined such that it may still be published to.
+func (rtr *backgroundRouter) Unsubscribe(topicStr string) error {
+	if topicStr == protocol.BackgroundTopicStr {
+		return fmt.Errorf("%w: %q", typesP2P.ErrReservedTopic, topicStr)
+	}
+
+	rtr.topicsMu.Lock()
+	defer rtr.topicsMu.Unlock()
+
+	topicSub, ok := rtr.topics[topicStr]
+	if !ok || topicSub.subscription == nil {
+		return fmt.Errorf("%w: %q", typesP2P.ErrNotSubscribed, topicStr)
+	}
+
+	topicSub.unsubscribe()
+	return nil
 }
 
 // Send implements the respective `typesP2P.Router` interface  method.
@@ -215,7 +281,7 @@ func (rtr *backgroundRouter) setupUnicastRouter() error {
 		Logger:         rtr.logger,
 		Host:           rtr.host,
 		ProtocolID:     protocol.BackgroundProtocolID,
-		MessageHandler: rtr.handleBackgroundMsg,
+		MessageHandler: rtr.handleUnicastMsg,
 		PeerHandler:    rtr.AddPeer,
 	}
 
@@ -243,11 +309,7 @@ func (rtr *backgroundRouter) setupDependencies(ctx context.Context, _ *config.Ba
 		return fmt.Errorf("setting up pubsub: %w", err)
 	}
 
-	if err := rtr.setupTopic(); err != nil {
-		return fmt.Errorf("setting up topic: %w", err)
-	}
-
-	if err := rtr.setupSubscription(); err != nil {
+	if err := rtr.subscribe(protocol.BackgroundTopicStr, nil); err != nil {
 		return fmt.Errorf("setting up subscription: %w", err)
 	}
 
@@ -312,34 +374,87 @@ func (rtr *backgroundRouter) setupPubsub(ctx context.Context) (err error) {
 	return err
 }
 
-func (rtr *backgroundRouter) setupTopic() (err error) {
-	if err := rtr.gossipSub.RegisterTopicValidator(
-		protocol.BackgroundTopicStr,
-		rtr.topicValidator,
-	); err != nil {
-		return fmt.Errorf(
-			"registering topic validator for topic: %q: %w",
-			protocol.BackgroundTopicStr, err,
-		)
+// subscribe joins the given topic, if not already joined, subscribes to it and
+// starts reading the subscription in a new go routine.
+func (rtr *backgroundRouter) subscribe(topicStr string, validator typesP2P.TopicValidator) error {
+	rtr.topicsMu.Lock()
+	defer rtr.topicsMu.Unlock()
+
+	if topicSub, ok := rtr.topics[topicStr]; ok && topicSub.subscription != nil {
+		return fmt.Errorf("%w: %q", typesP2P.ErrAlreadySubscribed, topicStr)
 	}
 
-	if rtr.topic, err = rtr.gossipSub.Join(protocol.BackgroundTopicStr); err != nil {
-		return fmt.Errorf(
-			"joining background topic: %q: %w",
-			protocol.BackgroundTopicStr, err,
-		)
+	topicSub, err := rtr.getOrJoinTopic(topicStr, validator)
+	if err != nil {
+		return err
 	}
-	return nil
-}
 
-func (rtr *backgroundRouter) setupSubscription() (err error) {
 	// INVESTIGATE: `WithBufferSize` `SubOpt`:
 	// > WithBufferSize is a Subscribe option to customize the size of the subscribe
 	// > output buffer. The default length is 32 but it can be configured to avoid
 	// > dropping messages if the consumer is not reading fast enough.
 	// (see: https://pkg.go.dev/github.com/libp2p/go-libp2p-pubsub#WithBufferSize)
-	rtr.subscription, err = rtr.topic.Subscribe()
-	return err
+	subscription, err := topicSub.topic.Subscribe()
+	if err != nil {
+		return fmt.Errorf("subscribing to topic: %q: %w", topicStr, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	topicSub.subscription = subscription
+	topicSub.cancelRead = cancel
+
+	go rtr.readSubscription(ctx, subscription)
+	return nil
+}
+
+// getOrJoinTopic returns the given topic if it has already been joined;
+// otherwise, it registers a topic validator and joins the topic. If the topic
+// has already been joined and a non-nil validator is given, the topic validator
+// is replaced. MUST be called while holding `rtr.topicsMu`.
+func (rtr *backgroundRouter) getOrJoinTopic(
+	topicStr string,
+	validator typesP2P.TopicValidator,
+) (*topicSubscription, error) {
+	if topicStr == "" {
+		return nil, typesP2P.ErrInvalidTopic
+	}
+
+	topicSub, ok := rtr.topics[topicStr]
+	if ok && validator == nil {
+		return topicSub, nil
+	}
+
+	if ok {
+		if err := rtr.gossipSub.UnregisterTopicValidator(topicStr); err != nil {
+			return nil, fmt.Errorf(
+				"unregistering topic validator for topic: %q: %w",
+				topicStr, err,
+			)
+		}
+	}
+
+	if err := rtr.gossipSub.RegisterTopicValidator(
+		topicStr,
+		rtr.newTopicValidator(validator),
+	); err != nil {
+		return nil, fmt.Errorf(
+			"registering topic validator for topic: %q: %w",
+			topicStr, err,
+		)
+	}
+
+	if ok {
+		return topicSub, nil
+	}
+
+	topic, err := rtr.gossipSub.Join(topicStr)
+	if err != nil {
+		return nil, fmt.Errorf("joining topic: %q: %w", topicStr, err)
+	}
+
+	topicSub = &topicSubscription{topic: topic}
+	rtr.topics[topicStr] = topicSub
+	return topicSub, nil
 }
 
 // TECHDEBT(#859): integrate with `p2pModule#bootstrap()`.
@@ -398,29 +513,112 @@ func (rtr *backgroundRouter) connectWithRetry(ctx context.Context, libp2pAddrInf
 //
 // Also note: https://pkg.go.dev/github.com/libp2p/go-libp2p-pubsub#BasicSeqnoValidator
 func (rtr *backgroundRouter) topicValidator(_ context.Context, _ libp2pPeer.ID, msg *pubsub.Message) bool {
+	_, ok := rtr.validatePocketEnvelope(msg)
+	return ok
+}
+
+// newTopicValidator returns a topic validator which, in addition to the checks
+// performed by `#topicValidator()`, passes the content of the received
+// `PocketEnvelope` to the given validator, if not nil.
+func (rtr *backgroundRouter) newTopicValidator(validator typesP2P.TopicValidator) pubsub.ValidatorEx {
+	return func(_ context.Context, _ libp2pPeer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+		poktEnvelope, ok := rtr.validatePocketEnvelope(msg)
+		if !ok {
+			return pubsub.ValidationReject
+		}
+
+		if validator != nil && !validator(poktEnvelope.Content) {
+			rtr.logger.Debug().
+				Str("topic", msg.GetTopic()).
+				Msg("message rejected by topic validator")
+			return pubsub.ValidationReject
+		}
+		return pubsub.ValidationAccept
+	}
+}
+
+// validatePocketEnvelope returns the `PocketEnvelope` contained in the given
+// pubsub message and whether it is structurally valid.
+func (rtr *backgroundRouter) validatePocketEnvelope(msg *pubsub.Message) (*messaging.PocketEnvelope, bool) {
 	var backgroundMsg typesP2P.BackgroundMessage
 	if err := proto.Unmarshal(msg.Data, &backgroundMsg); err != nil {
 		rtr.logger.Error().Err(err).Msg("unmarshalling Background message")
-		return false
+		return nil, false
 	}
 
 	if backgroundMsg.Data == nil {
 		rtr.logger.Debug().Msg("no data in Background message")
-		return false
+		return nil, false
 	}
 
-	poktEnvelope := messaging.PocketEnvelope{}
-	if err := proto.Unmarshal(backgroundMsg.Data, &poktEnvelope); err != nil {
+	poktEnvelope := &messaging.PocketEnvelope{}
+	if err := proto.Unmarshal(backgroundMsg.Data, poktEnvelope); err != nil {
 		rtr.logger.Error().Err(err).Msg("Error decoding Background message")
-		return false
+		return nil, false
+	}
+
+	if err := rtr.verifyPocketEnvelope(poktEnvelope); err != nil {
+		rtr.logger.Warn().Err(err).
+			Str("topic", msg.GetTopic()).
+			Msg("verifying pocket envelope")
+		return nil, false
+	}
+
+	return poktEnvelope, true
+}
+
+// verifyPocketEnvelope verifies the signature of the given `PocketEnvelope`,
+// if any, against the staked actor set at the current height.
+func (rtr *backgroundRouter) verifyPocketEnvelope(poktEnvelope *messaging.PocketEnvelope) error {
+	// The staked actor set is only needed to verify signed envelopes.
+	var stakedPStore typesP2P.Peerstore
+	if poktEnvelope.IsSigned() {
+		var err error
+		if stakedPStore, err = rtr.getStakedPeerstore(); err != nil {
+			return fmt.Errorf("getting staked peerstore: %w", err)
+		}
 	}
 
-	return true
+	return utils.VerifyPocketEnvelope(poktEnvelope, stakedPStore, rtr.requireSignedEnvelopes)
+}
+
+// getStakedPeerstore returns the staked actor peerstore at the current height.
+// It is cached until the current height changes.
+func (rtr *backgroundRouter) getStakedPeerstore() (typesP2P.Peerstore, error) {
+	currentHeight := rtr.GetBus().GetCurrentHeightProvider().CurrentHeight()
+
+	rtr.stakedPStoreMu.Lock()
+	defer rtr.stakedPStoreMu.Unlock()
+
+	if rtr.stakedPStore != nil && rtr.stakedPStoreHeight == currentHeight {
+		return rtr.stakedPStore, nil
+	}
+
+	// TECHDEBT(#810, #811): use `bus.GetPeerstoreProvider()` after peerstore provider
+	// is retrievable as a proper submodule
+	pstoreProviderModule, err := rtr.GetBus().GetModulesRegistry().
+		GetModule(peerstore_provider.PeerstoreProviderSubmoduleName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving peerstore provider: %w", err)
+	}
+	pstoreProvider, ok := pstoreProviderModule.(providers.PeerstoreProvider)
+	if !ok {
+		return nil, fmt.Errorf("unexpected peerstore provider type: %T", pstoreProviderModule)
+	}
+
+	stakedPStore, err := pstoreProvider.GetStakedPeerstoreAtHeight(currentHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	rtr.stakedPStore = stakedPStore
+	rtr.stakedPStoreHeight = currentHeight
+	return stakedPStore, nil
 }
 
 // readSubscription is a while loop for receiving and handling messages from the
-// subscription. It is intended to be called as a goroutine.
-func (rtr *backgroundRouter) readSubscription(ctx context.Context) {
+// given subscription. It is intended to be called as a goroutine.
+func (rtr *backgroundRouter) readSubscription(ctx context.Context, subscription *pubsub.Subscription) {
 	for {
 		if err := ctx.Err(); err != nil {
 			if err != context.Canceled {
@@ -429,9 +627,14 @@ func (rtr *backgroundRouter) readSubscription(ctx context.Context) {
 			}
 			return
 		}
-		msg, err := rtr.subscription.Next(ctx)
+		msg, err := subscription.Next(ctx)
 
 		if err != nil {
+			// NB: `Next()` returns `pubsub.ErrSubscriptionCancelled` after
+			// `subscription.Cancel()` is called (i.e. via `#Unsubscribe()`).
+			if err == pubsub.ErrSubscriptionCancelled {
+				return
+			}
 			rtr.logger.Error().Err(err).
 				Msg("error reading from background topic subscription")
 			continue
@@ -445,6 +648,32 @@ func (rtr *backgroundRouter) readSubscription(ctx context.Context) {
 	}
 }
 
+// handleUnicastMsg verifies the `PocketEnvelope` contained in the given
+// background message, received via unicast, before handling it. Messages
+// received via gossipsub are verified by the respective topic validator prior
+// to propagation.
+func (rtr *backgroundRouter) handleUnicastMsg(backgroundMsgBz []byte) error {
+	var backgroundMsg typesP2P.BackgroundMessage
+	if err := proto.Unmarshal(backgroundMsgBz, &backgroundMsg); err != nil {
+		return err
+	}
+
+	if backgroundMsg.Data == nil {
+		return nil
+	}
+
+	poktEnvelope := &messaging.PocketEnvelope{}
+	if err := proto.Unmarshal(backgroundMsg.Data, poktEnvelope); err != nil {
+		return fmt.Errorf("decoding pocket envelope: %w", err)
+	}
+
+	if err := rtr.verifyPocketEnvelope(poktEnvelope); err != nil {
+		return fmt.Errorf("verifying pocket envelope: %w", err)
+	}
+
+	return rtr.handler(backgroundMsg.Data)
+}
+
 func (rtr *backgroundRouter) handleBackgroundMsg(backgroundMsgBz []byte) error {
 	var backgroundMsg typesP2P.BackgroundMessage
 	if err := proto.Unmarshal(backgroundMsgBz, &backgroundMsg); err != nil {
@@ -464,3 +693,26 @@ func (rtr *backgroundRouter) handleBackgroundMsg(backgroundMsgBz []byte) error {
 func isClientDebugMode(bus modules.Bus) bool {
 	return bus.GetRuntimeMgr().GetConfig().ClientDebugMode
 }
+
+// topicSubscription holds a joined pubsub topic and its subscription, if any.
+type topicSubscription struct {
+	topic *pubsub.Topic
+	// subscription provides an interface to continuously read messages from.
+	// It is nil if the topic has only been joined for publishing.
+	subscription *pubsub.Subscription
+	// cancelRead terminates the respective `#readSubscription()` go routine.
+	cancelRead context.CancelFunc
+}
+
+// unsubscribe cancels the subscription, if any, and terminates the respective
+// `#readSubscription()` go routine.
+func (topicSub *topicSubscription) unsubscribe() {
+	if topicSub.subscription == nil {
+		return
+	}
+
+	topicSub.cancelRead()
+	topicSub.subscription.Cancel()
+	topicSub.subscription = nil
+	topicSub.cancelRead = nil
+}
@@ -158,6 +158,12 @@ func TestBackgroundRouter_Validation(t *testing.T) {
 				Data: mustMarshal(t, &invalidProtoMessage),
 			}),
 		},
+		{
+			name: "forged PocketEnvelope signature",
+			msgBz: mustMarshal(t, &typesP2P.BackgroundMessage{
+				Data: mustMarshal(t, newForgedPocketEnvelope(t)),
+			}),
+		},
 	}
 
 	// Set up test router as the receiver.
@@ -368,6 +374,103 @@ func TestBackgroundRouter_Broadcast(t *testing.T) {
 	require.ElementsMatchf(t, expectedPeerIDs, actualPeerIDs, "peerIDs don't match")
 }
 
+func TestBackgroundRouter_PublishSubscribe(t *testing.T) {
+	const (
+		testTopic           = "pokt/test"
+		testTimeoutDuration = time.Second * 5
+	)
+
+	var (
+		ctx           = context.Background()
+		libp2pMockNet = mocknet.New()
+		receivedChan  = make(chan []byte, 2)
+	)
+
+	validMsg := &anypb.Any{TypeUrl: "/test", Value: []byte("valid")}
+	invalidMsg := &anypb.Any{TypeUrl: "/test", Value: []byte("invalid")}
+
+	receiverPrivKey, receiverPeer := newTestPeer(t)
+	receiverHost := newTestHost(t, libp2pMockNet, receiverPrivKey)
+	receiverRouter := newRouterWithSelfPeerAndHost(t, receiverPeer, receiverHost, func(data []byte) error {
+		receivedChan <- data
+		return nil
+	})
+
+	senderPrivKey, senderPeer := newTestPeer(t)
+	senderHost := newTestHost(t, libp2pMockNet, senderPrivKey)
+	senderRouter := newRouterWithSelfPeerAndHost(t, senderPeer, senderHost, nil)
+
+	t.Cleanup(func() {
+		require.NoError(t, receiverRouter.Close())
+		require.NoError(t, senderRouter.Close())
+	})
+
+	err := receiverRouter.Subscribe(testTopic, func(content *anypb.Any) bool {
+		return string(content.GetValue()) != string(invalidMsg.Value)
+	})
+	require.NoError(t, err)
+
+	// Subscribing twice to the same topic is an error.
+	err = receiverRouter.Subscribe(testTopic, nil)
+	require.ErrorIs(t, err, typesP2P.ErrAlreadySubscribed)
+
+	// The default topic can't be (un)subscribed.
+	err = receiverRouter.Subscribe(protocol.BackgroundTopicStr, nil)
+	require.ErrorIs(t, err, typesP2P.ErrReservedTopic)
+
+	err = libp2pMockNet.LinkAll()
+	require.NoError(t, err)
+
+	receiverAddrInfo, err := utils.Libp2pAddrInfoFromPeer(receiverPeer)
+	require.NoError(t, err)
+	receiverAddrInfo.Addrs = receiverHost.Addrs()
+
+	err = senderHost.Connect(ctx, receiverAddrInfo)
+	require.NoError(t, err)
+
+	// Wait for the sender to learn about the receiver's subscription.
+	require.Eventually(t, func() bool {
+		for _, peerID := range senderRouter.gossipSub.ListPeers(testTopic) {
+			if peerID == receiverHost.ID() {
+				return true
+			}
+		}
+		return false
+	}, testTimeoutDuration, time.Millisecond*50)
+
+	for _, msg := range []*anypb.Any{invalidMsg, validMsg} {
+		poktEnvelope := &messaging.PocketEnvelope{
+			Content: msg,
+			Nonce:   cryptoPocket.GetNonce(),
+		}
+		err = senderRouter.Publish(testTopic, mustMarshal(t, poktEnvelope))
+		require.NoError(t, err)
+	}
+
+	select {
+	case data := <-receivedChan:
+		poktEnvelope := &messaging.PocketEnvelope{}
+		err := proto.Unmarshal(data, poktEnvelope)
+		require.NoError(t, err)
+		require.Equal(t, validMsg.Value, poktEnvelope.GetContent().GetValue())
+	case <-time.After(testTimeoutDuration):
+		t.Fatal("timed out waiting for message on subscribed topic")
+	}
+
+	// Ensure the invalid message was not handled.
+	select {
+	case <-receivedChan:
+		t.Fatal("invalid message should not have been handled by receiver router")
+	case <-time.After(invalidReceiveTimeout):
+	}
+
+	err = receiverRouter.Unsubscribe(testTopic)
+	require.NoError(t, err)
+
+	err = receiverRouter.Unsubscribe(testTopic)
+	require.ErrorIs(t, err, typesP2P.ErrNotSubscribed)
+}
+
 // bootstrap connects each host to one other except for the arbitrarily chosen "bootstrap host"
 func bootstrap(t *testing.T, ctx context.Context, testHosts []libp2pHost.Host) {
 	t.Helper()
@@ -397,6 +500,25 @@ func bootstrap(t *testing.T, ctx context.Context, testHosts []libp2pHost.Host) {
 }
 
 // TECHDEBT(#609): move & de-duplicate
+// newForgedPocketEnvelope returns a signed `PocketEnvelope` whose content was
+// altered after signing.
+func newForgedPocketEnvelope(t *testing.T) *messaging.PocketEnvelope {
+	t.Helper()
+
+	privKey, err := cryptoPocket.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	poktEnvelope := &messaging.PocketEnvelope{
+		Content: &anypb.Any{TypeUrl: "/test", Value: []byte("original")},
+		Nonce:   cryptoPocket.GetNonce(),
+	}
+	err = poktEnvelope.Sign(privKey)
+	require.NoError(t, err)
+
+	poktEnvelope.Content.Value = []byte("forged")
+	return poktEnvelope
+}
+
 func newTestRouter(
 	t *testing.T,
 	libp2pMockNet mocknet.Mocknet,
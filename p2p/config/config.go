@@ -17,6 +17,7 @@ var (
 	_ typesP2P.RouterConfig = &UnicastRouterConfig{}
 	_ typesP2P.RouterConfig = &BackgroundConfig{}
 	_ typesP2P.RouterConfig = &RainTreeConfig{}
+	_ typesP2P.RouterConfig = &RequestResponseConfig{}
 )
 
 // baseConfig implements `RouterConfig` using the given libp2p host, pokt address
@@ -39,11 +40,22 @@ type UnicastRouterConfig struct {
 	PeerHandler    func(peer typesP2P.Peer) error
 }
 
+// RequestResponseConfig implements `RouterConfig` for use with the
+// `request_response.Service`.
+type RequestResponseConfig struct {
+	Logger         *modules.Logger
+	Host           host.Host
+	RequestHandler typesP2P.RequestHandler
+}
+
 // BackgroundConfig implements `RouterConfig` for use with `BackgroundRouter`.
 type BackgroundConfig struct {
 	Host    host.Host
 	Addr    crypto.Address
 	Handler func(data []byte) error
+	// RequireSignedEnvelopes determines whether received `PocketEnvelope`s
+	// which are not signed are rejected.
+	RequireSignedEnvelopes bool
 }
 
 // RainTreeConfig implements `RouterConfig` for use with `RainTreeRouter`.
@@ -51,6 +63,9 @@ type RainTreeConfig struct {
 	Host    host.Host
 	Addr    crypto.Address
 	Handler func(data []byte) error
+	// RequireSignedEnvelopes determines whether received `PocketEnvelope`s
+	// which are not signed are rejected.
+	RequireSignedEnvelopes bool
 }
 
 // IsValid implements the respective member of the `RouterConfig` interface.
@@ -93,6 +108,22 @@ func (cfg *UnicastRouterConfig) IsValid() (err error) {
 	return err
 }
 
+// IsValid implements the respective member of the `RouterConfig` interface.
+func (cfg *RequestResponseConfig) IsValid() (err error) {
+	if cfg.Logger == nil {
+		err = errors.Join(err, fmt.Errorf("logger not configured"))
+	}
+
+	if cfg.Host == nil {
+		err = errors.Join(err, fmt.Errorf("host not configured"))
+	}
+
+	if cfg.RequestHandler == nil {
+		err = errors.Join(err, fmt.Errorf("request handler not configured"))
+	}
+	return err
+}
+
 // IsValid implements the respective member of the `RouterConfig` interface.
 func (cfg *BackgroundConfig) IsValid() error {
 	baseCfg := baseConfig{
@@ -5,6 +5,7 @@ import (
 
 	"google.golang.org/protobuf/types/known/anypb"
 
+	typesP2P "github.com/pokt-network/pocket/p2p/types"
 	"github.com/pokt-network/pocket/shared/codec"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	"github.com/pokt-network/pocket/shared/messaging"
@@ -30,28 +31,7 @@ func (m *p2pModule) HandleEvent(event *anypb.Any) error {
 			return nil // unstaked actors do not use RainTree and therefore do not need to update this router
 		}
 
-		oldPeerList := m.stakedActorRouter.GetPeerstore().GetPeerList()
-		pstoreProvider, err := m.getPeerstoreProvider()
-		if err != nil {
-			return err
-		}
-
-		updatedPeerstore, err := pstoreProvider.GetStakedPeerstoreAtHeight(consensusNewHeightEvent.Height)
-		if err != nil {
-			return err
-		}
-
-		added, removed := oldPeerList.Delta(updatedPeerstore.GetPeerList())
-		for _, add := range added {
-			if err := m.stakedActorRouter.AddPeer(add); err != nil {
-				return err
-			}
-		}
-		for _, rm := range removed {
-			if err := m.stakedActorRouter.RemovePeer(rm); err != nil {
-				return err
-			}
-		}
+		return m.updateStakedPeerstore(consensusNewHeightEvent.Height)
 
 	case messaging.StateMachineTransitionEventType:
 		stateMachineTransitionEvent, ok := evt.(*messaging.StateMachineTransitionEvent)
@@ -93,3 +73,43 @@ func (m *p2pModule) HandleEvent(event *anypb.Any) error {
 
 	return nil
 }
+
+// updateStakedPeerstore updates the staked actor router's peerstore to match the
+// staked actor set at the given height (i.e. the height of the last committed
+// block). Peers which stake, unstake or change their service URL are added,
+// removed or updated, respectively.
+func (m *p2pModule) updateStakedPeerstore(height uint64) error {
+	if m.stakedActorRouter == nil {
+		return nil
+	}
+
+	pstoreProvider, err := m.getPeerstoreProvider()
+	if err != nil {
+		return err
+	}
+
+	updatedPeerstore, err := pstoreProvider.GetStakedPeerstoreAtHeight(height)
+	if err != nil {
+		return err
+	}
+
+	if pstoreUpdater, ok := m.stakedActorRouter.(typesP2P.PeerstoreUpdater); ok {
+		return pstoreUpdater.UpdatePeerstore(updatedPeerstore)
+	}
+
+	// Fall back to incremental updates for routers which don't support
+	// replacing their peerstore.
+	oldPeerList := m.stakedActorRouter.GetPeerstore().GetPeerList()
+	added, removed := oldPeerList.Delta(updatedPeerstore.GetPeerList())
+	for _, add := range added {
+		if err := m.stakedActorRouter.AddPeer(add); err != nil {
+			return err
+		}
+	}
+	for _, rm := range removed {
+		if err := m.stakedActorRouter.RemovePeer(rm); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package p2p
 import (
 	"errors"
 	"fmt"
+	"sync"
 	"sync/atomic"
 
 	"github.com/libp2p/go-libp2p"
@@ -18,6 +19,7 @@ import (
 	"github.com/pokt-network/pocket/p2p/providers/peerstore_provider"
 	persPSP "github.com/pokt-network/pocket/p2p/providers/peerstore_provider/persistence"
 	"github.com/pokt-network/pocket/p2p/raintree"
+	"github.com/pokt-network/pocket/p2p/request_response"
 	typesP2P "github.com/pokt-network/pocket/p2p/types"
 	"github.com/pokt-network/pocket/p2p/utils"
 	"github.com/pokt-network/pocket/runtime/configs"
@@ -28,6 +30,7 @@ import (
 	"github.com/pokt-network/pocket/shared/messaging"
 	"github.com/pokt-network/pocket/shared/modules"
 	"github.com/pokt-network/pocket/shared/modules/base_modules"
+	"github.com/pokt-network/pocket/signer"
 	"github.com/pokt-network/pocket/telemetry"
 )
 
@@ -44,10 +47,25 @@ type p2pModule struct {
 	bootstrapNodes []string
 	identity       libp2p.Option
 	listenAddrs    libp2p.Option
+	// signer is used to sign outgoing `PocketEnvelope`s. It is only
+	// assigned if `P2PConfig.SignEnvelopes` is true.
+	signer modules.Signer
 
 	// Assigned during creation via `#setupDependencies()`.
 	nonceDeduper *mempool.GenericFIFOSet[uint64, uint64]
 
+	// requestHandlers maps protobuf type URLs to the handlers registered via
+	// `#RegisterRequestHandler()`. Handlers may be registered before the module
+	// is started.
+	requestHandlersMu sync.RWMutex
+	requestHandlers   map[string]modules.P2PRequestHandler
+
+	// topicSubscriptions maps pubsub topics subscribed to via `#Subscribe()` to
+	// their respective validators. Subscriptions are (re-)applied to the unstaked
+	// actor router whenever it is set up (i.e. on `#Start()`).
+	topicSubscriptionsMu sync.Mutex
+	topicSubscriptions   map[string]modules.P2PTopicValidator
+
 	// TECHDEBT(#810): register the routers to the module registry instead of
 	// holding a reference in the module struct. This will improve testability.
 	//
@@ -60,6 +78,9 @@ type p2pModule struct {
 	// according to options. Assigned via `#Start()` (starts on instantiation).
 	// (see: https://pkg.go.dev/github.com/libp2p/go-libp2p#section-readme)
 	host libp2pHost.Host
+	// requestResponseService is used for synchronous round trips between peers.
+	// Assigned during `#Start()` as it depends on `host`.
+	requestResponseService *request_response.Service
 }
 
 func Create(bus modules.Bus, options ...modules.ModuleOption) (modules.Module, error) {
@@ -69,8 +90,10 @@ func Create(bus modules.Bus, options ...modules.ModuleOption) (modules.Module, e
 func (m *p2pModule) Create(bus modules.Bus, options ...modules.ModuleOption) (modules.Module, error) {
 	logger.Global.Debug().Msg("Creating P2P module")
 	*m = p2pModule{
-		cfg:    bus.GetRuntimeMgr().GetConfig().P2P,
-		logger: logger.Global.CreateLoggerForModule(modules.P2PModuleName),
+		cfg:                bus.GetRuntimeMgr().GetConfig().P2P,
+		logger:             logger.Global.CreateLoggerForModule(modules.P2PModuleName),
+		requestHandlers:    make(map[string]modules.P2PRequestHandler),
+		topicSubscriptions: make(map[string]modules.P2PTopicValidator),
 	}
 
 	// MUST call before referencing m.bus to ensure != nil.
@@ -87,6 +110,26 @@ func (m *p2pModule) Create(bus modules.Bus, options ...modules.ModuleOption) (mo
 		return nil, fmt.Errorf("parsing private key as pocket key: %w", err)
 	}
 	m.address = privateKey.Address()
+	remoteSignerEnabled := bus.GetRuntimeMgr().GetConfig().Validator.GetRemoteSigner().GetEnabled()
+	if remoteSignerEnabled {
+		// The host keeps the P2P identity key, so it MUST NOT be the validator
+		// key held by the remote signer. The node is still addressed by the
+		// address of the validator it is staked as.
+		validatorPubKey := bus.GetConsensusModule().GetSigner().PublicKey()
+		if privateKey.PublicKey().Equals(validatorPubKey) {
+			return nil, typesP2P.ErrValidatorKeyAsP2PKey
+		}
+		m.address = validatorPubKey.Address()
+	}
+	if m.cfg.SignEnvelopes {
+		// The envelopes are signed by the validator key of the consensus module
+		// when it is held by a remote signer.
+		if remoteSignerEnabled {
+			m.signer = bus.GetConsensusModule().GetSigner()
+		} else {
+			m.signer = signer.NewLocalSigner(privateKey)
+		}
+	}
 
 	libp2pPrivKey, err := cryptoPocket.NewLibP2PPrivateKey(m.cfg.PrivateKey)
 	if err != nil {
@@ -162,6 +205,14 @@ func (m *p2pModule) Start() (err error) {
 		return fmt.Errorf("setting up routers: %w", err)
 	}
 
+	if err := m.setupTopicSubscriptions(); err != nil {
+		return fmt.Errorf("setting up topic subscriptions: %w", err)
+	}
+
+	if err := m.setupRequestResponseService(); err != nil {
+		return fmt.Errorf("setting up request/response service: %w", err)
+	}
+
 	m.GetBus().
 		GetTelemetryModule().
 		GetTimeSeriesAgent().
@@ -181,6 +232,11 @@ func (m *p2pModule) Stop() error {
 		stakedActorRouterCloseErr = m.stakedActorRouter.Close()
 	}
 
+	var requestResponseCloseErr error
+	if m.requestResponseService != nil {
+		requestResponseCloseErr = m.requestResponseService.Close()
+	}
+
 	routerCloseErrs := errors.Join(
 		m.unstakedActorRouter.Close(),
 		stakedActorRouterCloseErr,
@@ -188,6 +244,7 @@ func (m *p2pModule) Stop() error {
 
 	err := errors.Join(
 		routerCloseErrs,
+		requestResponseCloseErr,
 		m.host.Close(),
 	)
 
@@ -195,6 +252,7 @@ func (m *p2pModule) Stop() error {
 	m.host = nil
 	m.stakedActorRouter = nil
 	m.unstakedActorRouter = nil
+	m.requestResponseService = nil
 	return err
 }
 
@@ -214,11 +272,7 @@ func (m *p2pModule) Broadcast(msg *anypb.Any) error {
 		return fmt.Errorf("broadcasting: unstaked actor router not started")
 	}
 
-	poktEnvelope := &messaging.PocketEnvelope{
-		Content: msg,
-		Nonce:   cryptoPocket.GetNonce(),
-	}
-	poktEnvelopeBz, err := codec.GetCodec().Marshal(poktEnvelope)
+	poktEnvelopeBz, err := m.packPocketEnvelope(msg)
 	if err != nil {
 		return err
 	}
@@ -234,12 +288,7 @@ func (m *p2pModule) Broadcast(msg *anypb.Any) error {
 }
 
 func (m *p2pModule) Send(addr cryptoPocket.Address, msg *anypb.Any) error {
-	poktEnvelope := &messaging.PocketEnvelope{
-		Content: msg,
-		Nonce:   cryptoPocket.GetNonce(),
-	}
-
-	poktEnvelopeBz, err := codec.GetCodec().Marshal(poktEnvelope)
+	poktEnvelopeBz, err := m.packPocketEnvelope(msg)
 	if err != nil {
 		return err
 	}
@@ -356,9 +405,10 @@ func (m *p2pModule) setupStakedRouter() (err error) {
 	m.stakedActorRouter, err = raintree.Create(
 		m.GetBus(),
 		&config.RainTreeConfig{
-			Addr:    m.address,
-			Host:    m.host,
-			Handler: m.handlePocketEnvelope,
+			Addr:                   m.address,
+			Host:                   m.host,
+			Handler:                m.handlePocketEnvelope,
+			RequireSignedEnvelopes: m.cfg.RequireSignedEnvelopes,
 		},
 	)
 	if err != nil {
@@ -380,9 +430,10 @@ func (m *p2pModule) setupUnstakedRouter() (err error) {
 	m.unstakedActorRouter, err = background.Create(
 		m.GetBus(),
 		&config.BackgroundConfig{
-			Addr:    m.address,
-			Host:    m.host,
-			Handler: m.handlePocketEnvelope,
+			Addr:                   m.address,
+			Host:                   m.host,
+			Handler:                m.handlePocketEnvelope,
+			RequireSignedEnvelopes: m.cfg.RequireSignedEnvelopes,
 		},
 	)
 	if err != nil {
@@ -391,6 +442,21 @@ func (m *p2pModule) setupUnstakedRouter() (err error) {
 	return nil
 }
 
+// setupRequestResponseService instantiates the request/response service which
+// handles incoming requests and is used by `#Request()`.
+func (m *p2pModule) setupRequestResponseService() (err error) {
+	m.logger.Debug().Msg("setting up request/response service")
+	m.requestResponseService, err = request_response.Create(
+		m.GetBus(),
+		&config.RequestResponseConfig{
+			Logger:         m.logger,
+			Host:           m.host,
+			RequestHandler: m.handleRequest,
+		},
+	)
+	return err
+}
+
 // setupHost creates a new libp2p host and assigns it to `m.host`. Libp2p host
 // starts listening upon instantiation.
 func (m *p2pModule) setupHost() (err error) {
@@ -413,7 +479,12 @@ func (m *p2pModule) setupHost() (err error) {
 			libp2p.NoListenAddrs,
 		)
 	} else {
+		natOpts, err := m.getNATTraversalOptions()
+		if err != nil {
+			return fmt.Errorf("getting NAT traversal options: %w", err)
+		}
 		opts = append(opts, m.listenAddrs)
+		opts = append(opts, natOpts...)
 	}
 
 	m.host, err = libp2p.New(opts...)
@@ -435,6 +506,22 @@ func (m *p2pModule) isClientDebugMode() bool {
 	return m.GetBus().GetRuntimeMgr().GetConfig().ClientDebugMode
 }
 
+// packPocketEnvelope wraps the given message in a `PocketEnvelope`, signing it
+// if `P2PConfig.SignEnvelopes` is true, and returns the serialized envelope.
+func (m *p2pModule) packPocketEnvelope(msg *anypb.Any) ([]byte, error) {
+	poktEnvelope := &messaging.PocketEnvelope{
+		Content: msg,
+		Nonce:   cryptoPocket.GetNonce(),
+	}
+
+	if m.signer != nil {
+		if err := m.signer.SignEnvelope(poktEnvelope); err != nil {
+			return nil, err
+		}
+	}
+	return codec.GetCodec().Marshal(poktEnvelope)
+}
+
 // handlePocketEnvelope deserializes the received `PocketEnvelope` data and publishes
 // a copy of its `Content` to the application event bus.
 func (m *p2pModule) handlePocketEnvelope(pocketEnvelopeBz []byte) error {
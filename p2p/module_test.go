@@ -23,6 +23,7 @@ import (
 	"github.com/pokt-network/pocket/shared/messaging"
 	"github.com/pokt-network/pocket/shared/modules"
 	mockModules "github.com/pokt-network/pocket/shared/modules/mocks"
+	"github.com/pokt-network/pocket/signer"
 )
 
 // TECHDEBT(#609): move & de-dup.
@@ -239,6 +240,77 @@ func TestP2pModule_InvalidNonce(t *testing.T) {
 	require.ErrorIs(t, err, typesP2P.ErrInvalidNonce)
 }
 
+func TestP2pModule_Create_RemoteSigner(t *testing.T) {
+	validatorPrivKey := cryptoPocket.GetPrivKeySeed(1)
+	p2pPrivKey := cryptoPocket.GetPrivKeySeed(2)
+
+	tests := []struct {
+		name       string
+		p2pPrivKey cryptoPocket.PrivateKey
+		wantErr    error
+	}{
+		{
+			name:       "separate P2P identity key",
+			p2pPrivKey: p2pPrivKey,
+		},
+		{
+			name:       "validator key as P2P identity key",
+			p2pPrivKey: validatorPrivKey,
+			wantErr:    typesP2P.ErrValidatorKeyAsP2PKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRuntimeMgr := mockModules.NewMockRuntimeMgr(ctrl)
+			mockBus := createMockBus(t, mockRuntimeMgr, nil)
+
+			genesisStateMock := createMockGenesisState(nil)
+			persistenceMock := preparePersistenceMock(t, mockBus, genesisStateMock)
+			mockBus.EXPECT().GetPersistenceModule().Return(persistenceMock).AnyTimes()
+
+			// The remote signer holds the validator key.
+			consensusModuleMock := mockModules.NewMockConsensusModule(ctrl)
+			consensusModuleMock.EXPECT().CurrentHeight().Return(uint64(1)).AnyTimes()
+			consensusModuleMock.EXPECT().GetSigner().Return(signer.NewLocalSigner(validatorPrivKey)).AnyTimes()
+			mockBus.EXPECT().GetConsensusModule().Return(consensusModuleMock).AnyTimes()
+
+			currentHeightProviderMock := prepareCurrentHeightProviderMock(t, mockBus)
+			mockBus.RegisterModule(currentHeightProviderMock)
+
+			pstore := new(typesP2P.PeerAddrMap)
+			pstoreProviderMock := preparePeerstoreProviderMock(t, mockBus, pstore)
+			mockBus.RegisterModule(pstoreProviderMock)
+
+			mockRuntimeMgr.EXPECT().GetConfig().Return(&configs.Config{
+				P2P: &configs.P2PConfig{
+					PrivateKey:    tt.p2pPrivKey.String(),
+					MaxNonces:     defaults.DefaultP2PMaxNonces,
+					SignEnvelopes: true,
+				},
+				Validator: &configs.ValidatorConfig{
+					Enabled:      true,
+					RemoteSigner: &configs.RemoteSignerConfig{Enabled: true},
+				},
+			}).AnyTimes()
+			mockBus.EXPECT().GetRuntimeMgr().Return(mockRuntimeMgr).AnyTimes()
+
+			p2pMod, err := Create(mockBus)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			// The node is addressed as the validator while its libp2p identity is the P2P key.
+			addr, err := p2pMod.(modules.P2PModule).GetAddress()
+			require.NoError(t, err)
+			require.Equal(t, validatorPrivKey.Address(), addr)
+		})
+	}
+}
+
 // TECHDEBT(#609): move & de-duplicate
 func newP2PModule(t *testing.T, privKey cryptoPocket.PrivateKey, opts ...modules.ModuleOption) *p2pModule {
 	t.Helper()
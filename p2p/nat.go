@@ -0,0 +1,70 @@
+package p2p
+
+import (
+	"context"
+	"math/rand"
+
+	"github.com/libp2p/go-libp2p"
+	libp2pPeer "github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/pokt-network/pocket/p2p/utils"
+	"github.com/pokt-network/pocket/runtime/configs/types"
+)
+
+// getNATTraversalOptions returns the libp2p host options which configure NAT
+// traversal and circuit relay according to the P2P config.
+func (m *p2pModule) getNATTraversalOptions() ([]libp2p.Option, error) {
+	var isStaked bool
+	if m.cfg.RelayMode != types.RelayMode_NoRelay {
+		staked, err := m.isStakedActor()
+		if err != nil {
+			// INVESTIGATE: the staked actor set may change over time but the
+			// relay mode is only determined once, when the host is created.
+			m.logger.Warn().Err(err).Msg("unable to determine if staked actor, assuming unstaked")
+		}
+		isStaked = staked
+	}
+
+	return utils.Libp2pNATOptions(m.cfg, isStaked, m.relayPeerSource)
+}
+
+// relayPeerSource implements `autorelay.PeerSource`. It provides up to `num`
+// randomly selected staked actors (excluding self) as relay candidates.
+// (see: https://pkg.go.dev/github.com/libp2p/go-libp2p@v0.26.3/p2p/host/autorelay#PeerSource)
+func (m *p2pModule) relayPeerSource(_ context.Context, num int) <-chan libp2pPeer.AddrInfo {
+	pstore, err := m.getStakedPeerstore()
+	if err != nil {
+		m.logger.Warn().Err(err).Msg("getting staked peerstore for relay candidates")
+		candidatesCh := make(chan libp2pPeer.AddrInfo)
+		close(candidatesCh)
+		return candidatesCh
+	}
+
+	peers := pstore.GetPeerList()
+	rand.Shuffle(len(peers), func(i, j int) {
+		peers[i], peers[j] = peers[j], peers[i]
+	})
+
+	candidatesCh := make(chan libp2pPeer.AddrInfo, num)
+	defer close(candidatesCh)
+
+	for _, peer := range peers {
+		if len(candidatesCh) == num {
+			break
+		}
+
+		if peer.GetAddress().Equals(m.address) {
+			continue
+		}
+
+		addrInfo, err := utils.Libp2pAddrInfoFromPeer(peer)
+		if err != nil {
+			m.logger.Warn().Err(err).
+				Str("pokt_address", peer.GetAddress().String()).
+				Msg("converting relay candidate peer info")
+			continue
+		}
+		candidatesCh <- addrInfo
+	}
+	return candidatesCh
+}
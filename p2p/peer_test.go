@@ -73,3 +73,23 @@ func Test_getPeerListDelta(t *testing.T) {
 		})
 	}
 }
+
+func Test_getPeerListUpdated(t *testing.T) {
+	var (
+		addr1 = crypto.AddressFromString("000000000000000000000000000000000001")
+		addr2 = crypto.AddressFromString("000000000000000000000000000000000002")
+	)
+
+	before := typesP2P.PeerList{
+		&typesP2P.NetworkPeer{Address: addr1, ServiceURL: "10.0.0.1:42069"},
+		&typesP2P.NetworkPeer{Address: addr2, ServiceURL: "10.0.0.2:42069"},
+	}
+	after := typesP2P.PeerList{
+		&typesP2P.NetworkPeer{Address: addr1, ServiceURL: "10.0.0.1:42069"},
+		&typesP2P.NetworkPeer{Address: addr2, ServiceURL: "10.0.0.3:42069"},
+		&typesP2P.NetworkPeer{Address: crypto.AddressFromString("000000000000000000000000000000000003")},
+	}
+
+	updated := before.Updated(after)
+	require.Equal(t, typesP2P.PeerList{after[1]}, updated)
+}
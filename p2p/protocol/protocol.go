@@ -13,6 +13,10 @@ const (
 	// for the local peer. Libp2p APIs use this to distinguish which multiplexed
 	// protocols/streams to consider.
 	BackgroundProtocolID = protocol.ID("pokt/background/v1.0.0")
+	// RequestResponseProtocolID is the libp2p protocol ID used by the P2P module
+	// when opening a new stream to a remote peer for a synchronous request and
+	// when setting the stream handler for responding to incoming requests.
+	RequestResponseProtocolID = protocol.ID("pokt/request_response/v1.0.0")
 	// BackgroundTopicStr is a "default" pubsub topic string used when
 	// subscribing and broadcasting.
 	BackgroundTopicStr = "pokt/background"
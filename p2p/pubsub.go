@@ -0,0 +1,114 @@
+package p2p
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	typesP2P "github.com/pokt-network/pocket/p2p/types"
+	"github.com/pokt-network/pocket/shared/modules"
+)
+
+// Publish implements the respective `modules.P2PModule` interface method.
+func (m *p2pModule) Publish(topic string, msg *anypb.Any) error {
+	pubSubRouter, err := m.getPubSubRouter()
+	if err != nil {
+		return fmt.Errorf("publishing: %w", err)
+	}
+
+	poktEnvelopeBz, err := m.packPocketEnvelope(msg)
+	if err != nil {
+		return err
+	}
+
+	return pubSubRouter.Publish(topic, poktEnvelopeBz)
+}
+
+// Subscribe implements the respective `modules.P2PModule` interface method.
+func (m *p2pModule) Subscribe(topic string, validator modules.P2PTopicValidator) error {
+	m.topicSubscriptionsMu.Lock()
+	defer m.topicSubscriptionsMu.Unlock()
+
+	if _, ok := m.topicSubscriptions[topic]; ok {
+		return fmt.Errorf("%w: %q", typesP2P.ErrAlreadySubscribed, topic)
+	}
+
+	// Subscribe immediately if the module has been started; otherwise, the
+	// subscription is applied in `#setupTopicSubscriptions()`.
+	if m.unstakedActorRouter != nil {
+		pubSubRouter, err := m.getPubSubRouter()
+		if err != nil {
+			return fmt.Errorf("subscribing: %w", err)
+		}
+
+		if err := pubSubRouter.Subscribe(topic, typesP2P.TopicValidator(validator)); err != nil {
+			return err
+		}
+	}
+
+	m.topicSubscriptions[topic] = validator
+	return nil
+}
+
+// Unsubscribe implements the respective `modules.P2PModule` interface method.
+func (m *p2pModule) Unsubscribe(topic string) error {
+	m.topicSubscriptionsMu.Lock()
+	defer m.topicSubscriptionsMu.Unlock()
+
+	if _, ok := m.topicSubscriptions[topic]; !ok {
+		return fmt.Errorf("%w: %q", typesP2P.ErrNotSubscribed, topic)
+	}
+
+	if m.unstakedActorRouter != nil {
+		pubSubRouter, err := m.getPubSubRouter()
+		if err != nil {
+			return fmt.Errorf("unsubscribing: %w", err)
+		}
+
+		if err := pubSubRouter.Unsubscribe(topic); err != nil {
+			return err
+		}
+	}
+
+	delete(m.topicSubscriptions, topic)
+	return nil
+}
+
+// setupTopicSubscriptions subscribes the unstaked actor router to all topics
+// which were subscribed to via `#Subscribe()`.
+func (m *p2pModule) setupTopicSubscriptions() error {
+	m.topicSubscriptionsMu.Lock()
+	defer m.topicSubscriptionsMu.Unlock()
+
+	if len(m.topicSubscriptions) == 0 {
+		return nil
+	}
+
+	pubSubRouter, err := m.getPubSubRouter()
+	if err != nil {
+		return err
+	}
+
+	for topic, validator := range m.topicSubscriptions {
+		if err := pubSubRouter.Subscribe(topic, typesP2P.TopicValidator(validator)); err != nil {
+			return fmt.Errorf("subscribing to topic: %q: %w", topic, err)
+		}
+	}
+	return nil
+}
+
+// getPubSubRouter returns the unstaked actor router as a `typesP2P.PubSubRouter`.
+func (m *p2pModule) getPubSubRouter() (typesP2P.PubSubRouter, error) {
+	if m.unstakedActorRouter == nil {
+		return nil, fmt.Errorf("unstaked actor router not started")
+	}
+
+	pubSubRouter, ok := m.unstakedActorRouter.(typesP2P.PubSubRouter)
+	if !ok {
+		return nil, fmt.Errorf(
+			"unstaked actor router does not support pubsub: %T",
+			m.unstakedActorRouter,
+		)
+	}
+	return pubSubRouter, nil
+}
@@ -51,10 +51,23 @@ func newPeersManager(selfAddr cryptoPocket.Address, pstore typesP2P.Peerstore, i
 }
 
 func (pm *rainTreePeersManager) HandleEvent(evt typesP2P.PeerManagerEvent) {
+	pm.maxLevelsMutex.Lock()
+	defer pm.maxLevelsMutex.Unlock()
+
 	pm.SortedPeerManager.HandleEvent(evt)
 	pm.updateMaxNumLevels()
 }
 
+// SetPeerstore atomically replaces the peerstore, rebuilds the peers view and
+// updates the max number of levels accordingly.
+func (pm *rainTreePeersManager) SetPeerstore(pstore typesP2P.Peerstore) {
+	pm.maxLevelsMutex.Lock()
+	defer pm.maxLevelsMutex.Unlock()
+
+	pm.SortedPeerManager.SetPeerstore(pstore)
+	pm.updateMaxNumLevels()
+}
+
 func (pm *rainTreePeersManager) GetPeersView() typesP2P.PeersView {
 	return pm.SortedPeerManager.GetPeersView()
 }
@@ -66,8 +79,13 @@ func (pm *rainTreePeersManager) GetMaxNumLevels() uint32 {
 	return pm.maxNumLevels
 }
 
+// getPeersViewWithLevels returns the peers view and the max number of levels
+// consistently with one another (i.e. as of the same peerstore).
 func (pm *rainTreePeersManager) getPeersViewWithLevels() (view typesP2P.PeersView, level uint32) {
-	return pm.GetPeersView(), pm.GetMaxNumLevels()
+	pm.maxLevelsMutex.Lock()
+	defer pm.maxLevelsMutex.Unlock()
+
+	return pm.GetPeersView(), pm.maxNumLevels
 }
 
 // DISCUSS: This is only used in tests. Should we remove it?
@@ -1,7 +1,9 @@
 package raintree
 
 import (
+	"errors"
 	"fmt"
+	"sync"
 
 	libp2pHost "github.com/libp2p/go-libp2p/core/host"
 	"google.golang.org/protobuf/proto"
@@ -21,9 +23,10 @@ import (
 )
 
 var (
-	_ typesP2P.Router          = &rainTreeRouter{}
-	_ modules.IntegrableModule = &rainTreeRouter{}
-	_ rainTreeFactory          = &rainTreeRouter{}
+	_ typesP2P.Router           = &rainTreeRouter{}
+	_ typesP2P.PeerstoreUpdater = &rainTreeRouter{}
+	_ modules.IntegrableModule  = &rainTreeRouter{}
+	_ rainTreeFactory           = &rainTreeRouter{}
 )
 
 type rainTreeFactory = modules.FactoryWithConfig[typesP2P.Router, *config.RainTreeConfig]
@@ -41,7 +44,13 @@ type rainTreeRouter struct {
 	// (see: https://pkg.go.dev/github.com/libp2p/go-libp2p#section-readme)
 	host libp2pHost.Host
 	// selfAddr is the pocket address representing this host.
-	selfAddr     cryptoPocket.Address
+	selfAddr cryptoPocket.Address
+	// requireSignedEnvelopes determines whether received `PocketEnvelope`s
+	// which are not signed are rejected.
+	requireSignedEnvelopes bool
+	// peersMu serializes peerstore modifications (i.e. `#AddPeer()`,
+	// `#RemovePeer()` & `#UpdatePeerstore()`).
+	peersMu      sync.Mutex
 	peersManager *rainTreePeersManager
 }
 
@@ -59,10 +68,11 @@ func (*rainTreeRouter) Create(
 	}
 
 	rtr := &rainTreeRouter{
-		host:     cfg.Host,
-		selfAddr: cfg.Addr,
-		logger:   rainTreeLogger,
-		handler:  cfg.Handler,
+		host:                   cfg.Host,
+		selfAddr:               cfg.Addr,
+		logger:                 rainTreeLogger,
+		handler:                cfg.Handler,
+		requireSignedEnvelopes: cfg.RequireSignedEnvelopes,
 	}
 	bus.RegisterModule(rtr)
 
@@ -259,10 +269,24 @@ func (rtr *rainTreeRouter) handleRainTreeMsg(rainTreeMsgBz []byte) error {
 }
 
 // validateRainTreeMsg ensures that the `data` contained within the RainTree message
-// is a valid `PocketEnvelope` by attempting to deserialize it.
+// is a valid `PocketEnvelope` by attempting to deserialize it and verifying its
+// signature, if any, against the staked actor set.
 func (rtr *rainTreeRouter) validateRainTreeMsg(rainTreeMsg *typesP2P.RainTreeMessage) error {
+	// NB: messages without data are not handled (see: `#handleRainTreeMsg()`).
+	if rainTreeMsg.Data == nil {
+		return nil
+	}
+
 	networkMessage := messaging.PocketEnvelope{}
-	return proto.Unmarshal(rainTreeMsg.Data, &networkMessage)
+	if err := proto.Unmarshal(rainTreeMsg.Data, &networkMessage); err != nil {
+		return err
+	}
+
+	return utils.VerifyPocketEnvelope(
+		&networkMessage,
+		rtr.GetPeerstore(),
+		rtr.requireSignedEnvelopes,
+	)
 }
 
 // GetPeerstore implements the respective member of `typesP2P.Router`.
@@ -272,8 +296,11 @@ func (rtr *rainTreeRouter) GetPeerstore() typesP2P.Peerstore {
 
 // AddPeer implements the respective member of `typesP2P.Router`.
 func (rtr *rainTreeRouter) AddPeer(peer typesP2P.Peer) error {
+	rtr.peersMu.Lock()
+	defer rtr.peersMu.Unlock()
+
 	// Noop if peer with the same pokt address exists in the peerstore.
-	// TECHDEBT: add method(s) to update peers.
+	// (see: `#UpdatePeerstore()`)
 	if p := rtr.peersManager.GetPeerstore().GetPeer(peer.GetAddress()); p != nil {
 		return nil
 	}
@@ -293,6 +320,9 @@ func (rtr *rainTreeRouter) AddPeer(peer typesP2P.Peer) error {
 
 // RemovePeer implements the respective member of `typesP2P.Router`.
 func (rtr *rainTreeRouter) RemovePeer(peer typesP2P.Peer) error {
+	rtr.peersMu.Lock()
+	defer rtr.peersMu.Unlock()
+
 	rtr.peersManager.HandleEvent(
 		typesP2P.PeerManagerEvent{
 			EventType: typesP2P.RemovePeerEventType,
@@ -302,6 +332,72 @@ func (rtr *rainTreeRouter) RemovePeer(peer typesP2P.Peer) error {
 	return nil
 }
 
+// UpdatePeerstore implements the respective member of `typesP2P.PeerstoreUpdater`.
+// Peers which were added, removed or whose service URL changed are first
+// updated in the libp2p host's peerstore, then the peerstore is replaced and
+// the peers view rebuilt atomically.
+func (rtr *rainTreeRouter) UpdatePeerstore(pstore typesP2P.Peerstore) (err error) {
+	rtr.peersMu.Lock()
+	defer rtr.peersMu.Unlock()
+
+	currentPeers := rtr.peersManager.GetPeerstore().GetPeerList()
+	newPeers := pstore.GetPeerList()
+	added, removed := currentPeers.Delta(newPeers)
+	updated := currentPeers.Updated(newPeers)
+
+	if len(added)+len(removed)+len(updated) == 0 {
+		return nil
+	}
+
+	rtr.logger.Info().Fields(map[string]any{
+		"added":   len(added),
+		"removed": len(removed),
+		"updated": len(updated),
+	}).Msg("updating raintree peerstore")
+
+	// Copy the current peerstore such that it's not modified while in use.
+	updatedPstore := make(typesP2P.PeerAddrMap)
+	for _, peer := range currentPeers {
+		if addErr := updatedPstore.AddPeer(peer); addErr != nil {
+			err = errors.Join(err, addErr)
+		}
+	}
+
+	for _, peer := range append(removed, updated...) {
+		if rmErr := utils.RemovePeerFromLibp2pHost(rtr.host, peer); rmErr != nil {
+			err = errors.Join(err, rmErr)
+		}
+		if rmErr := updatedPstore.RemovePeer(peer.GetAddress()); rmErr != nil {
+			err = errors.Join(err, rmErr)
+		}
+	}
+
+	// NB: libp2p peerstores continue to resolve the multiaddrs of removed peers
+	// until their respective TTLs expire. Multiaddrs corresponding to a previous
+	// service URL must be cleared explicitly.
+	for _, peer := range updated {
+		peerInfo, infoErr := utils.Libp2pAddrInfoFromPeer(peer)
+		if infoErr != nil {
+			err = errors.Join(err, infoErr)
+			continue
+		}
+		rtr.host.Peerstore().ClearAddrs(peerInfo.ID)
+	}
+
+	for _, peer := range append(added, updated...) {
+		if addErr := utils.AddPeerToLibp2pHost(rtr.host, peer); addErr != nil {
+			err = errors.Join(err, addErr)
+			continue
+		}
+		if addErr := updatedPstore.AddPeer(peer); addErr != nil {
+			err = errors.Join(err, addErr)
+		}
+	}
+
+	rtr.peersManager.SetPeerstore(updatedPstore)
+	return err
+}
+
 // Size returns the number of peers the network is aware of and would attempt to
 // broadcast to.
 func (rtr *rainTreeRouter) Size() int {
@@ -165,6 +165,91 @@ func TestRainTreeRouter_RemovePeer(t *testing.T) {
 	require.Nil(t, getPeer(removedAddr), "Peerstore contains removed peer")
 }
 
+func TestRainTreeRouter_UpdatePeerstore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	// Start with a peerstore which contains self and 3 other peers.
+	pstore := getPeerstore(t, 3)
+
+	selfPeer, host := newTestPeer(t)
+	selfAddr := selfPeer.GetAddress()
+
+	err := pstore.AddPeer(selfPeer)
+	require.NoError(t, err)
+
+	busMock := mockBus(ctrl, pstore)
+	rtCfg := &config.RainTreeConfig{
+		Host:    host,
+		Addr:    selfAddr,
+		Handler: noopHandler,
+	}
+
+	router, err := Create(busMock, rtCfg)
+	require.NoError(t, err)
+	rainTree := router.(*rainTreeRouter)
+
+	initialView := rainTree.peersManager.GetPeersView()
+	initialAddrs := append([]string{}, initialView.GetAddrs()...)
+
+	// The updated peerstore: one peer unstaked, one peer changed its service
+	// URL, one peer is unchanged and one peer newly staked.
+	var peerToRemove, peerToUpdate, unchangedPeer typesP2P.Peer
+	for _, peer := range pstore.GetPeerList() {
+		switch {
+		case peer.GetAddress().Equals(selfAddr):
+		case peerToRemove == nil:
+			peerToRemove = peer
+		case peerToUpdate == nil:
+			peerToUpdate = peer
+		default:
+			unchangedPeer = peer
+		}
+	}
+
+	updatedPeer := &typesP2P.NetworkPeer{
+		PublicKey:  peerToUpdate.GetPublicKey(),
+		Address:    peerToUpdate.GetAddress(),
+		ServiceURL: "10.0.0.2:42069",
+	}
+
+	privKey, err := cryptoPocket.GeneratePrivateKey()
+	require.NoError(t, err)
+	peerToAdd := &typesP2P.NetworkPeer{
+		PublicKey:  privKey.PublicKey(),
+		Address:    privKey.Address(),
+		ServiceURL: "10.0.0.3:42069",
+	}
+
+	updatedPStore := make(typesP2P.PeerAddrMap)
+	for _, peer := range []typesP2P.Peer{selfPeer, unchangedPeer, updatedPeer, peerToAdd} {
+		err = updatedPStore.AddPeer(peer)
+		require.NoError(t, err)
+	}
+
+	err = rainTree.UpdatePeerstore(updatedPStore)
+	require.NoError(t, err)
+
+	// Ensure the view obtained prior to the update was not modified.
+	require.Equal(t, initialAddrs, initialView.GetAddrs())
+
+	peerAddrs, peers := getPeersViewParts(rainTree.peersManager)
+	require.Equal(t, updatedPStore.Size(), rainTree.GetPeerstore().Size())
+	require.ElementsMatch(t, updatedPStore.GetPeerList(), peers)
+	require.Equal(t, selfAddr.String(), peerAddrs[0], "self is not first in view")
+	require.Nil(t, rainTree.GetPeerstore().GetPeer(peerToRemove.GetAddress()))
+	require.Equal(t, updatedPeer, rainTree.GetPeerstore().GetPeer(updatedPeer.GetAddress()))
+	require.Equal(t, peerToAdd, rainTree.GetPeerstore().GetPeer(peerToAdd.GetAddress()))
+
+	// Ensure the libp2p host's peerstore was updated accordingly.
+	updatedPeerInfo, err := utils.Libp2pAddrInfoFromPeer(updatedPeer)
+	require.NoError(t, err)
+	require.ElementsMatch(t, updatedPeerInfo.Addrs, host.Peerstore().Addrs(updatedPeerInfo.ID))
+
+	addedPeerInfo, err := utils.Libp2pAddrInfoFromPeer(peerToAdd)
+	require.NoError(t, err)
+	require.ElementsMatch(t, addedPeerInfo.Addrs, host.Peerstore().Addrs(addedPeerInfo.ID))
+}
+
 func getPeersViewParts(pm typesP2P.PeerManager) (
 	addrs []string,
 	peers typesP2P.PeerList,
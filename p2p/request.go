@@ -0,0 +1,73 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	typesP2P "github.com/pokt-network/pocket/p2p/types"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/modules"
+)
+
+// Request implements the respective `modules.P2PModule` interface method.
+func (m *p2pModule) Request(ctx context.Context, addr cryptoPocket.Address, msg *anypb.Any) (*anypb.Any, error) {
+	if m.requestResponseService == nil {
+		return nil, fmt.Errorf("requesting: request/response service not started")
+	}
+
+	peer, err := m.getPeer(addr)
+	if err != nil {
+		return nil, fmt.Errorf("requesting: %w", err)
+	}
+
+	return m.requestResponseService.Request(ctx, peer, msg)
+}
+
+// RegisterRequestHandler implements the respective `modules.P2PModule` interface method.
+func (m *p2pModule) RegisterRequestHandler(typeURL string, handler modules.P2PRequestHandler) error {
+	m.requestHandlersMu.Lock()
+	defer m.requestHandlersMu.Unlock()
+
+	if _, ok := m.requestHandlers[typeURL]; ok {
+		return fmt.Errorf("%w: %s", typesP2P.ErrRequestHandlerRegistered, typeURL)
+	}
+
+	m.requestHandlers[typeURL] = handler
+	return nil
+}
+
+// handleRequest dispatches the content of an incoming request to the handler
+// registered for its type URL.
+func (m *p2pModule) handleRequest(from cryptoPocket.Address, req *anypb.Any) (*anypb.Any, error) {
+	if req == nil {
+		return nil, fmt.Errorf("%w: empty request", typesP2P.ErrNoRequestHandler)
+	}
+
+	m.requestHandlersMu.RLock()
+	handler, ok := m.requestHandlers[req.GetTypeUrl()]
+	m.requestHandlersMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", typesP2P.ErrNoRequestHandler, req.GetTypeUrl())
+	}
+	return handler(from, req)
+}
+
+// getPeer returns the peer with the given address from the staked actor
+// router's peerstore, if available, otherwise from the unstaked actor router's.
+func (m *p2pModule) getPeer(addr cryptoPocket.Address) (typesP2P.Peer, error) {
+	if m.stakedActorRouter != nil {
+		if peer := m.stakedActorRouter.GetPeerstore().GetPeer(addr); peer != nil {
+			return peer, nil
+		}
+	}
+
+	if m.unstakedActorRouter != nil {
+		if peer := m.unstakedActorRouter.GetPeerstore().GetPeer(addr); peer != nil {
+			return peer, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", typesP2P.ErrUnknownPeer, addr)
+}
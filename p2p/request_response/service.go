@@ -0,0 +1,291 @@
+package request_response
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	libp2pHost "github.com/libp2p/go-libp2p/core/host"
+	libp2pNetwork "github.com/libp2p/go-libp2p/core/network"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pokt-network/pocket/p2p/config"
+	"github.com/pokt-network/pocket/p2p/protocol"
+	typesP2P "github.com/pokt-network/pocket/p2p/types"
+	"github.com/pokt-network/pocket/p2p/utils"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/modules"
+	"github.com/pokt-network/pocket/shared/modules/base_modules"
+)
+
+// TECHDEBT(#629): configure timeouts. Consider security exposure vs. real-world conditions.
+// TECHDEBT(#629): parameterize and expose via config.
+const (
+	// DefaultRequestTimeout is the duration to wait for a response to a request
+	// if the caller's context has no deadline.
+	DefaultRequestTimeout = time.Second * 10
+	// readStreamTimeout is the duration to wait for an incoming request to be
+	// read, after which the stream is reset ("timed out").
+	readStreamTimeout = time.Second * 10
+	// maxMessageBytes is the maximum size of a serialized request or response.
+	maxMessageBytes = 1 << 24 // 16 MiB
+)
+
+var _ requestResponseFactory = &Service{}
+
+type requestResponseFactory = modules.FactoryWithConfig[*Service, *config.RequestResponseConfig]
+
+// Service implements synchronous request/response round trips between peers
+// over libp2p streams. Each request is written to a new stream (using
+// `protocol.RequestResponseProtocolID`); the remote peer writes its response
+// back to the same stream.
+type Service struct {
+	base_modules.IntegrableModule
+
+	logger *modules.Logger
+	// host represents a libp2p network node, it encapsulates a libp2p peerstore
+	// & connection manager. `libp2p.New` configures and starts listening
+	// according to options.
+	// (see: https://pkg.go.dev/github.com/libp2p/go-libp2p#section-readme)
+	host libp2pHost.Host
+	// requestHandler is called to produce a response for each incoming request.
+	requestHandler typesP2P.RequestHandler
+}
+
+type streamReadResult struct {
+	data []byte
+	err  error
+}
+
+func Create(bus modules.Bus, cfg *config.RequestResponseConfig) (*Service, error) {
+	return new(Service).Create(bus, cfg)
+}
+
+func (*Service) Create(bus modules.Bus, cfg *config.RequestResponseConfig) (*Service, error) {
+	if err := cfg.IsValid(); err != nil {
+		return nil, err
+	}
+
+	svc := &Service{
+		logger:         cfg.Logger,
+		host:           cfg.Host,
+		requestHandler: cfg.RequestHandler,
+	}
+
+	// `Service` is not a submodule and therefore does not register with the
+	// module registry. However, as it does depend on the bus and therefore MUST
+	// embed the base `IntegrableModule` and call `#SetBus()`.
+	svc.SetBus(bus)
+
+	// Don't respond to incoming requests in client debug mode.
+	if !svc.isClientDebugMode() {
+		svc.host.SetStreamHandler(protocol.RequestResponseProtocolID, svc.handleStream)
+	}
+
+	return svc, nil
+}
+
+// Close removes the request/response stream handler from the libp2p host.
+func (svc *Service) Close() error {
+	svc.host.RemoveStreamHandler(protocol.RequestResponseProtocolID)
+	return nil
+}
+
+// Request sends `content` to the given peer and waits for its response. If `ctx`
+// has no deadline, `DefaultRequestTimeout` is applied. The stream is reset if
+// `ctx` is done before a response is received.
+func (svc *Service) Request(ctx context.Context, peer typesP2P.Peer, content *anypb.Any) (*anypb.Any, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, DefaultRequestTimeout)
+		defer cancel()
+	}
+
+	peerInfo, err := utils.Libp2pAddrInfoFromPeer(peer)
+	if err != nil {
+		return nil, err
+	}
+
+	requestID := cryptoPocket.GetNonce()
+	requestBz, err := proto.Marshal(&typesP2P.RequestResponseMessage{
+		RequestId: requestID,
+		Content:   content,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshalling request: %w", err)
+	}
+
+	stream, err := svc.host.NewStream(ctx, peerInfo.ID, protocol.RequestResponseProtocolID)
+	if err != nil {
+		return nil, fmt.Errorf("opening stream: %w", err)
+	}
+
+	if _, err := stream.Write(requestBz); err != nil {
+		return nil, errors.Join(
+			fmt.Errorf("writing request to stream: %w", err),
+			stream.Reset(),
+		)
+	}
+
+	// NB: closing the write side signals the end of the request to the remote
+	// peer, which reads until EOF.
+	if err := stream.CloseWrite(); err != nil {
+		return nil, errors.Join(
+			fmt.Errorf("closing stream (write-side): %w", err),
+			stream.Reset(),
+		)
+	}
+
+	// Read the response in a go routine so that the request can be canceled
+	// independently of stream read deadline support (e.g. libp2p `mocknet`).
+	resultCh := make(chan streamReadResult, 1)
+	go func() {
+		data, err := readMessage(stream)
+		resultCh <- streamReadResult{data: data, err: err}
+	}()
+
+	var result streamReadResult
+	select {
+	case <-ctx.Done():
+		return nil, errors.Join(
+			fmt.Errorf("awaiting response: %w", ctx.Err()),
+			stream.Reset(),
+		)
+	case result = <-resultCh:
+	}
+
+	if result.err != nil {
+		return nil, errors.Join(
+			fmt.Errorf("reading response from stream: %w", result.err),
+			stream.Reset(),
+		)
+	}
+
+	if err := stream.Close(); err != nil {
+		svc.logger.Debug().Err(err).Msg("closing request stream")
+	}
+
+	response := &typesP2P.RequestResponseMessage{}
+	if err := proto.Unmarshal(result.data, response); err != nil {
+		return nil, fmt.Errorf("unmarshalling response: %w", err)
+	}
+
+	if response.RequestId != requestID {
+		return nil, fmt.Errorf(
+			"%w: expected %d, got %d",
+			typesP2P.ErrRequestIDMismatch,
+			requestID,
+			response.RequestId,
+		)
+	}
+
+	if response.Error != "" {
+		return nil, fmt.Errorf("%w: %s", typesP2P.ErrRequestFailed, response.Error)
+	}
+	return response.Content, nil
+}
+
+// handleStream handles each incoming request stream in a new go routine.
+func (svc *Service) handleStream(stream libp2pNetwork.Stream) {
+	go svc.handleRequest(stream)
+}
+
+// handleRequest reads the request out of the given stream, passes its content
+// to `svc.requestHandler` and writes the response back to the stream. Intended
+// to be called in a go routine.
+func (svc *Service) handleRequest(stream libp2pNetwork.Stream) {
+	peer, err := utils.PeerFromLibp2pStream(stream)
+	if err != nil {
+		svc.logger.Error().Err(err).Msg("parsing remote peer identity")
+		svc.resetStream(stream)
+		return
+	}
+
+	// Time out if no data is sent to free resources.
+	if err := stream.SetReadDeadline(time.Now().Add(readStreamTimeout)); err != nil {
+		// NB: `SetReadDeadline` is not supported by libp2p `mocknet` streams.
+		svc.logger.Debug().Err(err).Msg("setting stream read deadline")
+	}
+
+	requestBz, err := readMessage(stream)
+	if err != nil {
+		svc.logger.Error().Err(err).Msg("reading request from stream")
+		svc.resetStream(stream)
+		return
+	}
+
+	request := &typesP2P.RequestResponseMessage{}
+	if err := proto.Unmarshal(requestBz, request); err != nil {
+		svc.logger.Error().Err(err).Msg("unmarshalling request")
+		svc.resetStream(stream)
+		return
+	}
+
+	if request.RequestId == 0 {
+		svc.logger.Error().Err(typesP2P.ErrInvalidRequestID).
+			Str("address", peer.GetAddress().String()).
+			Msg("handling request")
+		svc.resetStream(stream)
+		return
+	}
+
+	response := &typesP2P.RequestResponseMessage{
+		RequestId: request.RequestId,
+	}
+	content, err := svc.requestHandler(peer.GetAddress(), request.Content)
+	if err != nil {
+		response.Error = err.Error()
+	} else {
+		response.Content = content
+	}
+
+	responseBz, err := proto.Marshal(response)
+	if err != nil {
+		svc.logger.Error().Err(err).Msg("marshalling response")
+		svc.resetStream(stream)
+		return
+	}
+
+	if _, err := stream.Write(responseBz); err != nil {
+		svc.logger.Error().Err(err).Msg("writing response to stream")
+		svc.resetStream(stream)
+		return
+	}
+
+	// MUST USE `stream.Close()`; otherwise, streams will accumulate until
+	// resource limits are hit.
+	if err := stream.Close(); err != nil {
+		svc.logger.Error().Err(err).Msg("closing response stream")
+	}
+}
+
+// resetStream resets the given stream, logging any error.
+// NB: failing to reset the stream can easily max out the number of available
+// network connections on the receiver's side.
+func (svc *Service) resetStream(stream libp2pNetwork.Stream) {
+	if err := stream.Reset(); err != nil {
+		svc.logger.Error().Err(err).Msg("resetting stream")
+	}
+}
+
+// isClientDebugMode returns the value of `ClientDebugMode` in the base config
+func (svc *Service) isClientDebugMode() bool {
+	return svc.GetBus().GetRuntimeMgr().GetConfig().ClientDebugMode
+}
+
+// readMessage reads the given stream until EOF, returning an error if more
+// than `maxMessageBytes` are received.
+func readMessage(stream io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(stream, maxMessageBytes+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > maxMessageBytes {
+		return nil, typesP2P.ErrMessageTooLarge
+	}
+	return data, nil
+}
@@ -0,0 +1,202 @@
+package request_response
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	libp2pCrypto "github.com/libp2p/go-libp2p/core/crypto"
+	libp2pHost "github.com/libp2p/go-libp2p/core/host"
+	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pokt-network/pocket/logger"
+	"github.com/pokt-network/pocket/p2p/config"
+	typesP2P "github.com/pokt-network/pocket/p2p/types"
+	"github.com/pokt-network/pocket/p2p/utils"
+	"github.com/pokt-network/pocket/runtime/configs"
+	"github.com/pokt-network/pocket/runtime/defaults"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+	mockModules "github.com/pokt-network/pocket/shared/modules/mocks"
+)
+
+const testTypeURL = "/test"
+
+// TECHDEBT(#609): move & de-dup.
+var testLocalServiceURL = fmt.Sprintf("127.0.0.1:%d", defaults.DefaultP2PPort)
+
+func TestRequestResponse_Request(t *testing.T) {
+	var (
+		testRequest  = &anypb.Any{TypeUrl: testTypeURL, Value: []byte("ping")}
+		testResponse = &anypb.Any{TypeUrl: testTypeURL, Value: []byte("pong")}
+		errTest      = errors.New("test handler error")
+	)
+
+	testCases := []struct {
+		name             string
+		handler          typesP2P.RequestHandler
+		expectedResponse *anypb.Any
+		expectedErr      error
+	}{
+		{
+			name: "successful round trip",
+			handler: func(_ cryptoPocket.Address, req *anypb.Any) (*anypb.Any, error) {
+				if string(req.Value) != "ping" {
+					return nil, fmt.Errorf("unexpected request: %s", req.Value)
+				}
+				return testResponse, nil
+			},
+			expectedResponse: testResponse,
+		},
+		{
+			name: "remote handler error",
+			handler: func(_ cryptoPocket.Address, _ *anypb.Any) (*anypb.Any, error) {
+				return nil, errTest
+			},
+			expectedErr: typesP2P.ErrRequestFailed,
+		},
+		{
+			name: "request canceled by timeout",
+			handler: func(_ cryptoPocket.Address, _ *anypb.Any) (*anypb.Any, error) {
+				time.Sleep(time.Second)
+				return testResponse, nil
+			},
+			expectedErr: context.DeadlineExceeded,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			libp2pMockNet := mocknet.New()
+
+			responderPrivKey, responderPeer := newTestPeer(t)
+			responderHost := newTestHost(t, libp2pMockNet, responderPrivKey, responderPeer)
+			_ = newTestService(t, responderHost, testCase.handler)
+
+			requesterPrivKey, requesterPeer := newTestPeer(t)
+			requesterHost := newTestHost(t, libp2pMockNet, requesterPrivKey, requesterPeer)
+			requester := newTestService(t, requesterHost, func(_ cryptoPocket.Address, _ *anypb.Any) (*anypb.Any, error) {
+				return nil, nil
+			})
+
+			err := libp2pMockNet.LinkAll()
+			require.NoError(t, err)
+
+			err = utils.AddPeerToLibp2pHost(requesterHost, responderPeer)
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*500)
+			defer cancel()
+
+			response, err := requester.Request(ctx, responderPeer, testRequest)
+			if testCase.expectedErr != nil {
+				require.ErrorIs(t, err, testCase.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedResponse.TypeUrl, response.TypeUrl)
+			require.Equal(t, testCase.expectedResponse.Value, response.Value)
+		})
+	}
+}
+
+func TestRequestResponse_RequestFrom(t *testing.T) {
+	libp2pMockNet := mocknet.New()
+	fromCh := make(chan cryptoPocket.Address, 1)
+
+	responderPrivKey, responderPeer := newTestPeer(t)
+	responderHost := newTestHost(t, libp2pMockNet, responderPrivKey, responderPeer)
+	_ = newTestService(t, responderHost, func(from cryptoPocket.Address, req *anypb.Any) (*anypb.Any, error) {
+		fromCh <- from
+		return req, nil
+	})
+
+	requesterPrivKey, requesterPeer := newTestPeer(t)
+	requesterHost := newTestHost(t, libp2pMockNet, requesterPrivKey, requesterPeer)
+	requester := newTestService(t, requesterHost, func(_ cryptoPocket.Address, _ *anypb.Any) (*anypb.Any, error) {
+		return nil, nil
+	})
+
+	err := libp2pMockNet.LinkAll()
+	require.NoError(t, err)
+
+	err = utils.AddPeerToLibp2pHost(requesterHost, responderPeer)
+	require.NoError(t, err)
+
+	_, err = requester.Request(context.Background(), responderPeer, &anypb.Any{TypeUrl: testTypeURL})
+	require.NoError(t, err)
+
+	select {
+	case from := <-fromCh:
+		require.Equal(t, requesterPeer.GetAddress(), from)
+	default:
+		t.Fatal("expected request handler to be called")
+	}
+}
+
+func newTestService(t *testing.T, host libp2pHost.Host, handler typesP2P.RequestHandler) *Service {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	runtimeMgrMock := mockModules.NewMockRuntimeMgr(ctrl)
+	runtimeMgrMock.EXPECT().GetConfig().Return(&configs.Config{
+		P2P: &configs.P2PConfig{},
+	}).AnyTimes()
+
+	busMock := mockModules.NewMockBus(ctrl)
+	busMock.EXPECT().GetRuntimeMgr().Return(runtimeMgrMock).AnyTimes()
+
+	svc, err := Create(busMock, &config.RequestResponseConfig{
+		Logger:         logger.Global.CreateLoggerForModule("request_response_test"),
+		Host:           host,
+		RequestHandler: handler,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, svc.Close())
+	})
+	return svc
+}
+
+// TECHDEBT(#609): move & de-duplicate
+func newTestPeer(t *testing.T) (cryptoPocket.PrivateKey, *typesP2P.NetworkPeer) {
+	t.Helper()
+
+	privKey, err := cryptoPocket.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	return privKey, &typesP2P.NetworkPeer{
+		PublicKey:  privKey.PublicKey(),
+		Address:    privKey.Address(),
+		ServiceURL: testLocalServiceURL,
+	}
+}
+
+func newTestHost(
+	t *testing.T,
+	mockNet mocknet.Mocknet,
+	privKey cryptoPocket.PrivateKey,
+	peer *typesP2P.NetworkPeer,
+) libp2pHost.Host {
+	t.Helper()
+
+	libp2pPrivKey, err := libp2pCrypto.UnmarshalEd25519PrivateKey(privKey.Bytes())
+	require.NoError(t, err)
+
+	libp2pMultiAddr, err := utils.Libp2pMultiaddrFromServiceURL(peer.ServiceURL)
+	require.NoError(t, err)
+
+	host, err := mockNet.AddPeer(libp2pPrivKey, libp2pMultiAddr)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = host.Close()
+	})
+	return host
+}
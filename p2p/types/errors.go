@@ -8,6 +8,23 @@ import (
 var (
 	ErrUnknownPeer  = errors.New("unknown peer")
 	ErrInvalidNonce = errors.New("invalid nonce")
+
+	ErrInvalidRequestID         = errors.New("invalid request ID")
+	ErrRequestIDMismatch        = errors.New("response request ID mismatch")
+	ErrRequestFailed            = errors.New("remote peer failed to handle request")
+	ErrRequestHandlerRegistered = errors.New("request handler already registered")
+	ErrNoRequestHandler         = errors.New("no request handler registered")
+	ErrMessageTooLarge          = errors.New("message exceeds maximum size")
+
+	ErrInvalidTopic      = errors.New("invalid pubsub topic")
+	ErrReservedTopic     = errors.New("reserved pubsub topic")
+	ErrAlreadySubscribed = errors.New("already subscribed to pubsub topic")
+	ErrNotSubscribed     = errors.New("not subscribed to pubsub topic")
+
+	ErrStaleEnvelope             = errors.New("envelope signature timestamp out of range")
+	ErrEnvelopePublicKeyMismatch = errors.New("envelope public key does not match staked actor's")
+
+	ErrValidatorKeyAsP2PKey = errors.New("the P2P private key must not be the validator key when the remote signer is enabled")
 )
 
 func ErrUnknownEventType(msg any) error {
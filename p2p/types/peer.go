@@ -37,3 +37,24 @@ func (peers PeerList) Delta(comparePeers PeerList) (added, removed PeerList) {
 	}
 	return
 }
+
+// Updated returns the peers in `comparePeers` which are also present in `peers`
+// (by pokt address) but whose service URL differs.
+func (peers PeerList) Updated(comparePeers PeerList) (updated PeerList) {
+	existingPeers := make(PeerAddrMap)
+	for _, p := range peers {
+		existingPeers.mustAddPeer(p)
+	}
+
+	for _, comparePeer := range comparePeers {
+		existingPeer := existingPeers.GetPeer(comparePeer.GetAddress())
+		if existingPeer == nil {
+			continue
+		}
+
+		if existingPeer.GetServiceURL() != comparePeer.GetServiceURL() {
+			updated = append(updated, comparePeer)
+		}
+	}
+	return updated
+}
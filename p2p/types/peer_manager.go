@@ -14,6 +14,10 @@ type PeerManager interface {
 	GetPeersView() PeersView
 	// HandleEvent synchronously reacts to `PeerManagerEvent`s
 	HandleEvent(event PeerManagerEvent)
+	// SetPeerstore atomically replaces the peerstore and rebuilds the peers
+	// view such that concurrent readers observe either the previous or the
+	// new peerstore & view; never an intermediate state.
+	SetPeerstore(pstore Peerstore)
 }
 
 const (
@@ -108,9 +112,23 @@ func NewSortedPeerManager(startAddr crypto.Address, pstore Peerstore, isDynamic
 }
 
 func (sortedPM *SortedPeerManager) GetPeerstore() Peerstore {
+	sortedPM.m.RLock()
+	defer sortedPM.m.RUnlock()
+
 	return sortedPM.pstore
 }
 
+// SetPeerstore implements the respective member of the `PeerManager` interface.
+func (sortedPM *SortedPeerManager) SetPeerstore(pstore Peerstore) {
+	view := NewSortedPeersView(sortedPM.startAddr, pstore)
+
+	sortedPM.m.Lock()
+	defer sortedPM.m.Unlock()
+
+	sortedPM.pstore = pstore
+	sortedPM.view = view
+}
+
 func (sortedPM *SortedPeerManager) GetPeersView() PeersView {
 	sortedPM.m.RLock()
 	defer sortedPM.m.RUnlock()
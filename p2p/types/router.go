@@ -1,8 +1,10 @@
 package types
 
-//go:generate mockgen -package=mock_types -destination=./mocks/network_mock.go github.com/pokt-network/pocket/p2p/types Router,RouterConfig
+//go:generate mockgen -package=mock_types -destination=./mocks/network_mock.go github.com/pokt-network/pocket/p2p/types Router,PubSubRouter,PeerstoreUpdater,RouterConfig
 
 import (
+	"google.golang.org/protobuf/types/known/anypb"
+
 	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
 	"github.com/pokt-network/pocket/shared/modules"
 )
@@ -49,8 +51,41 @@ type Router interface {
 	RemovePeer(peer Peer) error
 }
 
+// PubSubRouter is a `Router` which additionally supports publishing to, and
+// subscribing to, arbitrary pubsub topics.
+type PubSubRouter interface {
+	Router
+
+	// Publish broadcasts the given data to all peers subscribed to the given topic.
+	Publish(topic string, data []byte) error
+	// Subscribe subscribes to the given topic; received messages which pass
+	// validation, including the given validator (if not nil), are handled the
+	// same way as broadcast messages.
+	Subscribe(topic string, validator TopicValidator) error
+	// Unsubscribe cancels the subscription to the given topic.
+	Unsubscribe(topic string) error
+}
+
+// PeerstoreUpdater is implemented by routers whose peerstore may be updated as
+// a whole (e.g. `RainTreeRouter` when the staked actor set changes).
+type PeerstoreUpdater interface {
+	// UpdatePeerstore adds, removes & updates peers such that the router's
+	// peerstore matches the given one. Concurrent broadcasts observe either
+	// the previous or the updated peerstore; never an intermediate state.
+	UpdatePeerstore(pstore Peerstore) error
+}
+
 type MessageHandler func(data []byte) error
 
+// TopicValidator is called with the content of each message received on a
+// pubsub topic, prior to propagation and handling. Messages for which it
+// returns false are dropped.
+type TopicValidator func(content *anypb.Any) bool
+
+// RequestHandler is called with the address of the requesting peer and the
+// content of an incoming request and returns the content of the response.
+type RequestHandler func(from cryptoPocket.Address, req *anypb.Any) (*anypb.Any, error)
+
 // RouterConfig is used to configure `Router` implementations and to test a
 // given configuration's validity.
 type RouterConfig interface {
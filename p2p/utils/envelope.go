@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	typesP2P "github.com/pokt-network/pocket/p2p/types"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/messaging"
+)
+
+const (
+	// TECHDEBT(#629): parameterize and expose via config.
+	// maxEnvelopeAge is the maximum duration since a `PocketEnvelope` was signed
+	// after which it is no longer accepted. It bounds the window in which signed
+	// envelopes may be replayed (see: `NonceDeduper`).
+	maxEnvelopeAge = 5 * time.Minute
+	// maxEnvelopeClockSkew is the maximum duration by which a `PocketEnvelope`
+	// signature timestamp may be in the future.
+	maxEnvelopeClockSkew = 30 * time.Second
+)
+
+// VerifyPocketEnvelope verifies the signature of the given `PocketEnvelope`, if
+// any, and returns an error if it's invalid. Unsigned envelopes are only
+// accepted if `requireSignature` is false.
+//
+// If the claimed origin address is that of a staked actor (i.e. present in
+// `stakedPStore`), the envelope MUST be signed with the public key of the
+// staked actor on-chain.
+func VerifyPocketEnvelope(
+	envelope *messaging.PocketEnvelope,
+	stakedPStore typesP2P.Peerstore,
+	requireSignature bool,
+) error {
+	if !envelope.IsSigned() {
+		if requireSignature {
+			return messaging.ErrEnvelopeNotSigned
+		}
+		return nil
+	}
+
+	if err := verifyStakedOrigin(envelope.GetSignature(), stakedPStore); err != nil {
+		return err
+	}
+
+	if _, err := envelope.VerifySignature(); err != nil {
+		return err
+	}
+
+	signedAt := envelope.GetSignature().GetTimestamp().AsTime()
+	if age := time.Since(signedAt); age > maxEnvelopeAge || age < -maxEnvelopeClockSkew {
+		return fmt.Errorf("%w: signed at %s", typesP2P.ErrStaleEnvelope, signedAt)
+	}
+	return nil
+}
+
+// verifyStakedOrigin returns an error if the claimed origin of the signature is
+// a staked actor whose on-chain public key is not the signing key.
+func verifyStakedOrigin(signature *messaging.EnvelopeSignature, stakedPStore typesP2P.Peerstore) error {
+	if stakedPStore == nil {
+		return nil
+	}
+
+	originAddr := cryptoPocket.Address(signature.GetOriginAddress())
+	stakedPeer := stakedPStore.GetPeer(originAddr)
+	if stakedPeer == nil {
+		return nil
+	}
+
+	if !bytes.Equal(stakedPeer.GetPublicKey().Bytes(), signature.GetPublicKey()) {
+		return fmt.Errorf("%w: origin address: %s", typesP2P.ErrEnvelopePublicKeyMismatch, originAddr)
+	}
+	return nil
+}
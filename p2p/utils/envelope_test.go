@@ -0,0 +1,174 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	typesP2P "github.com/pokt-network/pocket/p2p/types"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/messaging"
+)
+
+func TestVerifyPocketEnvelope(t *testing.T) {
+	originPrivKey, err := cryptoPocket.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	otherPrivKey, err := cryptoPocket.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	stakedPStore := make(typesP2P.PeerAddrMap)
+	err = stakedPStore.AddPeer(&typesP2P.NetworkPeer{
+		PublicKey:  originPrivKey.PublicKey(),
+		Address:    originPrivKey.Address(),
+		ServiceURL: "10.0.0.1:42069",
+	})
+	require.NoError(t, err)
+
+	// A staked peerstore in which the origin's address maps to another public key.
+	mismatchedPStore := make(typesP2P.PeerAddrMap)
+	err = mismatchedPStore.AddPeer(&typesP2P.NetworkPeer{
+		PublicKey:  otherPrivKey.PublicKey(),
+		Address:    originPrivKey.Address(),
+		ServiceURL: "10.0.0.1:42069",
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name             string
+		signedAt         *time.Time
+		unsigned         bool
+		forgedOrigin     bool
+		stakedPStore     typesP2P.Peerstore
+		requireSignature bool
+		expectedErr      error
+	}{
+		{
+			name:     "unsigned envelope, signature not required",
+			unsigned: true,
+		},
+		{
+			name:             "unsigned envelope, signature required",
+			unsigned:         true,
+			requireSignature: true,
+			expectedErr:      messaging.ErrEnvelopeNotSigned,
+		},
+		{
+			name:             "signed by unstaked actor",
+			stakedPStore:     make(typesP2P.PeerAddrMap),
+			requireSignature: true,
+		},
+		{
+			name:             "signed by staked actor",
+			stakedPStore:     stakedPStore,
+			requireSignature: true,
+		},
+		{
+			name:         "staked actor public key mismatch",
+			stakedPStore: mismatchedPStore,
+			expectedErr:  typesP2P.ErrEnvelopePublicKeyMismatch,
+		},
+		{
+			name:             "staked origin claimed by another signer",
+			forgedOrigin:     true,
+			stakedPStore:     stakedPStore,
+			requireSignature: true,
+			expectedErr:      typesP2P.ErrEnvelopePublicKeyMismatch,
+		},
+		{
+			name:         "unstaked origin claimed by another signer",
+			forgedOrigin: true,
+			stakedPStore: make(typesP2P.PeerAddrMap),
+			expectedErr:  messaging.ErrInvalidEnvelopeSignature,
+		},
+		{
+			name:         "stale signature",
+			signedAt:     timePtr(time.Now().Add(-maxEnvelopeAge - time.Minute)),
+			stakedPStore: stakedPStore,
+			expectedErr:  typesP2P.ErrStaleEnvelope,
+		},
+		{
+			name:         "future signature",
+			signedAt:     timePtr(time.Now().Add(maxEnvelopeClockSkew + time.Minute)),
+			stakedPStore: stakedPStore,
+			expectedErr:  typesP2P.ErrStaleEnvelope,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			envelope := &messaging.PocketEnvelope{
+				Content: &anypb.Any{TypeUrl: "/test", Value: []byte("test")},
+				Nonce:   cryptoPocket.GetNonce(),
+			}
+
+			if !testCase.unsigned {
+				err := envelope.Sign(originPrivKey)
+				require.NoError(t, err)
+			}
+
+			if testCase.forgedOrigin {
+				forgeEnvelopeOrigin(t, envelope, otherPrivKey, originPrivKey.Address())
+			}
+
+			if testCase.signedAt != nil {
+				resignEnvelopeAt(t, envelope, originPrivKey, *testCase.signedAt)
+			}
+
+			err := VerifyPocketEnvelope(envelope, testCase.stakedPStore, testCase.requireSignature)
+			if testCase.expectedErr != nil {
+				require.ErrorIs(t, err, testCase.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+// resignEnvelopeAt re-signs the given envelope as if it had been signed at the
+// given time.
+func resignEnvelopeAt(
+	t *testing.T,
+	envelope *messaging.PocketEnvelope,
+	privKey cryptoPocket.PrivateKey,
+	signedAt time.Time,
+) {
+	t.Helper()
+
+	envelope.Signature.Timestamp = timestamppb.New(signedAt)
+	envelope.Signature.Signature = nil
+
+	signBz, err := proto.MarshalOptions{Deterministic: true}.Marshal(envelope)
+	require.NoError(t, err)
+
+	envelope.Signature.Signature, err = privKey.Sign(signBz)
+	require.NoError(t, err)
+}
+
+// forgeEnvelopeOrigin re-signs the given envelope with `privKey` while claiming
+// that it originates from `originAddr`.
+func forgeEnvelopeOrigin(
+	t *testing.T,
+	envelope *messaging.PocketEnvelope,
+	privKey cryptoPocket.PrivateKey,
+	originAddr cryptoPocket.Address,
+) {
+	t.Helper()
+
+	envelope.PrepareSignature(privKey.PublicKey())
+	envelope.Signature.OriginAddress = originAddr.Bytes()
+
+	signBz, err := envelope.SignBytes()
+	require.NoError(t, err)
+
+	envelope.Signature.Signature, err = privKey.Sign(signBz)
+	require.NoError(t, err)
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -6,19 +6,89 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/libp2p/go-libp2p"
 	libp2pHost "github.com/libp2p/go-libp2p/core/host"
 	libp2pProtocol "github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/libp2p/go-libp2p/p2p/host/autorelay"
 
 	"github.com/pokt-network/pocket/logger"
 	typesP2P "github.com/pokt-network/pocket/p2p/types"
+	"github.com/pokt-network/pocket/runtime/configs"
+	"github.com/pokt-network/pocket/runtime/configs/types"
 )
 
 const (
 	week = time.Hour * 24 * 7
 	// TECHDEBT(#629): consider more carefully and parameterize.
 	defaultPeerTTL = 2 * week
+	// TECHDEBT(#629): parameterize and expose via config.
+	// relayMinCandidates is the number of relay candidates (i.e. staked actors)
+	// the AutoRelay client waits for before reserving relay slots. Relay
+	// candidates are provided by the peer source given to `Libp2pNATOptions()`.
+	relayMinCandidates = 1
 )
 
+// Libp2pNATOptions returns the libp2p host options which configure NAT traversal
+// (AutoNAT service, UPnP / NAT-PMP port mapping & hole punching) and circuit
+// relay (v2) client and/or service, according to the given P2P config.
+//
+// `isStaked` determines which relay mode is used if `cfg.RelayMode` is
+// `RelayAuto`; the relay service is only enabled for staked actors.
+// `relayPeerSource` provides relay candidates to the AutoRelay client.
+// (see: https://docs.libp2p.io/concepts/nat/overview/)
+func Libp2pNATOptions(
+	cfg *configs.P2PConfig,
+	isStaked bool,
+	relayPeerSource autorelay.PeerSource,
+) (opts []libp2p.Option, err error) {
+	if cfg.EnableNatService {
+		opts = append(opts, libp2p.EnableNATService())
+	}
+
+	if cfg.EnableNatPortMap {
+		opts = append(opts, libp2p.NATPortMap())
+	}
+
+	if cfg.EnableHolePunching {
+		opts = append(opts, libp2p.EnableHolePunching())
+	}
+
+	relayMode := cfg.RelayMode
+	if relayMode == types.RelayMode_RelayAuto {
+		relayMode = types.RelayMode_RelayClient
+		if isStaked {
+			relayMode = types.RelayMode_RelayServer
+		}
+	}
+
+	switch relayMode {
+	case types.RelayMode_NoRelay:
+	case types.RelayMode_RelayClient:
+		if relayPeerSource == nil {
+			return nil, fmt.Errorf("relay peer source required for relay mode: %s", cfg.RelayMode)
+		}
+		opts = append(opts,
+			libp2p.EnableRelay(),
+			libp2p.EnableAutoRelayWithPeerSource(
+				relayPeerSource,
+				autorelay.WithMinCandidates(relayMinCandidates),
+			),
+		)
+	case types.RelayMode_RelayServer:
+		opts = append(opts, libp2p.EnableRelay())
+		// NB: libp2p only starts the relay service once AutoNAT determines that
+		// the host is publicly reachable.
+		if isStaked {
+			opts = append(opts, libp2p.EnableRelayService())
+		} else {
+			logger.Global.Warn().Msg("relay service is only enabled for staked actors")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported relay mode: %s", cfg.RelayMode)
+	}
+	return opts, nil
+}
+
 // PopulateLibp2pHost iterates through peers in given `pstore`, converting peer
 // info for use with libp2p and adding it to the underlying libp2p host's peerstore.
 // (see: https://pkg.go.dev/github.com/libp2p/go-libp2p@v0.26.2/core/host#Host)
@@ -0,0 +1,238 @@
+package utils
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	libp2pConfig "github.com/libp2p/go-libp2p/config"
+	libp2pHost "github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	libp2pPeer "github.com/libp2p/go-libp2p/core/peer"
+	circuitProto "github.com/libp2p/go-libp2p/p2p/protocol/circuitv2/proto"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/pocket/runtime/configs"
+	"github.com/pokt-network/pocket/runtime/configs/types"
+)
+
+const testRelayProtocolID = "/test/relay/1.0.0"
+
+func TestLibp2pNATOptions(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		cfg                  *configs.P2PConfig
+		isStaked             bool
+		nilPeerSource        bool
+		expectedErr          bool
+		expectedRelay        bool
+		expectedRelayService bool
+		expectedAutoRelay    bool
+		expectedNATService   bool
+		expectedNATManager   bool
+		expectedHolePunching bool
+	}{
+		{
+			name: "no relay",
+			cfg:  &configs.P2PConfig{RelayMode: types.RelayMode_NoRelay},
+		},
+		{
+			name: "NAT traversal",
+			cfg: &configs.P2PConfig{
+				EnableNatService:   true,
+				EnableNatPortMap:   true,
+				EnableHolePunching: true,
+			},
+			expectedNATService:   true,
+			expectedNATManager:   true,
+			expectedHolePunching: true,
+		},
+		{
+			name:              "relay client",
+			cfg:               &configs.P2PConfig{RelayMode: types.RelayMode_RelayClient},
+			expectedRelay:     true,
+			expectedAutoRelay: true,
+		},
+		{
+			name:          "relay client without peer source",
+			cfg:           &configs.P2PConfig{RelayMode: types.RelayMode_RelayClient},
+			nilPeerSource: true,
+			expectedErr:   true,
+		},
+		{
+			name:                 "relay server, staked",
+			cfg:                  &configs.P2PConfig{RelayMode: types.RelayMode_RelayServer},
+			isStaked:             true,
+			expectedRelay:        true,
+			expectedRelayService: true,
+		},
+		{
+			name:          "relay server, unstaked",
+			cfg:           &configs.P2PConfig{RelayMode: types.RelayMode_RelayServer},
+			expectedRelay: true,
+		},
+		{
+			name:                 "relay auto, staked",
+			cfg:                  &configs.P2PConfig{RelayMode: types.RelayMode_RelayAuto},
+			isStaked:             true,
+			expectedRelay:        true,
+			expectedRelayService: true,
+		},
+		{
+			name:              "relay auto, unstaked",
+			cfg:               &configs.P2PConfig{RelayMode: types.RelayMode_RelayAuto},
+			expectedRelay:     true,
+			expectedAutoRelay: true,
+		},
+		{
+			name:        "unsupported relay mode",
+			cfg:         &configs.P2PConfig{RelayMode: types.RelayMode(99)},
+			expectedErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			peerSource := newTestPeerSource()
+			if testCase.nilPeerSource {
+				peerSource = nil
+			}
+
+			opts, err := Libp2pNATOptions(testCase.cfg, testCase.isStaked, peerSource)
+			if testCase.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			cfg := new(libp2pConfig.Config)
+			err = cfg.Apply(opts...)
+			require.NoError(t, err)
+
+			require.Equal(t, testCase.expectedRelay, cfg.Relay)
+			require.Equal(t, testCase.expectedRelayService, cfg.EnableRelayService)
+			require.Equal(t, testCase.expectedAutoRelay, cfg.EnableAutoRelay)
+			require.Equal(t, testCase.expectedNATService, cfg.AutoNATConfig.EnableService)
+			require.Equal(t, testCase.expectedNATManager, cfg.NATManager != nil)
+			require.Equal(t, testCase.expectedHolePunching, cfg.EnableHolePunching)
+		})
+	}
+}
+
+func TestLibp2pNATOptions_CircuitRelay(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	relayOpts, err := Libp2pNATOptions(
+		&configs.P2PConfig{RelayMode: types.RelayMode_RelayServer},
+		true,
+		nil,
+	)
+	require.NoError(t, err)
+	relayHost := newTestLoopbackHost(t, append(relayOpts,
+		libp2p.ForceReachabilityPublic(),
+		// AutoRelay ignores private (e.g. loopback) relay addresses.
+		libp2p.AddrsFactory(loopbackToDNSAddrs),
+	)...)
+
+	// Wait for the relay service to start.
+	require.Eventually(t, func() bool {
+		for _, protocolID := range relayHost.Mux().Protocols() {
+			if protocolID == circuitProto.ProtoIDv2Hop {
+				return true
+			}
+		}
+		return false
+	}, time.Second, 10*time.Millisecond)
+
+	// The private host only uses the relay host as a relay candidate.
+	relayPeerSource := func(_ context.Context, num int) <-chan libp2pPeer.AddrInfo {
+		candidatesCh := make(chan libp2pPeer.AddrInfo, 1)
+		candidatesCh <- *libp2pHost.InfoFromHost(relayHost)
+		close(candidatesCh)
+		return candidatesCh
+	}
+	privateOpts, err := Libp2pNATOptions(
+		&configs.P2PConfig{RelayMode: types.RelayMode_RelayClient},
+		false,
+		relayPeerSource,
+	)
+	require.NoError(t, err)
+	privateHost := newTestLoopbackHost(t, append(privateOpts, libp2p.ForceReachabilityPrivate())...)
+
+	receivedCh := make(chan struct{}, 1)
+	privateHost.SetStreamHandler(testRelayProtocolID, func(stream network.Stream) {
+		receivedCh <- struct{}{}
+		_ = stream.Close()
+	})
+
+	// Wait for the private host to reserve a slot and advertise a relay address.
+	var circuitAddrs []multiaddr.Multiaddr
+	require.Eventually(t, func() bool {
+		circuitAddrs = circuitAddrs[:0]
+		for _, addr := range privateHost.Addrs() {
+			if _, err := addr.ValueForProtocol(multiaddr.P_CIRCUIT); err == nil {
+				circuitAddrs = append(circuitAddrs, addr)
+			}
+		}
+		return len(circuitAddrs) > 0
+	}, 8*time.Second, 100*time.Millisecond)
+
+	// A third host, which only knows the private host's relay address, connects
+	// via the relay and opens a stream over the (transient) relayed connection.
+	dialerHost := newTestLoopbackHost(t)
+	err = dialerHost.Connect(ctx, libp2pPeer.AddrInfo{
+		ID:    privateHost.ID(),
+		Addrs: circuitAddrs,
+	})
+	require.NoError(t, err)
+
+	stream, err := dialerHost.NewStream(
+		network.WithUseTransient(ctx, "test"),
+		privateHost.ID(),
+		testRelayProtocolID,
+	)
+	require.NoError(t, err)
+	require.NoError(t, stream.Close())
+
+	select {
+	case <-receivedCh:
+	case <-ctx.Done():
+		t.Fatal("expected stream to be received via relay")
+	}
+}
+
+func newTestPeerSource() func(context.Context, int) <-chan libp2pPeer.AddrInfo {
+	return func(context.Context, int) <-chan libp2pPeer.AddrInfo {
+		candidatesCh := make(chan libp2pPeer.AddrInfo)
+		close(candidatesCh)
+		return candidatesCh
+	}
+}
+
+// loopbackToDNSAddrs replaces the "/ip4/127.0.0.1" component of the given
+// multiaddrs with "/dns4/localhost".
+func loopbackToDNSAddrs(addrs []multiaddr.Multiaddr) []multiaddr.Multiaddr {
+	for i, addr := range addrs {
+		if addrStr := addr.String(); strings.HasPrefix(addrStr, "/ip4/127.0.0.1/") {
+			addrs[i] = multiaddr.StringCast("/dns4/localhost" + strings.TrimPrefix(addrStr, "/ip4/127.0.0.1"))
+		}
+	}
+	return addrs
+}
+
+func newTestLoopbackHost(t *testing.T, opts ...libp2p.Option) libp2pHost.Host {
+	t.Helper()
+
+	opts = append(opts, libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+	host, err := libp2p.New(opts...)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = host.Close()
+	})
+	return host
+}
@@ -5,23 +5,80 @@ import (
 
 	"github.com/pokt-network/pocket/persistence/types"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	moduleTypes "github.com/pokt-network/pocket/shared/modules/types"
 )
 
 func (p *PostgresContext) GetActor(actorType coreTypes.ActorType, address []byte, height int64) (*coreTypes.Actor, error) {
-	var schema types.ProtocolActorSchema
+	schema, err := getActorSchema(actorType)
+	if err != nil {
+		return nil, err
+	}
+	return p.getActor(schema, address, height)
+}
+
+// GetActorsPaginated returns the page of actors of the given type at the given height that match the
+// filter, ordered by address, along with the total number of matching actors.
+func (p *PostgresContext) GetActorsPaginated(
+	actorType coreTypes.ActorType,
+	height int64,
+	filter *moduleTypes.ActorQueryFilter,
+	offset, limit uint64,
+) (actors []*coreTypes.Actor, totalCount int, err error) {
+	schema, err := getActorSchema(actorType)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := filter.ValidateBasic(); err != nil {
+		return nil, 0, err
+	}
+	if filter.GetChain() != "" && schema.GetChainsTableName() == "" {
+		return nil, 0, fmt.Errorf("actor type %s does not have chains to filter by", actorType)
+	}
+
+	ctx, tx := p.getCtxAndTx()
+	if err := tx.QueryRow(ctx, schema.GetCountQuery(filter, height)).Scan(&totalCount); err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := tx.Query(ctx, schema.GetPaginatedQuery(filter, height, offset, limit))
+	if err != nil {
+		return nil, 0, err
+	}
+	page := make([]*coreTypes.Actor, 0, limit)
+	for rows.Next() {
+		actor, _, err := p.getActorFromRow(schema.GetActorType(), rows)
+		if err != nil {
+			rows.Close()
+			return nil, 0, err
+		}
+		page = append(page, actor)
+	}
+	rows.Close()
+
+	actors = make([]*coreTypes.Actor, 0, len(page))
+	for _, actor := range page {
+		actorWithChains, err := p.getChainsForActor(ctx, tx, schema, actor, height)
+		if err != nil {
+			return nil, 0, err
+		}
+		actors = append(actors, actorWithChains)
+	}
+	return actors, totalCount, nil
+}
+
+func getActorSchema(actorType coreTypes.ActorType) (types.ProtocolActorSchema, error) {
 	switch actorType {
 	case types.ApplicationActor.GetActorType():
-		schema = types.ApplicationActor
+		return types.ApplicationActor, nil
 	case types.ServicerActor.GetActorType():
-		schema = types.ServicerActor
+		return types.ServicerActor, nil
 	case types.FishermanActor.GetActorType():
-		schema = types.FishermanActor
+		return types.FishermanActor, nil
 	case types.ValidatorActor.GetActorType():
-		schema = types.ValidatorActor
+		return types.ValidatorActor, nil
 	default:
 		return nil, fmt.Errorf("invalid actor type: %s", actorType)
 	}
-	return p.getActor(schema, address, height)
 }
 
 // TODO (#399): All of the functions below following a structure similar to `GetAll<Actor>`
@@ -3,8 +3,11 @@ package persistence
 import (
 	"bytes"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/pokt-network/pocket/persistence/trees"
 	"github.com/pokt-network/pocket/persistence/types"
 	"github.com/pokt-network/pocket/shared/codec"
@@ -51,6 +54,30 @@ func (p *PostgresContext) GetBlockHash(height int64) (string, error) {
 	return blockHash, nil
 }
 
+// GetHeightByBlockHash returns the height of the block with the hash provided
+func (p *PostgresContext) GetHeightByBlockHash(blockHash string) (int64, error) {
+	ctx, tx := p.getCtxAndTx()
+
+	var height int64
+	err := tx.QueryRow(ctx, types.GetHeightByBlockHashQuery(blockHash)).Scan(&height)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, coreTypes.ErrBlockNotFound(blockHash)
+	}
+	return height, err
+}
+
+// GetHeightAtTime returns the height of the latest block committed at or before the time provided
+func (p *PostgresContext) GetHeightAtTime(blockTime time.Time) (int64, error) {
+	ctx, tx := p.getCtxAndTx()
+
+	var height int64
+	err := tx.QueryRow(ctx, types.GetHeightAtTimeQuery(blockTime)).Scan(&height)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, coreTypes.ErrBlockNotFound(fmt.Sprintf("no block committed before %s", blockTime.UTC().Format(time.RFC3339)))
+	}
+	return height, err
+}
+
 // TODO: Consider removing this function and using `Height` directly
 func (p *PostgresContext) GetHeight() (int64, error) {
 	return p.Height, nil
@@ -122,7 +149,7 @@ func (p *PostgresContext) insertBlock(block *coreTypes.Block) error {
 
 	ctx, tx := p.getCtxAndTx()
 
-	_, err := tx.Exec(ctx, types.InsertBlockQuery(blockHeader.Height, blockHeader.StateHash, blockHeader.ProposerAddress, blockHeader.QuorumCertificate))
+	_, err := tx.Exec(ctx, types.InsertBlockQuery(blockHeader.Height, blockHeader.StateHash, blockHeader.ProposerAddress, blockHeader.QuorumCertificate, blockHeader.Timestamp.AsTime()))
 	return err
 }
 
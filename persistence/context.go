@@ -5,6 +5,7 @@ package persistence
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -26,6 +27,9 @@ type PostgresContext struct {
 	conn *pgxpool.Conn
 	tx   pgx.Tx
 
+	// isSimulation is true for the throwaway contexts created by `NewSimulationContext`, which must never be committed
+	isSimulation bool
+
 	stateHash string
 	// TECHDEBT(#361): These three values are pointers to objects maintained by the PersistenceModule.
 	//                 Need to simply access them via the bus.
@@ -66,6 +70,10 @@ func (p *PostgresContext) ComputeStateHash() (string, error) {
 }
 
 func (p *PostgresContext) Commit(proposerAddr, quorumCert []byte) error {
+	if p.isSimulation {
+		return fmt.Errorf("cannot commit a simulation context")
+	}
+
 	p.logger.Info().Int64("height", p.Height).Msg("About to commit block & context")
 
 	// Create a persistence block proto
@@ -139,6 +139,18 @@ func initializeAllTables(ctx context.Context, db *pgxpool.Conn) error {
 		return err
 	}
 
+	if err := initializeProposalTables(ctx, db); err != nil {
+		return err
+	}
+
+	if err := initializeUpgradeTables(ctx, db); err != nil {
+		return err
+	}
+
+	if err := initializeDelegationTables(ctx, db); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -187,6 +199,11 @@ func initializeBlockTables(ctx context.Context, db *pgxpool.Conn) error {
 	if _, err := db.Exec(ctx, fmt.Sprintf(`%s %s %s %s`, CreateTable, IfNotExists, types.BlockTableName, types.BlockTableSchema)); err != nil {
 		return err
 	}
+	for _, query := range types.CreateBlockIndicesQueries() {
+		if _, err := db.Exec(ctx, query); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -199,3 +216,30 @@ func initialiseIBCTables(ctx context.Context, db *pgxpool.Conn) error {
 	}
 	return nil
 }
+
+func initializeProposalTables(ctx context.Context, db *pgxpool.Conn) error {
+	if _, err := db.Exec(ctx, fmt.Sprintf(`%s %s %s %s`, CreateTable, IfNotExists, types.ProposalsTableName, types.ProposalsTableSchema)); err != nil {
+		return err
+	}
+	if _, err := db.Exec(ctx, fmt.Sprintf(`%s %s %s %s`, CreateTable, IfNotExists, types.ProposalVotesTableName, types.ProposalVotesTableSchema)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func initializeUpgradeTables(ctx context.Context, db *pgxpool.Conn) error {
+	if _, err := db.Exec(ctx, fmt.Sprintf(`%s %s %s %s`, CreateTable, IfNotExists, types.UpgradesTableName, types.UpgradesTableSchema)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func initializeDelegationTables(ctx context.Context, db *pgxpool.Conn) error {
+	if _, err := db.Exec(ctx, fmt.Sprintf(`%s %s %s %s`, CreateTable, IfNotExists, types.DelegationsTableName, types.DelegationsTableSchema)); err != nil {
+		return err
+	}
+	if _, err := db.Exec(ctx, fmt.Sprintf(`%s %s %s %s`, CreateTable, IfNotExists, types.UnbondingDelegationsTableName, types.UnbondingDelegationsTableSchema)); err != nil {
+		return err
+	}
+	return nil
+}
@@ -16,6 +16,11 @@ var nonActorClearFunctions = []func() string{
 	types.ClearAllBlocksQuery,
 	types.ClearAllIBCStoreQuery,
 	types.ClearAllIBCEventsQuery,
+	types.ClearAllProposalsQuery,
+	types.ClearAllProposalVotesQuery,
+	types.ClearAllUpgradesQuery,
+	types.ClearAllDelegationsQuery,
+	types.ClearAllUnbondingDelegationsQuery,
 }
 
 func (m *persistenceModule) HandleDebugMessage(debugMessage *messaging.DebugMessage) error {
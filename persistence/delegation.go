@@ -0,0 +1,112 @@
+package persistence
+
+import (
+	"encoding/hex"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	pTypes "github.com/pokt-network/pocket/persistence/types"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+// SetDelegation sets the amount delegated by the delegator to the validator at the current height, removing the delegation if it is 0
+func (p *PostgresContext) SetDelegation(delegator, validator []byte, amount string) error {
+	ctx, tx := p.getCtxAndTx()
+	query := pTypes.InsertDelegationQuery(hex.EncodeToString(delegator), hex.EncodeToString(validator), amount, p.Height)
+	if _, err := tx.Exec(ctx, query); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SetUnbondingDelegation starts unbonding the amount undelegated from the validator at the current height until the completion height
+func (p *PostgresContext) SetUnbondingDelegation(delegator, validator []byte, amount string, completionHeight int64) error {
+	ctx, tx := p.getCtxAndTx()
+	query := pTypes.InsertUnbondingDelegationQuery(hex.EncodeToString(delegator), hex.EncodeToString(validator), amount, completionHeight, p.Height)
+	if _, err := tx.Exec(ctx, query); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetDelegation returns the amount delegated by the delegator to the validator at the height provided, or "0" if there is none
+func (p *PostgresContext) GetDelegation(delegator, validator []byte, height int64) (string, error) {
+	ctx, tx := p.getCtxAndTx()
+	var amount string
+	err := tx.QueryRow(ctx, pTypes.GetDelegationQuery(hex.EncodeToString(delegator), hex.EncodeToString(validator), height)).Scan(&amount)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "0", nil
+	} else if err != nil {
+		return "", err
+	}
+	return amount, nil
+}
+
+// GetDelegations returns the delegations of the delegator at the height provided
+func (p *PostgresContext) GetDelegations(delegator []byte, height int64) ([]*coreTypes.Delegation, error) {
+	return p.getDelegations(pTypes.GetDelegationsQuery(hex.EncodeToString(delegator), height))
+}
+
+// GetValidatorDelegations returns the delegations to the validator at the height provided
+func (p *PostgresContext) GetValidatorDelegations(validator []byte, height int64) ([]*coreTypes.Delegation, error) {
+	return p.getDelegations(pTypes.GetValidatorDelegationsQuery(hex.EncodeToString(validator), height))
+}
+
+// GetAllDelegations returns all the delegations at the height provided
+func (p *PostgresContext) GetAllDelegations(height int64) ([]*coreTypes.Delegation, error) {
+	return p.getDelegations(pTypes.GetAllDelegationsQuery(height))
+}
+
+// GetUnbondingDelegations returns the stake of the delegator that is still unbonding at the height provided
+func (p *PostgresContext) GetUnbondingDelegations(delegator []byte, height int64) ([]*coreTypes.UnbondingDelegation, error) {
+	return p.getUnbondingDelegations(pTypes.GetUnbondingDelegationsQuery(hex.EncodeToString(delegator), height))
+}
+
+// GetUnbondingDelegationsReadyToUnbond returns the stake that finishes unbonding at the height provided
+func (p *PostgresContext) GetUnbondingDelegationsReadyToUnbond(height int64) ([]*coreTypes.UnbondingDelegation, error) {
+	return p.getUnbondingDelegations(pTypes.GetUnbondingDelegationsCompletedAtHeightQuery(height))
+}
+
+func (p *PostgresContext) getDelegations(query string) ([]*coreTypes.Delegation, error) {
+	ctx, tx := p.getCtxAndTx()
+	rows, err := tx.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	delegations := make([]*coreTypes.Delegation, 0)
+	for rows.Next() {
+		delegation := new(coreTypes.Delegation)
+		if err := rows.Scan(&delegation.Delegator, &delegation.Validator, &delegation.Amount); err != nil {
+			return nil, err
+		}
+		delegations = append(delegations, delegation)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return delegations, nil
+}
+
+func (p *PostgresContext) getUnbondingDelegations(query string) ([]*coreTypes.UnbondingDelegation, error) {
+	ctx, tx := p.getCtxAndTx()
+	rows, err := tx.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	unbondings := make([]*coreTypes.UnbondingDelegation, 0)
+	for rows.Next() {
+		unbonding := new(coreTypes.UnbondingDelegation)
+		if err := rows.Scan(&unbonding.Delegator, &unbonding.Validator, &unbonding.Amount, &unbonding.Height, &unbonding.CompletionHeight); err != nil {
+			return nil, err
+		}
+		unbondings = append(unbondings, unbonding)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return unbondings, nil
+}
@@ -158,6 +158,32 @@ func (p *PostgresContext) GetAllAccounts(height int64) (accs []*coreTypes.Accoun
 	return
 }
 
+// GetAccountsPaginated returns the page of accounts at the given height, ordered by address, along
+// with the total number of accounts.
+func (p *PostgresContext) GetAccountsPaginated(height int64, offset, limit uint64) (accs []*coreTypes.Account, totalCount int, err error) {
+	ctx, tx := p.getCtxAndTx()
+	if err := tx.QueryRow(ctx, types.Account.GetCountQuery(height)).Scan(&totalCount); err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := tx.Query(ctx, types.Account.GetPaginatedQuery(height, offset, limit))
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	accs = make([]*coreTypes.Account, 0, limit)
+	for rows.Next() {
+		acc := new(coreTypes.Account)
+		var accHeight int64
+		if err := rows.Scan(&acc.Address, &acc.Amount, &accHeight); err != nil {
+			return nil, 0, err
+		}
+		accs = append(accs, acc)
+	}
+	return accs, totalCount, rows.Err()
+}
+
 // CLEANUP: Consolidate with GetAllAccounts.
 func (p *PostgresContext) GetAllPools(height int64) (accs []*coreTypes.Account, err error) {
 	ctx, tx := p.getCtxAndTx()
@@ -11,23 +11,11 @@ import (
 	"github.com/pokt-network/pocket/shared/modules"
 )
 
-// TODO: Implement this function
-func (p *PostgresContext) GetVersionAtHeight(height int64) (string, error) {
-	// This is a placeholder function for the RPC endpoint "v1/query/upgrade"
-	return "", nil
-}
-
 // TODO(#882): Implement this function
 func (p *PostgresContext) GetRevisionNumber(height int64) uint64 {
 	return 1
 }
 
-// TODO: Implement this function
-func (p *PostgresContext) GetSupportedChains(height int64) ([]string, error) {
-	// This is a placeholder function for the RPC endpoint "v1/query/supportedchains"
-	return []string{}, nil
-}
-
 func (p *PostgresContext) InitGenesisParams(params *genesis.Params) error {
 	ctx, tx := p.getCtxAndTx()
 	if p.Height != 0 {
@@ -214,6 +214,41 @@ func (m *persistenceModule) NewReadContext(height int64) (modules.PersistenceRea
 	}, nil
 }
 
+// NewSimulationContext returns a read-write context that is not tracked as the module's write context so
+// it can coexist with the one used by consensus. Its changes are always rolled back when released.
+func (m *persistenceModule) NewSimulationContext(height int64) (modules.PersistenceRWContext, error) {
+	conn, err := connectToPool(m.pool, m.config.GetNodeSchema())
+	if err != nil {
+		return nil, err
+	}
+	tx, err := conn.BeginTx(context.TODO(), pgx.TxOptions{
+		IsoLevel:       pgx.ReadCommitted,
+		AccessMode:     pgx.ReadWrite,
+		DeferrableMode: pgx.NotDeferrable,
+	})
+	if err != nil {
+		conn.Release()
+		return nil, err
+	}
+
+	return &PostgresContext{
+		logger: m.logger,
+
+		Height: height,
+
+		conn: conn,
+		tx:   tx,
+
+		isSimulation: true,
+
+		stateHash:  "",
+		blockStore: m.blockStore,
+		txIndexer:  m.txIndexer,
+		stateTrees: m.GetBus().GetTreeStore(),
+		networkId:  m.networkId,
+	}, nil
+}
+
 func (m *persistenceModule) ReleaseWriteContext() error {
 	writeContext := m.writeContext
 	if writeContext == nil {
@@ -0,0 +1,127 @@
+package persistence
+
+import (
+	"encoding/hex"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	pTypes "github.com/pokt-network/pocket/persistence/types"
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"google.golang.org/protobuf/proto"
+)
+
+// SetProposal stores the proposal at the current height in the persistence DB
+func (p *PostgresContext) SetProposal(proposal *coreTypes.Proposal) error {
+	ctx, tx := p.getCtxAndTx()
+	proposalBz, err := codec.GetCodec().Marshal(proposal)
+	if err != nil {
+		return err
+	}
+	query := pTypes.InsertProposalQuery(proposal.GetId(), int32(proposal.GetStatus()), hex.EncodeToString(proposalBz), p.Height)
+	if _, err := tx.Exec(ctx, query); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SetProposalVote stores the vote at the current height in the persistence DB
+func (p *PostgresContext) SetProposalVote(vote *coreTypes.ProposalVote) error {
+	ctx, tx := p.getCtxAndTx()
+	voteBz, err := codec.GetCodec().Marshal(vote)
+	if err != nil {
+		return err
+	}
+	query := pTypes.InsertProposalVoteQuery(vote.GetProposalId(), hex.EncodeToString(vote.GetVoter()), hex.EncodeToString(voteBz), p.Height)
+	if _, err := tx.Exec(ctx, query); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetProposal returns the latest version of the proposal at the height provided
+func (p *PostgresContext) GetProposal(id uint64, height int64) (*coreTypes.Proposal, error) {
+	ctx, tx := p.getCtxAndTx()
+	var proposalHex string
+	err := tx.QueryRow(ctx, pTypes.GetProposalQuery(id, height)).Scan(&proposalHex)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, coreTypes.ErrProposalNotFound(id)
+	} else if err != nil {
+		return nil, err
+	}
+	proposal := &coreTypes.Proposal{}
+	if err := decodeHexProto(proposalHex, proposal); err != nil {
+		return nil, err
+	}
+	return proposal, nil
+}
+
+// GetProposals returns the latest version of the proposals with the status provided at the height provided
+func (p *PostgresContext) GetProposals(status coreTypes.ProposalStatus, height int64) ([]*coreTypes.Proposal, error) {
+	ctx, tx := p.getCtxAndTx()
+	rows, err := tx.Query(ctx, pTypes.GetProposalsQuery(int32(status), height))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	proposals := make([]*coreTypes.Proposal, 0)
+	for rows.Next() {
+		var proposalHex string
+		if err := rows.Scan(&proposalHex); err != nil {
+			return nil, err
+		}
+		proposal := &coreTypes.Proposal{}
+		if err := decodeHexProto(proposalHex, proposal); err != nil {
+			return nil, err
+		}
+		proposals = append(proposals, proposal)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return proposals, nil
+}
+
+// GetLastProposalID returns the id of the last proposal submitted at or before the height provided
+func (p *PostgresContext) GetLastProposalID(height int64) (uint64, error) {
+	ctx, tx := p.getCtxAndTx()
+	var id int64
+	if err := tx.QueryRow(ctx, pTypes.GetLastProposalIDQuery(height)).Scan(&id); err != nil {
+		return 0, err
+	}
+	return uint64(id), nil
+}
+
+// GetProposalVotes returns the last vote of every voter on the proposal at the height provided
+func (p *PostgresContext) GetProposalVotes(proposalID uint64, height int64) ([]*coreTypes.ProposalVote, error) {
+	ctx, tx := p.getCtxAndTx()
+	rows, err := tx.Query(ctx, pTypes.GetProposalVotesQuery(proposalID, height))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	votes := make([]*coreTypes.ProposalVote, 0)
+	for rows.Next() {
+		var voteHex string
+		if err := rows.Scan(&voteHex); err != nil {
+			return nil, err
+		}
+		vote := &coreTypes.ProposalVote{}
+		if err := decodeHexProto(voteHex, vote); err != nil {
+			return nil, err
+		}
+		votes = append(votes, vote)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return votes, nil
+}
+
+func decodeHexProto(hexStr string, msg proto.Message) error {
+	bz, err := hex.DecodeString(hexStr)
+	if err != nil {
+		return err
+	}
+	return codec.GetCodec().Unmarshal(bz, msg)
+}
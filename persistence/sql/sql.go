@@ -7,6 +7,7 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	ptypes "github.com/pokt-network/pocket/persistence/types"
+	"github.com/pokt-network/pocket/shared/codec"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 )
 
@@ -184,6 +185,82 @@ func GetIBCStoreUpdates(pgtx pgx.Tx, height uint64) (keys, values [][]byte, err
 	return keys, values, nil
 }
 
+// GetProposals returns the proposals submitted or updated at the current height
+func GetProposals(pgtx pgx.Tx, height uint64) ([]*coreTypes.Proposal, error) {
+	rows, err := pgtx.Query(context.TODO(), ptypes.GetProposalsUpdatedAtHeightQuery(int64(height)))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var proposals []*coreTypes.Proposal
+	for rows.Next() {
+		var proposalHex string
+		if err := rows.Scan(&proposalHex); err != nil {
+			return nil, err
+		}
+		proposalBz, err := hex.DecodeString(proposalHex)
+		if err != nil {
+			return nil, err
+		}
+		proposal := new(coreTypes.Proposal)
+		if err := codec.GetCodec().Unmarshal(proposalBz, proposal); err != nil {
+			return nil, err
+		}
+		proposals = append(proposals, proposal)
+	}
+
+	return proposals, nil
+}
+
+// GetProposalVotes returns the proposal votes cast at the current height
+func GetProposalVotes(pgtx pgx.Tx, height uint64) ([]*coreTypes.ProposalVote, error) {
+	rows, err := pgtx.Query(context.TODO(), ptypes.GetProposalVotesUpdatedAtHeightQuery(int64(height)))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var votes []*coreTypes.ProposalVote
+	for rows.Next() {
+		var voteHex string
+		if err := rows.Scan(&voteHex); err != nil {
+			return nil, err
+		}
+		voteBz, err := hex.DecodeString(voteHex)
+		if err != nil {
+			return nil, err
+		}
+		vote := new(coreTypes.ProposalVote)
+		if err := codec.GetCodec().Unmarshal(voteBz, vote); err != nil {
+			return nil, err
+		}
+		votes = append(votes, vote)
+	}
+
+	return votes, nil
+}
+
+// GetUpgrades returns the upgrades scheduled at the current height
+func GetUpgrades(pgtx pgx.Tx, height uint64) ([]*coreTypes.Upgrade, error) {
+	rows, err := pgtx.Query(context.TODO(), ptypes.GetUpgradesUpdatedAtHeightQuery(int64(height)))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var upgrades []*coreTypes.Upgrade
+	for rows.Next() {
+		upgrade := new(coreTypes.Upgrade)
+		if err := rows.Scan(&upgrade.Version, &upgrade.Height, &upgrade.ScheduledHeight); err != nil {
+			return nil, err
+		}
+		upgrades = append(upgrades, upgrade)
+	}
+
+	return upgrades, nil
+}
+
 func getActor(tx pgx.Tx, actorSchema ptypes.ProtocolActorSchema, address []byte, height int64) (actor *coreTypes.Actor, err error) {
 	ctx := context.TODO()
 	actor, height, err = getActorFromRow(actorSchema.GetActorType(), tx.QueryRow(ctx, actorSchema.GetQuery(hex.EncodeToString(address), height)))
@@ -240,3 +317,53 @@ func getChainsForActor(
 	}
 	return actor, nil
 }
+
+// GetDelegations returns the delegations set at the current height, including the removed ones
+func GetDelegations(pgtx pgx.Tx, height uint64) ([]*coreTypes.Delegation, error) {
+	rows, err := pgtx.Query(context.TODO(), ptypes.GetDelegationsUpdatedAtHeightQuery(int64(height)))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var delegations []*coreTypes.Delegation
+	for rows.Next() {
+		delegation := new(coreTypes.Delegation)
+		if err := rows.Scan(&delegation.Delegator, &delegation.Validator, &delegation.Amount); err != nil {
+			return nil, err
+		}
+		delegations = append(delegations, delegation)
+	}
+
+	return delegations, nil
+}
+
+// GetUnbondingDelegations returns the stake undelegated at the current height and the stake that finishes unbonding at it
+func GetUnbondingDelegations(pgtx pgx.Tx, height uint64) (started, completed []*coreTypes.UnbondingDelegation, err error) {
+	if started, err = getUnbondingDelegations(pgtx, ptypes.GetUnbondingDelegationsUpdatedAtHeightQuery(int64(height))); err != nil {
+		return nil, nil, err
+	}
+	if completed, err = getUnbondingDelegations(pgtx, ptypes.GetUnbondingDelegationsCompletedAtHeightQuery(int64(height))); err != nil {
+		return nil, nil, err
+	}
+	return started, completed, nil
+}
+
+func getUnbondingDelegations(pgtx pgx.Tx, query string) ([]*coreTypes.UnbondingDelegation, error) {
+	rows, err := pgtx.Query(context.TODO(), query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var unbondings []*coreTypes.UnbondingDelegation
+	for rows.Next() {
+		unbonding := new(coreTypes.UnbondingDelegation)
+		if err := rows.Scan(&unbonding.Delegator, &unbonding.Validator, &unbonding.Amount, &unbonding.Height, &unbonding.CompletionHeight); err != nil {
+			return nil, err
+		}
+		unbondings = append(unbondings, unbonding)
+	}
+
+	return unbondings, nil
+}
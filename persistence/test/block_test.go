@@ -2,6 +2,7 @@ package test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -24,3 +25,26 @@ func TestGetBlockBlockHash(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, blockHash, "")
 }
+
+func TestGetHeightByBlockHashAndTime(t *testing.T) {
+	db := NewTestPostgresContext(t, 0)
+
+	blockHash, err := db.GetBlockHash(0)
+	require.NoError(t, err)
+
+	height, err := db.GetHeightByBlockHash(blockHash)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), height)
+
+	// Cannot get the height of a block that doesn't exist
+	_, err = db.GetHeightByBlockHash("00")
+	require.Error(t, err)
+
+	height, err = db.GetHeightAtTime(time.Now())
+	require.NoError(t, err)
+	require.Equal(t, int64(0), height)
+
+	// Cannot get a height before the first block
+	_, err = db.GetHeightAtTime(time.Unix(0, 0))
+	require.Error(t, err)
+}
@@ -0,0 +1,89 @@
+package test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSetDelegation(t *testing.T) {
+	db := NewTestPostgresContext(t, 1)
+	delegator, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	validator, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+
+	amount, err := db.GetDelegation(delegator, validator, 1)
+	require.NoError(t, err)
+	require.Equal(t, "0", amount)
+
+	require.NoError(t, db.SetDelegation(delegator, validator, "100"))
+	db.Height = 2
+	require.NoError(t, db.SetDelegation(delegator, validator, "150"))
+
+	amount, err = db.GetDelegation(delegator, validator, 1)
+	require.NoError(t, err)
+	require.Equal(t, "100", amount)
+	amount, err = db.GetDelegation(delegator, validator, 2)
+	require.NoError(t, err)
+	require.Equal(t, "150", amount)
+
+	expected := []*coreTypes.Delegation{{
+		Delegator: hex.EncodeToString(delegator),
+		Validator: hex.EncodeToString(validator),
+		Amount:    "150",
+	}}
+	delegations, err := db.GetDelegations(delegator, 2)
+	require.NoError(t, err)
+	require.Equal(t, expected, delegations)
+	delegations, err = db.GetValidatorDelegations(validator, 2)
+	require.NoError(t, err)
+	require.Equal(t, expected, delegations)
+
+	// a delegation of 0 is removed
+	db.Height = 3
+	require.NoError(t, db.SetDelegation(delegator, validator, "0"))
+	delegations, err = db.GetAllDelegations(3)
+	require.NoError(t, err)
+	require.Empty(t, delegations)
+	delegations, err = db.GetAllDelegations(2)
+	require.NoError(t, err)
+	require.Len(t, delegations, 1)
+}
+
+func TestGetSetUnbondingDelegation(t *testing.T) {
+	db := NewTestPostgresContext(t, 1)
+	delegator, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	validator, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+
+	// the amounts undelegated in the same block are unbonded together
+	require.NoError(t, db.SetUnbondingDelegation(delegator, validator, "100", 5))
+	require.NoError(t, db.SetUnbondingDelegation(delegator, validator, "50", 5))
+
+	expected := []*coreTypes.UnbondingDelegation{{
+		Delegator:        hex.EncodeToString(delegator),
+		Validator:        hex.EncodeToString(validator),
+		Amount:           "150",
+		Height:           1,
+		CompletionHeight: 5,
+	}}
+	unbondings, err := db.GetUnbondingDelegations(delegator, 4)
+	require.NoError(t, err)
+	require.Equal(t, expected, unbondings)
+
+	unbondings, err = db.GetUnbondingDelegationsReadyToUnbond(4)
+	require.NoError(t, err)
+	require.Empty(t, unbondings)
+	unbondings, err = db.GetUnbondingDelegationsReadyToUnbond(5)
+	require.NoError(t, err)
+	require.Equal(t, expected, unbondings)
+
+	unbondings, err = db.GetUnbondingDelegations(delegator, 5)
+	require.NoError(t, err)
+	require.Empty(t, unbondings)
+}
@@ -0,0 +1,70 @@
+package test
+
+import (
+	"testing"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSetUpgrade(t *testing.T) {
+	db := NewTestPostgresContext(t, 1)
+
+	version, err := db.GetVersionAtHeight(1)
+	require.NoError(t, err)
+	require.Equal(t, coreTypes.GenesisProtocolVersion, version)
+	pending, err := db.GetPendingUpgrade(1)
+	require.NoError(t, err)
+	require.Nil(t, pending)
+
+	require.NoError(t, db.SetUpgrade("v1.0.0", 10))
+
+	pending, err = db.GetPendingUpgrade(9)
+	require.NoError(t, err)
+	require.Equal(t, &coreTypes.Upgrade{Version: "v1.0.0", Height: 10, ScheduledHeight: 1}, pending)
+	version, err = db.GetVersionAtHeight(9)
+	require.NoError(t, err)
+	require.Equal(t, coreTypes.GenesisProtocolVersion, version)
+
+	version, err = db.GetVersionAtHeight(10)
+	require.NoError(t, err)
+	require.Equal(t, "v1.0.0", version)
+	pending, err = db.GetPendingUpgrade(10)
+	require.NoError(t, err)
+	require.Nil(t, pending)
+}
+
+func TestSetUpgrade_SupersedesPendingUpgrade(t *testing.T) {
+	db := NewTestPostgresContext(t, 1)
+	require.NoError(t, db.SetUpgrade("v1.0.0", 10))
+
+	db.Height = 12
+	require.NoError(t, db.SetUpgrade("v2.0.0", 20))
+
+	// the upgrade scheduled before the activation of v2.0.0 supersedes it
+	db.Height = 15
+	require.NoError(t, db.SetUpgrade("v2.1.0", 25))
+
+	pending, err := db.GetPendingUpgrade(15)
+	require.NoError(t, err)
+	require.Equal(t, "v2.1.0", pending.Version)
+
+	version, err := db.GetVersionAtHeight(22)
+	require.NoError(t, err)
+	require.Equal(t, "v1.0.0", version)
+
+	version, err = db.GetVersionAtHeight(25)
+	require.NoError(t, err)
+	require.Equal(t, "v2.1.0", version)
+}
+
+func TestGetSupportedChains(t *testing.T) {
+	db := NewTestPostgresContext(t, 0)
+
+	servicer, err := createAndInsertDefaultTestServicer(db)
+	require.NoError(t, err)
+
+	chains, err := db.GetSupportedChains(0)
+	require.NoError(t, err)
+	require.Subset(t, chains, servicer.Chains)
+}
@@ -15,9 +15,9 @@ import (
 
 const (
 	// the root hash of a tree store where each tree is empty but present and initialized
-	h0 = "302f2956c084cc3e0e760cf1b8c2da5de79c45fa542f68a660a5fc494b486972"
+	h0 = "72dcb3461a959191e65781db3ac2ddfac585eca4fd36478435d7792ad64d2c82"
 	// the root hash of a tree store where each tree has has key foo value bar added to it
-	h1 = "7d5712ea1507915c40e295845fa58773baa405b24b87e9d99761125d826ff915"
+	h1 = "c418540540842eac8a2491cb2b0de055b9ef2aefa0b29dda3fa122786cf60883"
 )
 
 func TestTreeStore_AtomicUpdatesWithSuccessfulRollback(t *testing.T) {
@@ -14,7 +14,9 @@ package trees
 
 import (
 	"crypto/sha256"
+	"encoding/binary"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"hash"
 	"log"
@@ -47,6 +49,12 @@ const (
 	ParamsTreeName       = "params"
 	FlagsTreeName        = "flags"
 	IBCTreeName          = "ibc"
+	ProposalsTreeName    = "proposals"
+	VotesTreeName        = "votes"
+	UpgradesTreeName     = "upgrades"
+
+	DelegationsTreeName          = "delegations"
+	UnbondingDelegationsTreeName = "unbonding_delegations"
 )
 
 var actorTypeToMerkleTreeName = map[coreTypes.ActorType]string{
@@ -70,6 +78,10 @@ var stateTreeNames = []string{
 	AccountTreeName, PoolTreeName,
 	// Data Trees
 	TransactionsTreeName, ParamsTreeName, FlagsTreeName, IBCTreeName,
+	// Governance Trees
+	ProposalsTreeName, VotesTreeName, UpgradesTreeName,
+	// Delegation Trees
+	DelegationsTreeName, UnbondingDelegationsTreeName,
 }
 
 // stateTree is a wrapper around the SMT that contains an identifying
@@ -258,6 +270,50 @@ func (t *treeStore) updateMerkleTrees(pgtx pgx.Tx, txi indexer.TxIndexer, height
 			if err := t.updateIBCTree(keys, values); err != nil {
 				return "", fmt.Errorf("failed to update IBC tree: %w", err)
 			}
+
+		// Governance Merkle Trees
+		case ProposalsTreeName:
+			proposals, err := sql.GetProposals(pgtx, height)
+			if err != nil {
+				return "", fmt.Errorf("failed to get proposals: %w", err)
+			}
+			if err := t.updateProposalsTree(proposals); err != nil {
+				return "", fmt.Errorf("failed to update proposals tree: %w", err)
+			}
+		case VotesTreeName:
+			votes, err := sql.GetProposalVotes(pgtx, height)
+			if err != nil {
+				return "", fmt.Errorf("failed to get proposal votes: %w", err)
+			}
+			if err := t.updateVotesTree(votes); err != nil {
+				return "", fmt.Errorf("failed to update votes tree: %w", err)
+			}
+		case UpgradesTreeName:
+			upgrades, err := sql.GetUpgrades(pgtx, height)
+			if err != nil {
+				return "", fmt.Errorf("failed to get upgrades: %w", err)
+			}
+			if err := t.updateUpgradesTree(upgrades); err != nil {
+				return "", fmt.Errorf("failed to update upgrades tree: %w", err)
+			}
+
+		// Delegation Merkle Trees
+		case DelegationsTreeName:
+			delegations, err := sql.GetDelegations(pgtx, height)
+			if err != nil {
+				return "", fmt.Errorf("failed to get delegations: %w", err)
+			}
+			if err := t.updateDelegationsTree(delegations); err != nil {
+				return "", fmt.Errorf("failed to update delegations tree: %w", err)
+			}
+		case UnbondingDelegationsTreeName:
+			started, completed, err := sql.GetUnbondingDelegations(pgtx, height)
+			if err != nil {
+				return "", fmt.Errorf("failed to get unbonding delegations: %w", err)
+			}
+			if err := t.updateUnbondingDelegationsTree(started, completed); err != nil {
+				return "", fmt.Errorf("failed to update unbonding delegations tree: %w", err)
+			}
 		// Default
 		default:
 			t.logger.Panic().Msgf("unhandled merkle tree type: %s", treeName)
@@ -496,6 +552,144 @@ func (t *treeStore) updateIBCTree(keys, values [][]byte) error {
 	return nil
 }
 
+/////////////////////////////
+// Governance Tree Helpers //
+/////////////////////////////
+
+func (t *treeStore) updateProposalsTree(proposals []*coreTypes.Proposal) error {
+	for _, proposal := range proposals {
+		proposalBz, err := codec.GetCodec().Marshal(proposal)
+		if err != nil {
+			return err
+		}
+		if err := t.merkleTrees[ProposalsTreeName].tree.Update(ProposalKey(proposal.GetId()), proposalBz); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *treeStore) updateVotesTree(votes []*coreTypes.ProposalVote) error {
+	for _, vote := range votes {
+		voteBz, err := codec.GetCodec().Marshal(vote)
+		if err != nil {
+			return err
+		}
+		if err := t.merkleTrees[VotesTreeName].tree.Update(VoteKey(vote.GetProposalId(), vote.GetVoter()), voteBz); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *treeStore) updateUpgradesTree(upgrades []*coreTypes.Upgrade) error {
+	for _, upgrade := range upgrades {
+		upgradeBz, err := codec.GetCodec().Marshal(upgrade)
+		if err != nil {
+			return err
+		}
+		if err := t.merkleTrees[UpgradesTreeName].tree.Update(UpgradeKey(upgrade.GetScheduledHeight()), upgradeBz); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/////////////////////////////
+// Delegation Tree Helpers //
+/////////////////////////////
+
+// updateDelegationsTree updates the delegations set at the current height, and removes the ones whose amount was set to 0
+func (t *treeStore) updateDelegationsTree(delegations []*coreTypes.Delegation) error {
+	for _, delegation := range delegations {
+		key, err := DelegationKey(delegation.GetDelegator(), delegation.GetValidator())
+		if err != nil {
+			return err
+		}
+		if delegation.GetAmount() == "0" {
+			// the delegation may have been created and removed within the same block
+			if err := t.merkleTrees[DelegationsTreeName].tree.Delete(key); err != nil && !errors.Is(err, smt.ErrKeyNotPresent) {
+				return err
+			}
+			continue
+		}
+		delegationBz, err := codec.GetCodec().Marshal(delegation)
+		if err != nil {
+			return err
+		}
+		if err := t.merkleTrees[DelegationsTreeName].tree.Update(key, delegationBz); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateUnbondingDelegationsTree inserts the stake that started unbonding at the current height, and removes the stake
+// that finished unbonding at it
+func (t *treeStore) updateUnbondingDelegationsTree(started, completed []*coreTypes.UnbondingDelegation) error {
+	for _, unbonding := range started {
+		key, err := UnbondingDelegationKey(unbonding.GetDelegator(), unbonding.GetValidator(), unbonding.GetHeight())
+		if err != nil {
+			return err
+		}
+		unbondingBz, err := codec.GetCodec().Marshal(unbonding)
+		if err != nil {
+			return err
+		}
+		if err := t.merkleTrees[UnbondingDelegationsTreeName].tree.Update(key, unbondingBz); err != nil {
+			return err
+		}
+	}
+	for _, unbonding := range completed {
+		key, err := UnbondingDelegationKey(unbonding.GetDelegator(), unbonding.GetValidator(), unbonding.GetHeight())
+		if err != nil {
+			return err
+		}
+		if err := t.merkleTrees[UnbondingDelegationsTreeName].tree.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ProposalKey returns the key of a proposal in the proposals tree: its id as 8 big-endian bytes
+func ProposalKey(id uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, id)
+}
+
+// VoteKey returns the key of a vote in the votes tree: the proposal key followed by the voter address
+func VoteKey(proposalID uint64, voter []byte) []byte {
+	return append(ProposalKey(proposalID), voter...)
+}
+
+// UpgradeKey returns the key of an upgrade in the upgrades tree: the height it was scheduled at as 8 big-endian bytes
+func UpgradeKey(scheduledHeight int64) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(scheduledHeight))
+}
+
+// DelegationKey returns the key of a delegation in the delegations tree: the delegator address followed by the validator address
+func DelegationKey(delegatorHex, validatorHex string) ([]byte, error) {
+	delegator, err := hex.DecodeString(delegatorHex)
+	if err != nil {
+		return nil, err
+	}
+	validator, err := hex.DecodeString(validatorHex)
+	if err != nil {
+		return nil, err
+	}
+	return append(delegator, validator...), nil
+}
+
+// UnbondingDelegationKey returns the key of an unbonding delegation in the unbonding delegations tree: the delegation key
+// followed by the height the stake was undelegated at as 8 big-endian bytes
+func UnbondingDelegationKey(delegatorHex, validatorHex string, height int64) ([]byte, error) {
+	key, err := DelegationKey(delegatorHex, validatorHex)
+	if err != nil {
+		return nil, err
+	}
+	return binary.BigEndian.AppendUint64(key, uint64(height)), nil
+}
+
 // getTransactions takes a transaction indexer and returns the transactions for the current height
 func getTransactions(txi indexer.TxIndexer, height uint64) ([]*coreTypes.IndexedTransaction, error) {
 	// TECHDEBT(#813): Avoid this cast to int64
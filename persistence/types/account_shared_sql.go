@@ -23,6 +23,20 @@ func SelectAccounts(height int64, colName, tableName string) string {
        `, colName, colName, tableName, height, colName)
 }
 
+// SelectAccountsPaginated returns the page of accounts (with their balances at the given height) ordered by colName
+func SelectAccountsPaginated(height int64, colName, tableName string, offset, limit uint64) string {
+	return fmt.Sprintf(`
+			SELECT * FROM (%s) AS accounts
+			ORDER BY %s
+			LIMIT %d OFFSET %d
+       `, SelectAccounts(height, colName, tableName), colName, limit, offset)
+}
+
+// CountAccounts returns the number of accounts at the given height
+func CountAccounts(height int64, colName, tableName string) string {
+	return fmt.Sprintf(`SELECT COUNT(*) FROM (%s) AS accounts`, SelectAccounts(height, colName, tableName))
+}
+
 func SelectBalance(accountSpecificParam, accountSpecificParamValue string, height int64, tableName string) string {
 	return fmt.Sprintf(`SELECT balance FROM %s WHERE %s='%s' AND height<=%d ORDER BY height DESC LIMIT 1`,
 		tableName, accountSpecificParam, accountSpecificParamValue, height)
@@ -6,8 +6,10 @@ package types
 import (
 	"bytes"
 	"fmt"
+	"strings"
 
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	moduleTypes "github.com/pokt-network/pocket/shared/modules/types"
 )
 
 const (
@@ -93,6 +95,67 @@ func SelectActors(actorSpecificParam string, height int64, tableName string) str
        `, actorSpecificParam, tableName, height)
 }
 
+// SelectActorsPaginated returns the page of actors (with their attributes at the given height) matching
+// the filter, ordered by address. The filter must be validated with `ValidateBasic` beforehand.
+func SelectActorsPaginated(actorSpecificParam string, filter *moduleTypes.ActorQueryFilter, height int64, offset, limit uint64, tableName, chainsTableName string) string {
+	return fmt.Sprintf(`
+			SELECT * FROM (%s) AS actors%s
+			ORDER BY address
+			LIMIT %d OFFSET %d
+       `, SelectActors(actorSpecificParam, height, tableName), actorsFilterClause(filter, height, chainsTableName), limit, offset)
+}
+
+// CountActors returns the number of actors at the given height matching the filter.
+// The filter must be validated with `ValidateBasic` beforehand.
+func CountActors(actorSpecificParam string, filter *moduleTypes.ActorQueryFilter, height int64, tableName, chainsTableName string) string {
+	return fmt.Sprintf(`SELECT COUNT(*) FROM (%s) AS actors%s`,
+		SelectActors(actorSpecificParam, height, tableName), actorsFilterClause(filter, height, chainsTableName))
+}
+
+// actorsFilterClause returns the WHERE clause applying the filter to the `actors` subquery.
+// The stake status is derived from the unstaking height in the same way as `GetActorStatus`.
+func actorsFilterClause(filter *moduleTypes.ActorQueryFilter, height int64, chainsTableName string) string {
+	if filter.IsEmpty() {
+		return ""
+	}
+
+	conditions := make([]string, 0)
+	switch coreTypes.StakeStatus(filter.GetStatus()) {
+	case coreTypes.StakeStatus_Staked:
+		conditions = append(conditions, fmt.Sprintf("actors.unstaking_height=%d", DefaultBigInt))
+	case coreTypes.StakeStatus_Unstaking:
+		conditions = append(conditions, fmt.Sprintf("actors.unstaking_height>%d", height))
+	case coreTypes.StakeStatus_Unstaked:
+		conditions = append(conditions, fmt.Sprintf("actors.unstaking_height<>%d AND actors.unstaking_height<=%d", DefaultBigInt, height))
+	}
+	if chain := filter.GetChain(); chain != "" {
+		conditions = append(conditions, fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM %s AS chains WHERE chains.address=actors.address AND chains.height=actors.height AND chains.chain_id='%s')",
+			chainsTableName, chain))
+	}
+	if minStake := filter.GetMinStake(); minStake != "" {
+		conditions = append(conditions, fmt.Sprintf("CAST(actors.staked_tokens AS NUMERIC)>=%s", minStake))
+	}
+	if outputAddress := filter.GetOutputAddress(); outputAddress != "" {
+		conditions = append(conditions, fmt.Sprintf("actors.output_address='%s'", strings.ToLower(outputAddress)))
+	}
+	if len(conditions) == 0 {
+		return ""
+	}
+	return "\n\t\t\tWHERE " + strings.Join(conditions, " AND ")
+}
+
+// SelectStakedChains returns the distinct chains, ordered alphabetically, that the actors staked at the given height
+// (i.e. not unstaking, in the same way as `GetActorStatus`) are staked for
+func SelectStakedChains(actorSpecificParam string, height int64, tableName, chainsTableName string) string {
+	return fmt.Sprintf(`
+			SELECT DISTINCT chains.chain_id FROM (%s) AS actors
+			JOIN %s AS chains ON chains.address=actors.address AND chains.height=actors.height
+			WHERE actors.unstaking_height=%d
+			ORDER BY chains.chain_id
+       `, SelectActors(actorSpecificParam, height, tableName), chainsTableName, DefaultBigInt)
+}
+
 func selectChains(selector, address string, height int64, actorTableName, chainsTableName string) string {
 	return fmt.Sprintf(`SELECT %s FROM %s WHERE address='%s' AND height=(%s);`,
 		selector, chainsTableName, address, Select(HeightCol, address, height, actorTableName))
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	moduleTypes "github.com/pokt-network/pocket/shared/modules/types"
+)
+
+func TestActorsFilterClause(t *testing.T) {
+	const height = int64(10)
+	tests := []struct {
+		name   string
+		filter *moduleTypes.ActorQueryFilter
+		want   string
+	}{
+		{
+			name:   "nil filter does not restrict the actors",
+			filter: nil,
+			want:   "",
+		},
+		{
+			name:   "empty filter does not restrict the actors",
+			filter: &moduleTypes.ActorQueryFilter{},
+			want:   "",
+		},
+		{
+			name:   "staked actors have no unstaking height",
+			filter: &moduleTypes.ActorQueryFilter{Status: int32(coreTypes.StakeStatus_Staked)},
+			want:   "\n\t\t\tWHERE actors.unstaking_height=-1",
+		},
+		{
+			name:   "unstaking actors have an unstaking height in the future",
+			filter: &moduleTypes.ActorQueryFilter{Status: int32(coreTypes.StakeStatus_Unstaking)},
+			want:   "\n\t\t\tWHERE actors.unstaking_height>10",
+		},
+		{
+			name:   "unstaked actors have an unstaking height in the past",
+			filter: &moduleTypes.ActorQueryFilter{Status: int32(coreTypes.StakeStatus_Unstaked)},
+			want:   "\n\t\t\tWHERE actors.unstaking_height<>-1 AND actors.unstaking_height<=10",
+		},
+		{
+			name: "all filters are combined",
+			filter: &moduleTypes.ActorQueryFilter{
+				Chain:         "0001",
+				MinStake:      "1000",
+				OutputAddress: "ABCDEF",
+			},
+			want: "\n\t\t\tWHERE EXISTS (SELECT 1 FROM app_chains AS chains WHERE chains.address=actors.address AND chains.height=actors.height AND chains.chain_id='0001')" +
+				" AND CAST(actors.staked_tokens AS NUMERIC)>=1000" +
+				" AND actors.output_address='abcdef'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, actorsFilterClause(tt.filter, height, "app_chains"))
+		})
+	}
+}
@@ -43,6 +43,14 @@ func (account baseProtocolAccountSchema) GetAllQuery(height int64) string {
 	return SelectAccounts(height, account.accountSpecificColName, account.tableName)
 }
 
+func (account baseProtocolAccountSchema) GetPaginatedQuery(height int64, offset, limit uint64) string {
+	return SelectAccountsPaginated(height, account.accountSpecificColName, account.tableName, offset, limit)
+}
+
+func (account baseProtocolAccountSchema) GetCountQuery(height int64) string {
+	return CountAccounts(height, account.accountSpecificColName, account.tableName)
+}
+
 func (account baseProtocolAccountSchema) InsertAccountQuery(identifier, amount string, height int64) string {
 	return InsertAccount(account.accountSpecificColName, identifier, amount, height, account.tableName, account.heightConstraintName)
 }
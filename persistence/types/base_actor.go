@@ -3,6 +3,7 @@ package types
 // REFACTOR: Move schema related functions to a separate sub-package
 import (
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	moduleTypes "github.com/pokt-network/pocket/shared/modules/types"
 )
 
 var _ ProtocolActorSchema = &BaseProtocolActorSchema{}
@@ -65,6 +66,18 @@ func (actor *BaseProtocolActorSchema) GetAllQuery(height int64) string {
 	return SelectActors(actor.GetActorSpecificColName(), height, actor.tableName)
 }
 
+func (actor *BaseProtocolActorSchema) GetPaginatedQuery(filter *moduleTypes.ActorQueryFilter, height int64, offset, limit uint64) string {
+	return SelectActorsPaginated(actor.GetActorSpecificColName(), filter, height, offset, limit, actor.tableName, actor.chainsTableName)
+}
+
+func (actor *BaseProtocolActorSchema) GetCountQuery(filter *moduleTypes.ActorQueryFilter, height int64) string {
+	return CountActors(actor.GetActorSpecificColName(), filter, height, actor.tableName, actor.chainsTableName)
+}
+
+func (actor *BaseProtocolActorSchema) GetStakedChainsQuery(height int64) string {
+	return SelectStakedChains(actor.GetActorSpecificColName(), height, actor.tableName, actor.chainsTableName)
+}
+
 func (actor *BaseProtocolActorSchema) GetExistsQuery(address string, height int64) string {
 	return Exists(address, height, actor.tableName)
 }
@@ -1,6 +1,9 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // TODO(olshansky/team): Compare with `block.proto` and expand on this.
 const (
@@ -9,22 +12,43 @@ const (
 			height             BIGINT PRIMARY KEY,
 			hash 	           TEXT NOT NULL,
 			proposer_address   BYTEA NOT NULL,
-			quorum_certificate BYTEA NOT NULL
+			quorum_certificate BYTEA NOT NULL,
+			block_time         TIMESTAMPTZ NOT NULL
 		)`
+	BlockHashIndexName = "block_hash_idx"
+	BlockTimeIndexName = "block_time_idx"
 )
 
-func InsertBlockQuery(height uint64, hashString string, proposerAddr, quorumCert []byte) string {
+func InsertBlockQuery(height uint64, hashString string, proposerAddr, quorumCert []byte, blockTime time.Time) string {
 	return fmt.Sprintf(
-		`INSERT INTO %s(height, hash, proposer_address, quorum_certificate)
-			VALUES(%d, '%s', '%b', '%b')`,
+		`INSERT INTO %s(height, hash, proposer_address, quorum_certificate, block_time)
+			VALUES(%d, '%s', '%b', '%b', '%s')`,
 		BlockTableName,
-		height, hashString, proposerAddr, quorumCert)
+		height, hashString, proposerAddr, quorumCert, blockTime.UTC().Format(time.RFC3339Nano))
+}
+
+// CreateBlockIndicesQueries returns the queries creating the indices used to look up blocks by hash and by time
+func CreateBlockIndicesQueries() []string {
+	return []string{
+		fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s ON %s (hash)`, BlockHashIndexName, BlockTableName),
+		fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s ON %s (block_time)`, BlockTimeIndexName, BlockTableName),
+	}
 }
 
 func GetBlockHashQuery(height int64) string {
 	return fmt.Sprintf(`SELECT hash FROM %s WHERE height=%d`, BlockTableName, height)
 }
 
+func GetHeightByBlockHashQuery(hash string) string {
+	return fmt.Sprintf(`SELECT height FROM %s WHERE hash='%s'`, BlockTableName, hash)
+}
+
+// GetHeightAtTimeQuery returns the height of the latest block committed at or before the time provided
+func GetHeightAtTimeQuery(blockTime time.Time) string {
+	return fmt.Sprintf(`SELECT height FROM %s WHERE block_time<='%s' ORDER BY block_time DESC, height DESC LIMIT 1`,
+		BlockTableName, blockTime.UTC().Format(time.RFC3339Nano))
+}
+
 func GetMaximumBlockHeightQuery() string {
 	return fmt.Sprintf(`SELECT MAX(height) FROM %s`, BlockTableName)
 }
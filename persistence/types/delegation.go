@@ -0,0 +1,142 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	DelegationsTableName   = "delegations"
+	DelegationsTableSchema = `(
+		delegator TEXT NOT NULL,
+		validator TEXT NOT NULL,
+		height BIGINT NOT NULL,
+		amount TEXT NOT NULL,
+		PRIMARY KEY (delegator, validator, height)
+	)`
+	UnbondingDelegationsTableName   = "unbonding_delegations"
+	UnbondingDelegationsTableSchema = `(
+		delegator TEXT NOT NULL,
+		validator TEXT NOT NULL,
+		height BIGINT NOT NULL,
+		completion_height BIGINT NOT NULL,
+		amount TEXT NOT NULL,
+		PRIMARY KEY (delegator, validator, height)
+	)`
+)
+
+// InsertDelegationQuery returns the query to set the amount delegated by the delegator to the validator at the height provided.
+// An amount of 0 removes the delegation.
+func InsertDelegationQuery(delegator, validator, amount string, height int64) string {
+	return fmt.Sprintf(
+		`INSERT INTO %s(delegator, validator, height, amount) VALUES('%s', '%s', %d, '%s') ON CONFLICT (delegator, validator, height) DO UPDATE SET amount=EXCLUDED.amount`,
+		DelegationsTableName,
+		delegator,
+		validator,
+		height,
+		amount,
+	)
+}
+
+// GetDelegationQuery returns the latest amount delegated by the delegator to the validator at the height provided
+func GetDelegationQuery(delegator, validator string, height int64) string {
+	return fmt.Sprintf(
+		`SELECT amount FROM %s WHERE delegator='%s' AND validator='%s' AND height<=%d ORDER BY height DESC LIMIT 1`,
+		DelegationsTableName,
+		delegator,
+		validator,
+		height,
+	)
+}
+
+// GetDelegationsQuery returns the delegations of the delegator at the height provided ordered by validator
+func GetDelegationsQuery(delegator string, height int64) string {
+	return selectDelegations(fmt.Sprintf(`delegator='%s'`, delegator), height)
+}
+
+// GetValidatorDelegationsQuery returns the delegations to the validator at the height provided ordered by delegator
+func GetValidatorDelegationsQuery(validator string, height int64) string {
+	return selectDelegations(fmt.Sprintf(`validator='%s'`, validator), height)
+}
+
+// GetAllDelegationsQuery returns all the delegations at the height provided
+func GetAllDelegationsQuery(height int64) string {
+	return selectDelegations("TRUE", height)
+}
+
+// selectDelegations returns the latest version of the delegations matching the filter at the height provided,
+// excluding the ones that were removed
+func selectDelegations(filter string, height int64) string {
+	return fmt.Sprintf(
+		`SELECT delegator, validator, amount FROM (
+			SELECT DISTINCT ON (delegator, validator) delegator, validator, amount FROM %s
+			WHERE %s AND height<=%d ORDER BY delegator ASC, validator ASC, height DESC
+		) AS latest WHERE amount<>'0' ORDER BY delegator ASC, validator ASC`,
+		DelegationsTableName,
+		filter,
+		height,
+	)
+}
+
+// GetDelegationsUpdatedAtHeightQuery returns the delegations set at exactly the height provided, including the removed ones
+func GetDelegationsUpdatedAtHeightQuery(height int64) string {
+	return fmt.Sprintf(
+		`SELECT delegator, validator, amount FROM %s WHERE height=%d ORDER BY delegator ASC, validator ASC`,
+		DelegationsTableName,
+		height,
+	)
+}
+
+// InsertUnbondingDelegationQuery returns the query to start unbonding the amount undelegated from the validator at the height provided.
+// The amounts undelegated from the same validator in the same block are unbonded together.
+func InsertUnbondingDelegationQuery(delegator, validator, amount string, completionHeight, height int64) string {
+	return fmt.Sprintf(
+		`INSERT INTO %s AS u(delegator, validator, height, completion_height, amount) VALUES('%s', '%s', %d, %d, '%s')
+			ON CONFLICT (delegator, validator, height) DO UPDATE SET amount=(u.amount::NUMERIC + EXCLUDED.amount::NUMERIC)::TEXT`,
+		UnbondingDelegationsTableName,
+		delegator,
+		validator,
+		height,
+		completionHeight,
+		amount,
+	)
+}
+
+// GetUnbondingDelegationsQuery returns the stake of the delegator that is still unbonding at the height provided
+func GetUnbondingDelegationsQuery(delegator string, height int64) string {
+	return fmt.Sprintf(
+		`SELECT delegator, validator, amount, height, completion_height FROM %s
+			WHERE delegator='%s' AND height<=%d AND completion_height>%d ORDER BY completion_height ASC, validator ASC`,
+		UnbondingDelegationsTableName,
+		delegator,
+		height,
+		height,
+	)
+}
+
+// GetUnbondingDelegationsUpdatedAtHeightQuery returns the stake undelegated at exactly the height provided
+func GetUnbondingDelegationsUpdatedAtHeightQuery(height int64) string {
+	return fmt.Sprintf(
+		`SELECT delegator, validator, amount, height, completion_height FROM %s WHERE height=%d ORDER BY delegator ASC, validator ASC`,
+		UnbondingDelegationsTableName,
+		height,
+	)
+}
+
+// GetUnbondingDelegationsCompletedAtHeightQuery returns the stake that finishes unbonding at exactly the height provided
+func GetUnbondingDelegationsCompletedAtHeightQuery(height int64) string {
+	return fmt.Sprintf(
+		`SELECT delegator, validator, amount, height, completion_height FROM %s WHERE completion_height=%d ORDER BY delegator ASC, validator ASC, height ASC`,
+		UnbondingDelegationsTableName,
+		height,
+	)
+}
+
+// ClearAllDelegationsQuery returns the query to clear all entries from the delegations table
+func ClearAllDelegationsQuery() string {
+	return fmt.Sprintf(`DELETE FROM %s`, DelegationsTableName)
+}
+
+// ClearAllUnbondingDelegationsQuery returns the query to clear all entries from the unbonding_delegations table
+func ClearAllUnbondingDelegationsQuery() string {
+	return fmt.Sprintf(`DELETE FROM %s`, UnbondingDelegationsTableName)
+}
@@ -77,6 +77,16 @@ func TestInsertParams(t *testing.T) {
 				"('message_pause_servicer_fee', -1, 'STRING', '10000')," +
 				"('message_unpause_servicer_fee', -1, 'STRING', '10000')," +
 				"('message_change_parameter_fee', -1, 'STRING', '10000')," +
+				"('message_submit_proposal_fee', -1, 'STRING', '10000')," +
+				"('message_vote_fee', -1, 'STRING', '10000')," +
+				"('governance_voting_blocks', -1, 'BIGINT', 100)," +
+				"('governance_quorum_percentage', -1, 'SMALLINT', 33)," +
+				"('governance_threshold_percentage', -1, 'SMALLINT', 50)," +
+				"('message_upgrade_fee', -1, 'STRING', '10000')," +
+				"('validator_commission_percentage', -1, 'SMALLINT', 10)," +
+				"('message_delegate_fee', -1, 'STRING', '10000')," +
+				"('message_undelegate_fee', -1, 'STRING', '10000')," +
+				"('message_redelegate_fee', -1, 'STRING', '10000')," +
 				"('acl_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
 				"('blocks_per_session_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
 				"('app_minimum_stake_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
@@ -131,7 +141,17 @@ func TestInsertParams(t *testing.T) {
 				"('message_unstake_servicer_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
 				"('message_pause_servicer_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
 				"('message_unpause_servicer_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
-				"('message_change_parameter_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45') " +
+				"('message_change_parameter_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('message_submit_proposal_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('message_vote_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('governance_voting_blocks_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('governance_quorum_percentage_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('governance_threshold_percentage_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('message_upgrade_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('validator_commission_percentage_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('message_delegate_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('message_undelegate_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45')," +
+				"('message_redelegate_fee_owner', -1, 'STRING', 'da034209758b78eaea06dd99c07909ab54c99b45') " +
 				"ON CONFLICT ON CONSTRAINT params_pkey DO UPDATE SET value=EXCLUDED.value, type=EXCLUDED.type",
 		},
 	}
@@ -0,0 +1,109 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	ProposalsTableName   = "proposals"
+	ProposalsTableSchema = `(
+		id BIGINT NOT NULL,
+		height BIGINT NOT NULL,
+		status SMALLINT NOT NULL,
+		proposal TEXT NOT NULL,
+		PRIMARY KEY (id, height)
+	)`
+	ProposalVotesTableName   = "proposal_votes"
+	ProposalVotesTableSchema = `(
+		proposal_id BIGINT NOT NULL,
+		voter TEXT NOT NULL,
+		height BIGINT NOT NULL,
+		vote TEXT NOT NULL,
+		PRIMARY KEY (proposal_id, voter, height)
+	)`
+)
+
+// InsertProposalQuery returns the query to insert the hex encoded proposal at the height provided.
+// The proposal is updated in place if it was already inserted at that height (e.g. tallied in the block it was submitted in).
+func InsertProposalQuery(id uint64, status int32, proposalHex string, height int64) string {
+	return fmt.Sprintf(
+		`INSERT INTO %s(id, height, status, proposal) VALUES(%d, %d, %d, '%s') ON CONFLICT (id, height) DO UPDATE SET status=EXCLUDED.status, proposal=EXCLUDED.proposal`,
+		ProposalsTableName,
+		id,
+		height,
+		status,
+		proposalHex,
+	)
+}
+
+// GetProposalQuery returns the latest version of the proposal at the height provided
+func GetProposalQuery(id uint64, height int64) string {
+	return fmt.Sprintf(
+		`SELECT proposal FROM %s WHERE id=%d AND height<=%d ORDER BY height DESC LIMIT 1`,
+		ProposalsTableName,
+		id,
+		height,
+	)
+}
+
+// GetProposalsQuery returns the latest version of every proposal at the height provided ordered by id.
+// If `status` is 0 (i.e. unspecified), the proposals are not filtered by status.
+func GetProposalsQuery(status int32, height int64) string {
+	latest := fmt.Sprintf(
+		`SELECT DISTINCT ON (id) id, status, proposal FROM %s WHERE height<=%d ORDER BY id ASC, height DESC`,
+		ProposalsTableName,
+		height,
+	)
+	if status == 0 {
+		return fmt.Sprintf(`SELECT proposal FROM (%s) AS latest ORDER BY id ASC`, latest)
+	}
+	return fmt.Sprintf(`SELECT proposal FROM (%s) AS latest WHERE status=%d ORDER BY id ASC`, latest, status)
+}
+
+// GetLastProposalIDQuery returns the id of the last proposal submitted at or before the height provided, or 0 if there are none
+func GetLastProposalIDQuery(height int64) string {
+	return fmt.Sprintf(`SELECT COALESCE(MAX(id), 0) FROM %s WHERE height<=%d`, ProposalsTableName, height)
+}
+
+// GetProposalsUpdatedAtHeightQuery returns the proposals submitted or updated at exactly the height provided
+func GetProposalsUpdatedAtHeightQuery(height int64) string {
+	return fmt.Sprintf(`SELECT proposal FROM %s WHERE height=%d ORDER BY id ASC`, ProposalsTableName, height)
+}
+
+// InsertProposalVoteQuery returns the query to insert the hex encoded vote of the voter at the height provided.
+// A vote cast again in the same block replaces the previous one.
+func InsertProposalVoteQuery(proposalID uint64, voter, voteHex string, height int64) string {
+	return fmt.Sprintf(
+		`INSERT INTO %s(proposal_id, voter, height, vote) VALUES(%d, '%s', %d, '%s') ON CONFLICT (proposal_id, voter, height) DO UPDATE SET vote=EXCLUDED.vote`,
+		ProposalVotesTableName,
+		proposalID,
+		voter,
+		height,
+		voteHex,
+	)
+}
+
+// GetProposalVotesQuery returns the last vote of every voter on the proposal at the height provided
+func GetProposalVotesQuery(proposalID uint64, height int64) string {
+	return fmt.Sprintf(
+		`SELECT DISTINCT ON (voter) vote FROM %s WHERE proposal_id=%d AND height<=%d ORDER BY voter ASC, height DESC`,
+		ProposalVotesTableName,
+		proposalID,
+		height,
+	)
+}
+
+// GetProposalVotesUpdatedAtHeightQuery returns the votes cast at exactly the height provided
+func GetProposalVotesUpdatedAtHeightQuery(height int64) string {
+	return fmt.Sprintf(`SELECT vote FROM %s WHERE height=%d ORDER BY proposal_id ASC, voter ASC`, ProposalVotesTableName, height)
+}
+
+// ClearAllProposalsQuery returns the query to clear all entries from the proposals table
+func ClearAllProposalsQuery() string {
+	return fmt.Sprintf(`DELETE FROM %s`, ProposalsTableName)
+}
+
+// ClearAllProposalVotesQuery returns the query to clear all entries from the proposal_votes table
+func ClearAllProposalVotesQuery() string {
+	return fmt.Sprintf(`DELETE FROM %s`, ProposalVotesTableName)
+}
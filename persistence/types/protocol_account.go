@@ -16,6 +16,10 @@ type ProtocolAccountSchema interface {
 
 	// Returns a query to get all accounts
 	GetAllQuery(height int64) string
+	// Returns a query to get the page of accounts at a specified height, ordered by identifier
+	GetPaginatedQuery(height int64, offset, limit uint64) string
+	// Returns a query to count the accounts at a specified height
+	GetCountQuery(height int64) string
 	// Returns a query to get the balance of an account at a specified height
 	GetAccountAmountQuery(identifier string, height int64) string // Identifier can either be address (cryptographic ID) (Account) or semantic name (Pool)
 	// Returns a query to select all accounts updated at a specified height
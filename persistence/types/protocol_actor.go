@@ -1,6 +1,9 @@
 package types
 
-import coreTypes "github.com/pokt-network/pocket/shared/core/types"
+import (
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	moduleTypes "github.com/pokt-network/pocket/shared/modules/types"
+)
 
 // Interface common to all protocol actors at the persistence schema layer. This exposes SQL specific
 // attributes and queries.
@@ -27,6 +30,10 @@ type ProtocolActorSchema interface {
 	GetQuery(address string, height int64) string
 	// Returns all actors at that height
 	GetAllQuery(height int64) string
+	// Returns a query for the page of actors at that height matching the filter, ordered by address
+	GetPaginatedQuery(filter *moduleTypes.ActorQueryFilter, height int64, offset, limit uint64) string
+	// Returns a query for the number of actors at that height matching the filter
+	GetCountQuery(filter *moduleTypes.ActorQueryFilter, height int64) string
 	// Returns a query for the existence of an Actor given its address.
 	GetExistsQuery(address string, height int64) string
 	// Returns a query to retrieve data associated with all the apps ready to unstake.
@@ -42,6 +49,8 @@ type ProtocolActorSchema interface {
 	GetUnstakingHeightQuery(address string, height int64) string
 	// Returns a query to retrieve all the data associated with the chains an Actor is staked for.
 	GetChainsQuery(address string, height int64) string
+	// Returns a query to retrieve the distinct chains the staked Actors are staked for at that height.
+	GetStakedChainsQuery(height int64) string
 
 	/*** Create/Insert Queries ***/
 
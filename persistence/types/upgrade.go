@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	UpgradesTableName   = "upgrades"
+	UpgradesTableSchema = `(
+		height BIGINT NOT NULL,
+		version TEXT NOT NULL,
+		upgrade_height BIGINT NOT NULL,
+		PRIMARY KEY (height)
+	)`
+)
+
+// InsertUpgradeQuery returns the query to schedule the version at `upgradeHeight` at the height provided.
+// An upgrade scheduled again in the same block replaces the previous one.
+func InsertUpgradeQuery(version string, upgradeHeight, height int64) string {
+	return fmt.Sprintf(
+		`INSERT INTO %s(height, version, upgrade_height) VALUES(%d, '%s', %d) ON CONFLICT (height) DO UPDATE SET version=EXCLUDED.version, upgrade_height=EXCLUDED.upgrade_height`,
+		UpgradesTableName,
+		height,
+		version,
+		upgradeHeight,
+	)
+}
+
+// GetActiveUpgradeQuery returns the last upgrade activated at or before the height provided.
+// An upgrade is superseded, and never activated, if another one is scheduled before its upgrade height.
+func GetActiveUpgradeQuery(height int64) string {
+	return fmt.Sprintf(
+		`SELECT version, upgrade_height, height FROM %s AS u
+			WHERE u.upgrade_height<=%d AND NOT EXISTS (SELECT 1 FROM %s AS s WHERE s.height>u.height AND s.height<u.upgrade_height)
+			ORDER BY u.upgrade_height DESC LIMIT 1`,
+		UpgradesTableName,
+		height,
+		UpgradesTableName,
+	)
+}
+
+// GetPendingUpgradeQuery returns the upgrade scheduled last at or before the height provided if it is not active yet
+func GetPendingUpgradeQuery(height int64) string {
+	return fmt.Sprintf(
+		`SELECT version, upgrade_height, height FROM (SELECT * FROM %s WHERE height<=%d ORDER BY height DESC LIMIT 1) AS latest WHERE upgrade_height>%d`,
+		UpgradesTableName,
+		height,
+		height,
+	)
+}
+
+// GetUpgradesUpdatedAtHeightQuery returns the upgrades scheduled at exactly the height provided
+func GetUpgradesUpdatedAtHeightQuery(height int64) string {
+	return fmt.Sprintf(`SELECT version, upgrade_height, height FROM %s WHERE height=%d`, UpgradesTableName, height)
+}
+
+// ClearAllUpgradesQuery returns the query to clear all entries from the upgrades table
+func ClearAllUpgradesQuery() string {
+	return fmt.Sprintf(`DELETE FROM %s`, UpgradesTableName)
+}
@@ -0,0 +1,71 @@
+package persistence
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	pTypes "github.com/pokt-network/pocket/persistence/types"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+// SetUpgrade schedules the version at the upgrade height at the current height in the persistence DB
+func (p *PostgresContext) SetUpgrade(version string, upgradeHeight int64) error {
+	ctx, tx := p.getCtxAndTx()
+	if _, err := tx.Exec(ctx, pTypes.InsertUpgradeQuery(version, upgradeHeight, p.Height)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetVersionAtHeight returns the version of the last upgrade activated at or before the height provided,
+// or the genesis protocol version if there are none
+func (p *PostgresContext) GetVersionAtHeight(height int64) (string, error) {
+	upgrade, err := p.getUpgrade(pTypes.GetActiveUpgradeQuery(height))
+	if err != nil {
+		return "", err
+	}
+	if upgrade == nil {
+		return coreTypes.GenesisProtocolVersion, nil
+	}
+	return upgrade.Version, nil
+}
+
+// GetPendingUpgrade returns the upgrade scheduled at or before the height provided that is not active yet, or nil if there is none
+func (p *PostgresContext) GetPendingUpgrade(height int64) (*coreTypes.Upgrade, error) {
+	return p.getUpgrade(pTypes.GetPendingUpgradeQuery(height))
+}
+
+// GetSupportedChains returns the chains, ordered alphabetically, that the servicers staked at the height provided are staked for
+func (p *PostgresContext) GetSupportedChains(height int64) ([]string, error) {
+	ctx, tx := p.getCtxAndTx()
+	rows, err := tx.Query(ctx, pTypes.ServicerActor.GetStakedChainsQuery(height))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	chains := make([]string, 0)
+	for rows.Next() {
+		var chain string
+		if err := rows.Scan(&chain); err != nil {
+			return nil, err
+		}
+		chains = append(chains, chain)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return chains, nil
+}
+
+func (p *PostgresContext) getUpgrade(query string) (*coreTypes.Upgrade, error) {
+	ctx, tx := p.getCtxAndTx()
+	upgrade := new(coreTypes.Upgrade)
+	err := tx.QueryRow(ctx, query).Scan(&upgrade.Version, &upgrade.Height, &upgrade.ScheduledHeight)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return upgrade, nil
+}
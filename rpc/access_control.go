@@ -0,0 +1,376 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"golang.org/x/time/rate"
+
+	"github.com/pokt-network/pocket/runtime/configs"
+	"github.com/pokt-network/pocket/telemetry"
+)
+
+// rpcScope is a group of routes an API key can be granted access to
+type rpcScope string
+
+const (
+	scopeQuery     rpcScope = "query"
+	scopeBroadcast rpcScope = "broadcast"
+	scopeDebug     rpcScope = "debug"
+
+	apiKeyHeader = "X-API-Key"
+	bearerPrefix = "Bearer "
+
+	// limiterSweepInterval is how often the idle IP buckets are evicted
+	limiterSweepInterval = time.Minute
+)
+
+// Reasons a request is rejected, reported in telemetry
+const (
+	rejectionUnauthorized = "unauthorized"
+	rejectionForbidden    = "forbidden"
+	rejectionRateLimited  = "rate_limited"
+)
+
+var (
+	validScopes = map[rpcScope]struct{}{
+		scopeQuery:     {},
+		scopeBroadcast: {},
+		scopeDebug:     {},
+	}
+
+	// routeScopes lists the routes that are not in the `query` scope
+	routeScopes = map[string]rpcScope{
+		"/v1/client/broadcast_tx_async":      scopeBroadcast,
+		"/v1/client/broadcast_tx_commit":     scopeBroadcast,
+		"/v1/client/broadcast_tx_sync":       scopeBroadcast,
+		"/v1/client/challenge":               scopeBroadcast,
+		"/v1/client/relay":                   scopeBroadcast,
+		"/v1/consensus/state":                scopeDebug,
+		"/v1/p2p/staked_actors_address_book": scopeDebug,
+	}
+
+	// defaultRouteCosts are the built-in costs of the expensive routes, every other route costs 1 token
+	defaultRouteCosts = map[string]uint32{
+		"/v1/query/accounts":             5,
+		"/v1/query/account_txs":          5,
+		"/v1/query/apps":                 5,
+		"/v1/query/block_txs":            5,
+		"/v1/query/fishermen":            5,
+		"/v1/query/servicers":            5,
+		"/v1/query/unconfirmed_txs":      5,
+		"/v1/query/validators":           5,
+		"/v1/query/proof":                3,
+		"/v1/client/relay":               3,
+		"/v1/client/broadcast_tx_commit": 3,
+	}
+)
+
+type rpcClientCtxKey struct{}
+
+// rpcClient identifies the client of a request for the authorization and the rate limits
+type rpcClient struct {
+	ip     string
+	apiKey *apiKey
+}
+
+func (c *rpcClient) String() string {
+	if c.apiKey != nil {
+		return "key:" + c.apiKey.name
+	}
+	return "ip:" + c.ip
+}
+
+type apiKey struct {
+	name    string
+	scopes  map[rpcScope]struct{}
+	limiter *rate.Limiter
+}
+
+// accessController authenticates the RPC requests with the configured API keys, checks the scope of the
+// routes they are requesting and enforces the per IP and per key token bucket rate limits.
+type accessController struct {
+	authEnabled  bool
+	apiKeys      map[string]*apiKey
+	publicScopes map[rpcScope]struct{}
+
+	rateLimitEnabled bool
+	routeCosts       map[string]uint32
+	keyLimit         rate.Limit
+	keyBurst         int
+	ipLimit          rate.Limit
+	ipBurst          int
+
+	m          sync.Mutex
+	ipLimiters map[string]*ipLimiter
+	lastSweep  time.Time
+}
+
+type ipLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// newAccessController returns nil if neither the authentication nor the rate limits are enabled
+func newAccessController(cfg *configs.RPCConfig) (*accessController, error) {
+	authCfg, rateLimitCfg := cfg.GetAuth(), cfg.GetRateLimit()
+	if !authCfg.GetEnabled() && !rateLimitCfg.GetEnabled() {
+		return nil, nil
+	}
+
+	ac := &accessController{
+		authEnabled:      authCfg.GetEnabled(),
+		apiKeys:          make(map[string]*apiKey),
+		rateLimitEnabled: rateLimitCfg.GetEnabled(),
+		routeCosts:       make(map[string]uint32),
+		ipLimiters:       make(map[string]*ipLimiter),
+	}
+
+	publicScopes, err := parseScopes(authCfg.GetPublicScopes())
+	if err != nil {
+		return nil, fmt.Errorf("invalid public scopes: %w", err)
+	}
+	ac.publicScopes = publicScopes
+
+	var maxCost uint32 = 1
+	for route, cost := range defaultRouteCosts {
+		ac.routeCosts[route] = cost
+	}
+	for route, cost := range rateLimitCfg.GetRouteCosts() {
+		ac.routeCosts[route] = cost
+	}
+	for _, cost := range ac.routeCosts {
+		if cost > maxCost {
+			maxCost = cost
+		}
+	}
+	ac.ipLimit, ac.ipBurst = tokenBucket(rateLimitCfg.GetIpRequestsPerSecond(), rateLimitCfg.GetIpBurst(), maxCost)
+	ac.keyLimit, ac.keyBurst = tokenBucket(rateLimitCfg.GetKeyRequestsPerSecond(), rateLimitCfg.GetKeyBurst(), maxCost)
+
+	for _, keyCfg := range authCfg.GetApiKeys() {
+		if keyCfg.GetName() == "" || keyCfg.GetKey() == "" {
+			return nil, fmt.Errorf("API keys must have a name and a key")
+		}
+		if _, ok := ac.apiKeys[keyCfg.GetKey()]; ok {
+			return nil, fmt.Errorf("API key %s is configured more than once", keyCfg.GetName())
+		}
+		scopes, err := parseScopes(keyCfg.GetScopes())
+		if err != nil {
+			return nil, fmt.Errorf("invalid scopes for API key %s: %w", keyCfg.GetName(), err)
+		}
+		limit, burst := ac.keyLimit, ac.keyBurst
+		if keyCfg.GetRequestsPerSecond() > 0 {
+			limit, burst = tokenBucket(keyCfg.GetRequestsPerSecond(), keyCfg.GetBurst(), maxCost)
+		}
+		ac.apiKeys[keyCfg.GetKey()] = &apiKey{
+			name:    keyCfg.GetName(),
+			scopes:  scopes,
+			limiter: rate.NewLimiter(limit, burst),
+		}
+	}
+
+	return ac, nil
+}
+
+// tokenBucket converts the configured rate and burst into a limiter's; a rate of 0 means no limit
+func tokenBucket(requestsPerSecond float64, burst, maxCost uint32) (rate.Limit, int) {
+	if requestsPerSecond <= 0 {
+		return rate.Inf, 0
+	}
+	if burst == 0 {
+		burst = uint32(math.Ceil(requestsPerSecond))
+		if burst < maxCost {
+			burst = maxCost
+		}
+	}
+	return rate.Limit(requestsPerSecond), int(burst)
+}
+
+func parseScopes(scopes []string) (map[rpcScope]struct{}, error) {
+	parsed := make(map[rpcScope]struct{}, len(scopes))
+	for _, scope := range scopes {
+		if _, ok := validScopes[rpcScope(scope)]; !ok {
+			return nil, fmt.Errorf("unknown scope %q", scope)
+		}
+		parsed[rpcScope(scope)] = struct{}{}
+	}
+	return parsed, nil
+}
+
+func getRouteScope(path string) rpcScope {
+	if scope, ok := routeScopes[path]; ok {
+		return scope
+	}
+	return scopeQuery
+}
+
+func (ac *accessController) getRouteCost(path string) int {
+	if cost, ok := ac.routeCosts[path]; ok {
+		return int(cost)
+	}
+	return 1
+}
+
+// authenticate identifies the client of the request, it fails if the request carries an unknown API key
+func (ac *accessController) authenticate(req *http.Request, ip string) (*rpcClient, *echo.HTTPError) {
+	client := &rpcClient{ip: ip}
+	if !ac.authEnabled {
+		return client, nil
+	}
+
+	key := req.Header.Get(apiKeyHeader)
+	if auth := req.Header.Get(echo.HeaderAuthorization); key == "" && strings.HasPrefix(auth, bearerPrefix) {
+		key = strings.TrimPrefix(auth, bearerPrefix)
+	}
+	if key == "" {
+		return client, nil
+	}
+
+	apiKey, ok := ac.apiKeys[key]
+	if !ok {
+		return nil, echo.NewHTTPError(http.StatusUnauthorized, "invalid API key")
+	}
+	client.apiKey = apiKey
+	return client, nil
+}
+
+// authorize checks that the client has the scope of the route and consumes the cost of the route from its bucket
+func (ac *accessController) authorize(client *rpcClient, path string, now time.Time) (reason string, httpErr *echo.HTTPError) {
+	if ac.authEnabled {
+		scope := getRouteScope(path)
+		scopes := ac.publicScopes
+		if client.apiKey != nil {
+			scopes = client.apiKey.scopes
+		}
+		if _, ok := scopes[scope]; !ok {
+			if client.apiKey == nil {
+				return rejectionUnauthorized, echo.NewHTTPError(http.StatusUnauthorized, fmt.Sprintf("an API key with the %s scope is required", scope))
+			}
+			return rejectionForbidden, echo.NewHTTPError(http.StatusForbidden, fmt.Sprintf("the API key does not have the %s scope", scope))
+		}
+	}
+
+	if ac.rateLimitEnabled {
+		limiter := ac.getLimiter(client, now)
+		if limiter.Limit() == rate.Inf {
+			return "", nil
+		}
+		reservation := limiter.ReserveN(now, ac.getRouteCost(path))
+		if !reservation.OK() {
+			return rejectionRateLimited, echo.NewHTTPError(http.StatusTooManyRequests, "the request costs more than the rate limit burst")
+		}
+		if delay := reservation.DelayFrom(now); delay > 0 {
+			reservation.CancelAt(now)
+			httpErr := echo.NewHTTPError(http.StatusTooManyRequests, "rate limit exceeded")
+			httpErr.SetInternal(retryAfterError(delay))
+			return rejectionRateLimited, httpErr
+		}
+	}
+	return "", nil
+}
+
+// getLimiter returns the bucket of the client's API key, or of its IP if it did not provide one
+func (ac *accessController) getLimiter(client *rpcClient, now time.Time) *rate.Limiter {
+	if client.apiKey != nil {
+		return client.apiKey.limiter
+	}
+
+	ac.m.Lock()
+	defer ac.m.Unlock()
+
+	// The buckets that have been idle long enough to be full again are dropped to bound the memory usage
+	if now.Sub(ac.lastSweep) > limiterSweepInterval {
+		for ip, l := range ac.ipLimiters {
+			if now.Sub(l.lastSeen) > limiterSweepInterval {
+				delete(ac.ipLimiters, ip)
+			}
+		}
+		ac.lastSweep = now
+	}
+
+	l, ok := ac.ipLimiters[client.ip]
+	if !ok {
+		l = &ipLimiter{limiter: rate.NewLimiter(ac.ipLimit, ac.ipBurst)}
+		ac.ipLimiters[client.ip] = l
+	}
+	l.lastSeen = now
+	return l.limiter
+}
+
+// retryAfterError carries the delay after which a rate limited request can be retried
+type retryAfterError time.Duration
+
+func (e retryAfterError) Error() string {
+	return fmt.Sprintf("retry after %s", time.Duration(e))
+}
+
+// accessControlMiddleware authenticates the requests and enforces the scopes and rate limits of the routes.
+// JSON-RPC requests are only authenticated here: their calls are authorized one by one by `jsonRPCAccessControlMiddleware`.
+func (s *rpcServer) accessControlMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		path := c.Request().URL.Path
+		client, httpErr := s.accessControl.authenticate(c.Request(), c.RealIP())
+		if httpErr != nil {
+			s.reportRejection(rejectionUnauthorized, path, (&rpcClient{ip: c.RealIP()}).String())
+			return c.String(httpErr.Code, fmt.Sprint(httpErr.Message))
+		}
+
+		if path == jsonRPCPath {
+			c.SetRequest(c.Request().WithContext(context.WithValue(c.Request().Context(), rpcClientCtxKey{}, client)))
+			return next(c)
+		}
+		if reason, httpErr := s.accessControl.authorize(client, path, time.Now()); httpErr != nil {
+			s.reportRejection(reason, path, client.String())
+			return writeAccessControlError(c, httpErr)
+		}
+		return next(c)
+	}
+}
+
+// jsonRPCAccessControlMiddleware authorizes the JSON-RPC calls with the client authenticated by `accessControlMiddleware`
+func (s *rpcServer) jsonRPCAccessControlMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		client, ok := c.Request().Context().Value(rpcClientCtxKey{}).(*rpcClient)
+		if s.accessControl == nil || !ok {
+			return next(c)
+		}
+		path := c.Request().URL.Path
+		if reason, httpErr := s.accessControl.authorize(client, path, time.Now()); httpErr != nil {
+			s.reportRejection(reason, path, client.String())
+			return writeAccessControlError(c, httpErr)
+		}
+		return next(c)
+	}
+}
+
+func writeAccessControlError(c echo.Context, httpErr *echo.HTTPError) error {
+	if delay, ok := httpErr.Internal.(retryAfterError); ok {
+		c.Response().Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(time.Duration(delay).Seconds()))))
+	}
+	return c.String(httpErr.Code, fmt.Sprint(httpErr.Message))
+}
+
+func (s *rpcServer) reportRejection(reason, route, client string) {
+	s.logger.Debug().
+		Str("reason", reason).
+		Str("route", route).
+		Str("client", client).
+		Msg("RPC request rejected")
+
+	telemetryMod := s.GetBus().GetTelemetryModule()
+	telemetryMod.GetTimeSeriesAgent().CounterIncrement(telemetry.RPC_REQUESTS_REJECTED_TIMESERIES_METRIC_NAME)
+	telemetryMod.GetEventMetricsAgent().EmitEvent(
+		telemetry.RPC_EVENT_METRICS_NAMESPACE,
+		telemetry.RPC_REQUEST_REJECTED_EVENT_METRIC_NAME,
+		telemetry.RPC_REQUEST_REJECTED_EVENT_METRIC_REASON_LABEL, reason,
+		telemetry.RPC_REQUEST_REJECTED_EVENT_METRIC_ROUTE_LABEL, route,
+		telemetry.RPC_REQUEST_REJECTED_EVENT_METRIC_CLIENT_LABEL, client,
+	)
+}
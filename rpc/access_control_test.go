@@ -0,0 +1,248 @@
+package rpc
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/pocket/logger"
+	"github.com/pokt-network/pocket/runtime/configs"
+	"github.com/pokt-network/pocket/shared/modules"
+	mockModules "github.com/pokt-network/pocket/shared/modules/mocks"
+	"github.com/pokt-network/pocket/telemetry"
+)
+
+func TestNewAccessController(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      *configs.RPCConfig
+		disabled bool
+		wantErr  bool
+	}{
+		{
+			name:     "disabled",
+			cfg:      &configs.RPCConfig{},
+			disabled: true,
+		},
+		{
+			name: "unknown public scope",
+			cfg: &configs.RPCConfig{Auth: &configs.RPCAuthConfig{
+				Enabled:      true,
+				PublicScopes: []string{"admin"},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "unknown key scope",
+			cfg: &configs.RPCConfig{Auth: &configs.RPCAuthConfig{
+				Enabled: true,
+				ApiKeys: []*configs.RPCAPIKey{{Name: "a", Key: "key", Scopes: []string{"admin"}}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "key without a name",
+			cfg: &configs.RPCConfig{Auth: &configs.RPCAuthConfig{
+				Enabled: true,
+				ApiKeys: []*configs.RPCAPIKey{{Key: "key"}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "duplicate key",
+			cfg: &configs.RPCConfig{Auth: &configs.RPCAuthConfig{
+				Enabled: true,
+				ApiKeys: []*configs.RPCAPIKey{{Name: "a", Key: "key"}, {Name: "b", Key: "key"}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "rate limit only",
+			cfg:  &configs.RPCConfig{RateLimit: &configs.RPCRateLimitConfig{Enabled: true, IpRequestsPerSecond: 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ac, err := newAccessController(tt.cfg)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.disabled, ac == nil)
+		})
+	}
+}
+
+func TestAccessController_Authorize(t *testing.T) {
+	ac, err := newAccessController(&configs.RPCConfig{
+		Auth: &configs.RPCAuthConfig{
+			Enabled:      true,
+			PublicScopes: []string{"query"},
+			ApiKeys: []*configs.RPCAPIKey{
+				{Name: "broadcaster", Key: "broadcast-key", Scopes: []string{"query", "broadcast"}},
+				{Name: "limited", Key: "limited-key", Scopes: []string{"query"}},
+				{Name: "fast", Key: "fast-key", Scopes: []string{"query"}, RequestsPerSecond: 100},
+			},
+		},
+		RateLimit: &configs.RPCRateLimitConfig{
+			Enabled:              true,
+			IpRequestsPerSecond:  1,
+			IpBurst:              6,
+			KeyRequestsPerSecond: 1,
+			KeyBurst:             2,
+			RouteCosts:           map[string]uint32{"/v1/query/height": 2},
+		},
+	})
+	require.NoError(t, err)
+	now := time.Now()
+
+	authenticate := func(t *testing.T, key string, ip string) *rpcClient {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if key != "" {
+			req.Header.Set(echo.HeaderAuthorization, "Bearer "+key)
+		}
+		client, httpErr := ac.authenticate(req, ip)
+		require.Nil(t, httpErr)
+		return client
+	}
+
+	t.Run("unknown key", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(apiKeyHeader, "unknown")
+		_, httpErr := ac.authenticate(req, "1.1.1.1")
+		require.Equal(t, http.StatusUnauthorized, httpErr.Code)
+	})
+
+	t.Run("scopes", func(t *testing.T) {
+		anonymous := authenticate(t, "", "2.2.2.2")
+		_, httpErr := ac.authorize(anonymous, "/v1/query/account", now)
+		require.Nil(t, httpErr)
+		reason, httpErr := ac.authorize(anonymous, "/v1/client/broadcast_tx_sync", now)
+		require.Equal(t, rejectionUnauthorized, reason)
+		require.Equal(t, http.StatusUnauthorized, httpErr.Code)
+
+		broadcaster := authenticate(t, "broadcast-key", "2.2.2.2")
+		_, httpErr = ac.authorize(broadcaster, "/v1/client/broadcast_tx_sync", now)
+		require.Nil(t, httpErr)
+		reason, httpErr = ac.authorize(broadcaster, "/v1/p2p/staked_actors_address_book", now)
+		require.Equal(t, rejectionForbidden, reason)
+		require.Equal(t, http.StatusForbidden, httpErr.Code)
+	})
+
+	t.Run("anonymous clients are limited per IP", func(t *testing.T) {
+		client := authenticate(t, "", "3.3.3.3")
+		// the burst of 6 covers the built-in cost of 5 of the accounts query and the default cost of 1
+		_, httpErr := ac.authorize(client, "/v1/query/accounts", now)
+		require.Nil(t, httpErr)
+		_, httpErr = ac.authorize(client, "/v1/query/account", now)
+		require.Nil(t, httpErr)
+
+		reason, httpErr := ac.authorize(client, "/v1/query/account", now)
+		require.Equal(t, rejectionRateLimited, reason)
+		require.Equal(t, http.StatusTooManyRequests, httpErr.Code)
+		require.Equal(t, retryAfterError(time.Second), httpErr.Internal)
+
+		// other IPs have their own bucket
+		_, httpErr = ac.authorize(authenticate(t, "", "4.4.4.4"), "/v1/query/account", now)
+		require.Nil(t, httpErr)
+
+		// the bucket refills over time
+		_, httpErr = ac.authorize(client, "/v1/query/account", now.Add(time.Second))
+		require.Nil(t, httpErr)
+	})
+
+	t.Run("keys are limited per key", func(t *testing.T) {
+		client := authenticate(t, "limited-key", "5.5.5.5")
+		// the configured route cost of 2 uses the whole burst of the key
+		_, httpErr := ac.authorize(client, "/v1/query/height", now)
+		require.Nil(t, httpErr)
+		_, httpErr = ac.authorize(authenticate(t, "limited-key", "6.6.6.6"), "/v1/query/account", now)
+		require.Equal(t, http.StatusTooManyRequests, httpErr.Code)
+
+		// routes costing more than the burst are always rejected
+		_, httpErr = ac.authorize(client, "/v1/query/accounts", now.Add(time.Hour))
+		require.Equal(t, http.StatusTooManyRequests, httpErr.Code)
+
+		// the key specific rate overrides the default one
+		fast := authenticate(t, "fast-key", "5.5.5.5")
+		for i := 0; i < 10; i++ {
+			_, httpErr = ac.authorize(fast, "/v1/query/accounts", now)
+			require.Nil(t, httpErr)
+		}
+	})
+}
+
+func TestRPCServer_AccessControlMiddleware(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	timeSeriesAgentMock := mockModules.NewMockTimeSeriesAgent(ctrl)
+	timeSeriesAgentMock.EXPECT().CounterIncrement(telemetry.RPC_REQUESTS_REJECTED_TIMESERIES_METRIC_NAME).Times(3)
+	eventMetricsAgentMock := mockModules.NewMockEventMetricsAgent(ctrl)
+	eventMetricsAgentMock.EXPECT().EmitEvent(telemetry.RPC_EVENT_METRICS_NAMESPACE, telemetry.RPC_REQUEST_REJECTED_EVENT_METRIC_NAME, gomock.Any()).Times(3)
+	telemetryMock := mockModules.NewMockTelemetryModule(ctrl)
+	telemetryMock.EXPECT().GetTimeSeriesAgent().Return(timeSeriesAgentMock).AnyTimes()
+	telemetryMock.EXPECT().GetEventMetricsAgent().Return(eventMetricsAgentMock).AnyTimes()
+
+	busMock := mockModules.NewMockBus(ctrl)
+	busMock.EXPECT().GetTelemetryModule().Return(telemetryMock).AnyTimes()
+
+	s := NewRPCServer(busMock)
+	s.logger = *logger.Global.CreateLoggerForModule(modules.RPCModuleName)
+	s.accessControl, _ = newAccessController(&configs.RPCConfig{
+		Auth: &configs.RPCAuthConfig{
+			Enabled:      true,
+			PublicScopes: []string{"query"},
+			ApiKeys:      []*configs.RPCAPIKey{{Name: "debugger", Key: "debug-key", Scopes: []string{"debug"}}},
+		},
+	})
+
+	e := echo.New()
+	e.Use(s.accessControlMiddleware)
+	e.GET("/v1/health", s.GetV1Health)
+	e.GET("/v1/consensus/state", s.GetV1Health)
+	e.POST(jsonRPCPath, s.PostV1JSONRPC)
+
+	serve := func(req *http.Request) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := serve(httptest.NewRequest(http.MethodGet, "/v1/health", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = serve(httptest.NewRequest(http.MethodGet, "/v1/consensus/state", nil))
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/consensus/state", nil)
+	req.Header.Set(apiKeyHeader, "debug-key")
+	require.Equal(t, http.StatusOK, serve(req).Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/v1/health", nil)
+	req.Header.Set(apiKeyHeader, "invalid-key")
+	require.Equal(t, http.StatusUnauthorized, serve(req).Code)
+
+	// JSON-RPC calls are authorized one by one
+	req = httptest.NewRequest(http.MethodPost, jsonRPCPath, strings.NewReader(`[
+		{"jsonrpc":"2.0","id":1,"method":"health"},
+		{"jsonrpc":"2.0","id":2,"method":"consensus_state"}
+	]`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec = serve(req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var res []jsonRPCResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Len(t, res, 2)
+	require.Nil(t, res[0].Error)
+	require.Equal(t, jsonRPCServerError, res[1].Error.Code)
+	require.Equal(t, map[string]any{"http_status": float64(http.StatusUnauthorized)}, res[1].Error.Data)
+}
@@ -0,0 +1,100 @@
+package rpc
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/pokt-network/pocket/runtime/defaults"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+)
+
+const broadcastTxCommitPath = "/v1/client/broadcast_tx_commit"
+
+// heightNotifier wakes up the goroutines waiting for a new block to be committed
+type heightNotifier struct {
+	mu sync.Mutex
+	ch chan struct{}
+}
+
+func newHeightNotifier() *heightNotifier {
+	return &heightNotifier{
+		ch: make(chan struct{}),
+	}
+}
+
+// wait returns a channel that is closed the next time notify is called
+func (n *heightNotifier) wait() <-chan struct{} {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.ch
+}
+
+func (n *heightNotifier) notify() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	close(n.ch)
+	n.ch = make(chan struct{})
+}
+
+// checkTxHash returns the hash of the transaction if it is neither in the mempool nor already committed
+func (s *rpcServer) checkTxHash(txBz []byte) (string, error) {
+	txHash := crypto.SHA3Hash(txBz)
+	txHashHex := hex.EncodeToString(txHash)
+
+	if s.GetBus().GetUtilityModule().GetMempool().Contains(txHashHex) {
+		return "", coreTypes.ErrDuplicateTransaction()
+	}
+	txExists, err := s.GetBus().GetPersistenceModule().TransactionExists(txHash, txBz)
+	if err != nil {
+		return "", err
+	}
+	if txExists {
+		return "", coreTypes.ErrTransactionAlreadyCommitted()
+	}
+	return txHashHex, nil
+}
+
+// handleTxAsync validates, adds to the mempool and gossips a transaction whose client is not waiting for the result
+func (s *rpcServer) handleTxAsync(txBz []byte, txHash string) {
+	if err := s.GetBus().GetUtilityModule().HandleTransaction(txBz); err != nil {
+		s.logger.Debug().Err(err).Str("hash", txHash).Msg("Failed to handle async broadcast transaction")
+		return
+	}
+	if err := s.broadcastMessage(txBz); err != nil {
+		s.logger.Debug().Err(err).Str("hash", txHash).Msg("Failed to gossip async broadcast transaction")
+	}
+}
+
+// getBroadcastTxCommitTimeout returns how long `broadcast_tx_commit` waits for the transaction to be committed.
+// The RPC timeout is reused since the endpoint bypasses the timeout middleware in order to respond itself.
+func (s *rpcServer) getBroadcastTxCommitTimeout() time.Duration {
+	if s.timeout == 0 {
+		return time.Duration(defaults.DefaultRPCTimeout) * time.Millisecond
+	}
+	return s.timeout
+}
+
+// waitForTxCommit blocks until the transaction is indexed in a committed block or ctx is done
+func (s *rpcServer) waitForTxCommit(ctx context.Context, txBz []byte) (*coreTypes.IndexedTransaction, error) {
+	for {
+		// Get the notification channel before checking the index so a block committed in between is not missed
+		newHeight := s.committedHeights.wait()
+
+		idxTx, err := s.GetBus().GetUtilityModule().GetIndexedTransaction(txBz)
+		if err == nil {
+			return idxTx, nil
+		}
+		if coreErr, ok := err.(coreTypes.Error); !ok || coreErr.Code() != coreTypes.CodeTransactionNotCommittedError {
+			return nil, err
+		}
+
+		select {
+		case <-newHeight:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
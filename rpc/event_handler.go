@@ -0,0 +1,147 @@
+package rpc
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/messaging"
+)
+
+// handleEvent publishes the bus events relevant to the WebSocket subscriptions
+func (s *rpcServer) handleEvent(event *anypb.Any) error {
+	evt, err := codec.GetCodec().FromAny(event)
+	if err != nil {
+		return err
+	}
+
+	switch event.MessageName() {
+	case messaging.ConsensusNewHeightEventType:
+		consensusNewHeightEvent, ok := evt.(*messaging.ConsensusNewHeightEvent)
+		if !ok {
+			return fmt.Errorf("failed to cast event to ConsensusNewHeightEvent")
+		}
+		// Wake up the `broadcast_tx_commit` requests waiting for their transaction to be committed
+		s.committedHeights.notify()
+
+		// The consensus module is at the height it is actively participating in, so the most
+		// recently committed block is one below it (see `getQueryHeight`).
+		if consensusNewHeightEvent.GetHeight() == 0 {
+			return nil
+		}
+		return s.publishCommittedHeight(consensusNewHeightEvent.GetHeight() - 1)
+
+	case messaging.StateMachineTransitionEventType:
+		stateTransitionEvent, ok := evt.(*messaging.StateMachineTransitionEvent)
+		if !ok {
+			return fmt.Errorf("failed to cast event to StateMachineTransitionEvent")
+		}
+		topic := StateTransition
+		s.subscriptions.publish(SubscriptionMessage{
+			Topic: &topic,
+			StateTransition: &SubscriptionStateTransition{
+				Event:         stateTransitionEvent.GetEvent(),
+				PreviousState: stateTransitionEvent.GetPreviousState(),
+				NewState:      stateTransitionEvent.GetNewState(),
+			},
+		}, nil)
+	}
+
+	return nil
+}
+
+// publishCommittedHeight publishes the block committed at height, its transactions and the IBC
+// events emitted at that height to the matching subscriptions
+func (s *rpcServer) publishCommittedHeight(height uint64) error {
+	rpcHeight := int64(height)
+
+	if s.subscriptions.hasSubscriptions(NewBlock, NewTx) {
+		block, err := s.GetBus().GetPersistenceModule().GetBlockStore().GetBlock(height)
+		if err != nil {
+			return err
+		}
+		rpcBlock, err := s.blockToRPCBlock(block)
+		if err != nil {
+			return err
+		}
+
+		blockTopic := NewBlock
+		s.subscriptions.publish(SubscriptionMessage{
+			Topic:  &blockTopic,
+			Height: &rpcHeight,
+			Block:  rpcBlock,
+		}, nil)
+
+		txTopic := NewTx
+		for i := range rpcBlock.Transactions {
+			tx := &rpcBlock.Transactions[i]
+			s.subscriptions.publish(SubscriptionMessage{
+				Topic:  &txTopic,
+				Height: &rpcHeight,
+				Tx:     tx,
+			}, func(filter *SubscriptionFilter) bool {
+				return txMatchesFilter(tx, filter)
+			})
+		}
+	}
+
+	ibcTopics := s.subscriptions.ibcTopics()
+	if len(ibcTopics) == 0 {
+		return nil
+	}
+	eventLogger, err := s.getEventLogger()
+	if err != nil {
+		return err
+	}
+	ibcTopic := IbcEvent
+	for _, topic := range ibcTopics {
+		events, err := eventLogger.QueryEvents(topic, height)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			rpcEvent := ibcEventToRPCIBCEvent(event)
+			s.subscriptions.publish(SubscriptionMessage{
+				Topic:    &ibcTopic,
+				Height:   &rpcHeight,
+				IbcEvent: rpcEvent,
+			}, func(filter *SubscriptionFilter) bool {
+				return filter != nil && filter.IbcTopic != nil && *filter.IbcTopic == rpcEvent.Topic
+			})
+		}
+	}
+
+	return nil
+}
+
+// txMatchesFilter returns true if the transaction matches every address set in the filter
+func txMatchesFilter(tx *IndexedTransaction, filter *SubscriptionFilter) bool {
+	if filter == nil {
+		return true
+	}
+	if filter.SignerAddr != nil && *filter.SignerAddr != "" && *filter.SignerAddr != tx.SignerAddr {
+		return false
+	}
+	if filter.RecipientAddr != nil && *filter.RecipientAddr != "" && *filter.RecipientAddr != tx.RecipientAddr {
+		return false
+	}
+	return true
+}
+
+// ibcEventToRPCIBCEvent converts an IBC event protobuf to the RPC subscription IBC event type
+func ibcEventToRPCIBCEvent(event *coreTypes.IBCEvent) *SubscriptionIBCEvent {
+	attributes := make([]SubscriptionIBCEventAttribute, 0, len(event.GetAttributes()))
+	for _, attribute := range event.GetAttributes() {
+		attributes = append(attributes, SubscriptionIBCEventAttribute{
+			Key:   hex.EncodeToString(attribute.GetKey()),
+			Value: hex.EncodeToString(attribute.GetValue()),
+		})
+	}
+	return &SubscriptionIBCEvent{
+		Topic:      event.GetTopic(),
+		Attributes: attributes,
+	}
+}
@@ -0,0 +1,179 @@
+package rpc
+
+import (
+	"context"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	rpcTypes "github.com/pokt-network/pocket/rpc/types"
+	"github.com/pokt-network/pocket/runtime/configs"
+	"github.com/pokt-network/pocket/runtime/defaults"
+)
+
+// grpcRoute is the REST route a gRPC method mirrors, as declared by its `google.api.http` annotation
+type grpcRoute struct {
+	httpMethod string
+	path       string
+}
+
+// httpToGRPCCodes maps the status codes of the errors shared with the REST handlers to gRPC codes, other codes map to `codes.Internal`
+var httpToGRPCCodes = map[int]codes.Code{
+	http.StatusBadRequest:         codes.InvalidArgument,
+	http.StatusUnauthorized:       codes.Unauthenticated,
+	http.StatusForbidden:          codes.PermissionDenied,
+	http.StatusNotFound:           codes.NotFound,
+	http.StatusTooManyRequests:    codes.ResourceExhausted,
+	http.StatusServiceUnavailable: codes.Unavailable,
+}
+
+// grpcServer implements the gRPC services defined in `rpc/types/proto/rpc.proto` on top of the RPC server
+type grpcServer struct {
+	*rpcServer
+
+	rpcTypes.UnimplementedQueryServiceServer
+	rpcTypes.UnimplementedBroadcastServiceServer
+	rpcTypes.UnimplementedSessionServiceServer
+	rpcTypes.UnimplementedRelayServiceServer
+}
+
+var (
+	_ rpcTypes.QueryServiceServer     = &grpcServer{}
+	_ rpcTypes.BroadcastServiceServer = &grpcServer{}
+	_ rpcTypes.SessionServiceServer   = &grpcServer{}
+	_ rpcTypes.RelayServiceServer     = &grpcServer{}
+)
+
+// getGRPCRoutes maps the full name of the gRPC methods, e.g. `/rpc.QueryService/GetHeight`, to the REST route they mirror
+func getGRPCRoutes() map[string]grpcRoute {
+	routes := make(map[string]grpcRoute)
+	services := rpcTypes.File_rpc_proto.Services()
+	for i := 0; i < services.Len(); i++ {
+		service := services.Get(i)
+		methods := service.Methods()
+		for j := 0; j < methods.Len(); j++ {
+			method := methods.Get(j)
+			rule, ok := proto.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+			if !ok || rule == nil {
+				continue
+			}
+			route := grpcRoute{httpMethod: http.MethodPost, path: rule.GetPost()}
+			if rule.GetGet() != "" {
+				route = grpcRoute{httpMethod: http.MethodGet, path: rule.GetGet()}
+			}
+			routes["/"+string(service.FullName())+"/"+string(method.Name())] = route
+		}
+	}
+	return routes
+}
+
+// newGRPCServer returns a gRPC server serving the RPC services, with the logging and access control of the REST server, and the standard health checks
+func (s *rpcServer) newGRPCServer(enableReflection bool) *grpc.Server {
+	routes := getGRPCRoutes()
+	interceptors := []grpc.UnaryServerInterceptor{s.grpcLoggingInterceptor}
+	if s.accessControl != nil {
+		interceptors = append(interceptors, s.grpcAccessControlInterceptor(routes))
+	}
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptors...))
+
+	srv := &grpcServer{rpcServer: s}
+	rpcTypes.RegisterQueryServiceServer(server, srv)
+	rpcTypes.RegisterBroadcastServiceServer(server, srv)
+	rpcTypes.RegisterSessionServiceServer(server, srv)
+	rpcTypes.RegisterRelayServiceServer(server, srv)
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	if enableReflection {
+		reflection.Register(server)
+	}
+	return server
+}
+
+// StartGRPC serves the gRPC services until the server fails
+func (s *rpcServer) StartGRPC(cfg *configs.RPCGRPCConfig) {
+	port := cfg.GetPort()
+	if port == "" {
+		port = defaults.DefaultGRPCPort
+	}
+	s.logger.Info().Bool("reflection", cfg.GetReflection()).Msgf("Starting gRPC on port " + port)
+
+	listener, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		s.logger.Fatal().Err(err).Msg("gRPC server failed to listen")
+	}
+	if err := s.newGRPCServer(cfg.GetReflection()).Serve(listener); err != nil {
+		s.logger.Fatal().Err(err).Msg("gRPC server failed to start")
+	}
+}
+
+func (s *rpcServer) grpcLoggingInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	res, err := handler(ctx, req)
+	s.logger.Info().
+		Str("method", info.FullMethod).
+		Str("code", status.Code(err).String()).
+		Msg("gRPC request")
+	return res, err
+}
+
+// grpcAccessControlInterceptor enforces the scopes and rate limits of the REST routes the gRPC methods mirror.
+// The API key is read from the `x-api-key` or `authorization` metadata and clients are identified by the
+// address of the connection since `trust_forwarded_for` only applies to the REST server.
+func (s *rpcServer) grpcAccessControlInterceptor(routes map[string]grpcRoute) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		path := info.FullMethod
+		if route, ok := routes[info.FullMethod]; ok {
+			path = route.path
+		}
+
+		header := http.Header{}
+		md, _ := metadata.FromIncomingContext(ctx)
+		for _, key := range []string{apiKeyHeader, echo.HeaderAuthorization} {
+			if values := md.Get(key); len(values) > 0 {
+				header.Set(key, values[0])
+			}
+		}
+		var ip string
+		if p, ok := peer.FromContext(ctx); ok {
+			ip = p.Addr.String()
+			if host, _, err := net.SplitHostPort(ip); err == nil {
+				ip = host
+			}
+		}
+
+		client, httpErr := s.accessControl.authenticate(&http.Request{Header: header}, ip)
+		if httpErr != nil {
+			s.reportRejection(rejectionUnauthorized, path, (&rpcClient{ip: ip}).String())
+			return nil, grpcError(httpErr)
+		}
+		if reason, httpErr := s.accessControl.authorize(client, path, time.Now()); httpErr != nil {
+			s.reportRejection(reason, path, client.String())
+			if delay, ok := httpErr.Internal.(retryAfterError); ok {
+				_ = grpc.SetTrailer(ctx, metadata.Pairs("retry-after", strconv.Itoa(int(math.Ceil(time.Duration(delay).Seconds())))))
+			}
+			return nil, grpcError(httpErr)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// grpcError converts an error returned by the helpers shared with the REST handlers to a gRPC status error
+func grpcError(httpErr *echo.HTTPError) error {
+	code, ok := httpToGRPCCodes[httpErr.Code]
+	if !ok {
+		code = codes.Internal
+	}
+	return status.Errorf(code, "%v", httpErr.Message)
+}
@@ -0,0 +1,597 @@
+package rpc
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	rpcTypes "github.com/pokt-network/pocket/rpc/types"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+)
+
+// This file contains the implementation of the gRPC services. They mirror the REST handlers of the
+// routes in their `google.api.http` annotations but respond with the native protobuf messages.
+
+func (g *grpcServer) GetHeight(context.Context, *rpcTypes.QueryHeightRequest) (*rpcTypes.QueryHeightResponse, error) {
+	return &rpcTypes.QueryHeightResponse{Height: g.getQueryHeight(0)}, nil
+}
+
+func (g *grpcServer) GetHeightAtTime(_ context.Context, req *rpcTypes.QueryTimestampRequest) (*rpcTypes.QueryHeightAtTimeResponse, error) {
+	if req.GetTimestamp() == nil {
+		return nil, status.Error(codes.InvalidArgument, "timestamp is required")
+	}
+	height, err := g.resolveGRPCQueryHeight(0, "", req.GetTimestamp())
+	if err != nil {
+		return nil, err
+	}
+	block, err := g.GetBus().GetPersistenceModule().GetBlockStore().GetBlock(uint64(height))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &rpcTypes.QueryHeightAtTimeResponse{
+		Height:    height,
+		BlockHash: block.BlockHeader.GetStateHash(),
+		BlockTime: block.BlockHeader.GetTimestamp(),
+	}, nil
+}
+
+func (g *grpcServer) GetBlock(_ context.Context, req *rpcTypes.QueryHeightRequest) (*coreTypes.Block, error) {
+	height, err := g.resolveGRPCQueryHeight(req.GetHeight(), req.GetBlockHash(), req.GetTimestamp())
+	if err != nil {
+		return nil, err
+	}
+	return g.getGRPCBlock(height)
+}
+
+func (g *grpcServer) GetBlockByHash(_ context.Context, req *rpcTypes.QueryHashRequest) (*coreTypes.Block, error) {
+	if req.GetHash() == "" {
+		return nil, status.Error(codes.InvalidArgument, "hash is required")
+	}
+	height, err := g.resolveGRPCQueryHeight(0, req.GetHash(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return g.getGRPCBlock(height)
+}
+
+func (g *grpcServer) GetTx(_ context.Context, req *rpcTypes.QueryHashRequest) (*coreTypes.IndexedTransaction, error) {
+	hashBz, err := hex.DecodeString(req.GetHash())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	idxTx, err := g.GetBus().GetPersistenceModule().GetTxIndexer().GetByHash(hashBz)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if idxTx == nil {
+		return nil, status.Errorf(codes.NotFound, "transaction not found: %s", req.GetHash())
+	}
+	return idxTx, nil
+}
+
+func (g *grpcServer) GetUnconfirmedTx(_ context.Context, req *rpcTypes.QueryHashRequest) (*coreTypes.Transaction, error) {
+	uncTx := g.GetBus().GetUtilityModule().GetMempool().Get(req.GetHash())
+	if uncTx == nil {
+		return nil, status.Errorf(codes.NotFound, "hash not found in mempool: %s", req.GetHash())
+	}
+	tx, err := coreTypes.TxFromBytes(uncTx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return tx, nil
+}
+
+func (g *grpcServer) GetAccount(_ context.Context, req *rpcTypes.QueryAddressRequest) (*coreTypes.Account, error) {
+	amount, err := g.getGRPCAccountAmount(req)
+	if err != nil {
+		return nil, err
+	}
+	return &coreTypes.Account{
+		Address: req.GetAddress(),
+		Amount:  amount,
+	}, nil
+}
+
+func (g *grpcServer) GetAccounts(_ context.Context, req *rpcTypes.QueryPaginatedRequest) (*rpcTypes.QueryAccountsResponse, error) {
+	offset, limit, err := getPageOffset(req.GetPage(), req.GetPerPage())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	height, err := g.resolveGRPCQueryHeight(req.GetHeight(), req.GetBlockHash(), req.GetTimestamp())
+	if err != nil {
+		return nil, err
+	}
+	readCtx, err := g.GetBus().GetPersistenceModule().NewReadContext(height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer readCtx.Release() //nolint:errcheck // We only need to make sure the readCtx is released
+
+	pageAccounts, totalAccounts, err := readCtx.GetAccountsPaginated(height, offset, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if totalAccounts == 0 {
+		return &rpcTypes.QueryAccountsResponse{}, nil
+	}
+
+	totalPages := getTotalPages(totalAccounts, limit)
+	if offset >= uint64(totalAccounts) {
+		return nil, status.Errorf(codes.InvalidArgument, "starting page too high: got %d, total pages: %d", req.GetPage(), totalPages)
+	}
+
+	return &rpcTypes.QueryAccountsResponse{
+		Accounts:   pageAccounts,
+		Page:       req.GetPage(),
+		TotalPages: int64(totalPages),
+	}, nil
+}
+
+func (g *grpcServer) GetBalance(_ context.Context, req *rpcTypes.QueryAddressRequest) (*rpcTypes.QueryBalanceResponse, error) {
+	amountStr, err := g.getGRPCAccountAmount(req)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := strconv.ParseInt(amountStr, 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &rpcTypes.QueryBalanceResponse{Balance: amount}, nil
+}
+
+func (g *grpcServer) GetDelegations(_ context.Context, req *rpcTypes.QueryAddressRequest) (*rpcTypes.QueryDelegationsResponse, error) {
+	accBz, err := hex.DecodeString(req.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	height, err := g.resolveGRPCQueryHeight(req.GetHeight(), req.GetBlockHash(), req.GetTimestamp())
+	if err != nil {
+		return nil, err
+	}
+	readCtx, err := g.GetBus().GetPersistenceModule().NewReadContext(height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer readCtx.Release() //nolint:errcheck // We only need to make sure the readCtx is released
+
+	delegations, err := readCtx.GetDelegations(accBz, height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	unbondings, err := readCtx.GetUnbondingDelegations(accBz, height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &rpcTypes.QueryDelegationsResponse{Delegations: delegations, UnbondingDelegations: unbondings}, nil
+}
+
+func (g *grpcServer) GetApp(_ context.Context, req *rpcTypes.QueryAddressRequest) (*coreTypes.Actor, error) {
+	return g.getGRPCActor(coreTypes.ActorType_ACTOR_TYPE_APP, req)
+}
+
+func (g *grpcServer) GetApps(_ context.Context, req *rpcTypes.QueryActorsRequest) (*rpcTypes.QueryActorsResponse, error) {
+	return g.getGRPCActorsPage(coreTypes.ActorType_ACTOR_TYPE_APP, req)
+}
+
+func (g *grpcServer) GetFisherman(_ context.Context, req *rpcTypes.QueryAddressRequest) (*coreTypes.Actor, error) {
+	return g.getGRPCActor(coreTypes.ActorType_ACTOR_TYPE_FISH, req)
+}
+
+func (g *grpcServer) GetFishermen(_ context.Context, req *rpcTypes.QueryActorsRequest) (*rpcTypes.QueryActorsResponse, error) {
+	return g.getGRPCActorsPage(coreTypes.ActorType_ACTOR_TYPE_FISH, req)
+}
+
+func (g *grpcServer) GetProposal(_ context.Context, req *rpcTypes.QueryProposalRequest) (*rpcTypes.QueryProposalResponse, error) {
+	height, err := g.resolveGRPCQueryHeight(req.GetHeight(), req.GetBlockHash(), req.GetTimestamp())
+	if err != nil {
+		return nil, err
+	}
+	readCtx, err := g.GetBus().GetPersistenceModule().NewReadContext(height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer readCtx.Release() //nolint:errcheck // We only need to make sure the readCtx is released
+
+	proposal, err := readCtx.GetProposal(req.GetId(), height)
+	if err != nil {
+		var coreErr coreTypes.Error
+		if errors.As(err, &coreErr) && coreErr.Code() == coreTypes.CodeProposalNotFoundError {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	votes, err := readCtx.GetProposalVotes(proposal.GetId(), height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &rpcTypes.QueryProposalResponse{
+		Proposal: proposal,
+		Votes:    votes,
+	}, nil
+}
+
+func (g *grpcServer) GetProposals(_ context.Context, req *rpcTypes.QueryProposalsRequest) (*rpcTypes.QueryProposalsResponse, error) {
+	height, err := g.resolveGRPCQueryHeight(req.GetHeight(), req.GetBlockHash(), req.GetTimestamp())
+	if err != nil {
+		return nil, err
+	}
+	readCtx, err := g.GetBus().GetPersistenceModule().NewReadContext(height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer readCtx.Release() //nolint:errcheck // We only need to make sure the readCtx is released
+
+	proposals, err := readCtx.GetProposals(req.GetStatus(), height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &rpcTypes.QueryProposalsResponse{
+		Proposals:      proposals,
+		TotalProposals: int64(len(proposals)),
+	}, nil
+}
+
+func (g *grpcServer) GetServicer(_ context.Context, req *rpcTypes.QueryAddressRequest) (*coreTypes.Actor, error) {
+	return g.getGRPCActor(coreTypes.ActorType_ACTOR_TYPE_SERVICER, req)
+}
+
+func (g *grpcServer) GetServicers(_ context.Context, req *rpcTypes.QueryActorsRequest) (*rpcTypes.QueryActorsResponse, error) {
+	return g.getGRPCActorsPage(coreTypes.ActorType_ACTOR_TYPE_SERVICER, req)
+}
+
+func (g *grpcServer) GetValidator(_ context.Context, req *rpcTypes.QueryAddressRequest) (*coreTypes.Actor, error) {
+	return g.getGRPCActor(coreTypes.ActorType_ACTOR_TYPE_VAL, req)
+}
+
+func (g *grpcServer) GetValidators(_ context.Context, req *rpcTypes.QueryActorsRequest) (*rpcTypes.QueryActorsResponse, error) {
+	return g.getGRPCActorsPage(coreTypes.ActorType_ACTOR_TYPE_VAL, req)
+}
+
+func (g *grpcServer) GetParam(_ context.Context, req *rpcTypes.QueryParamRequest) (*rpcTypes.QueryParamResponse, error) {
+	height, err := g.resolveGRPCQueryHeight(req.GetHeight(), req.GetBlockHash(), req.GetTimestamp())
+	if err != nil {
+		return nil, err
+	}
+	readCtx, err := g.GetBus().GetPersistenceModule().NewReadContext(height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer readCtx.Release() //nolint:errcheck // We only need to make sure the readCtx is released
+
+	paramValue, err := readCtx.GetStringParam(req.GetParamName(), height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &rpcTypes.QueryParamResponse{
+		ParamName:  req.GetParamName(),
+		ParamValue: paramValue,
+	}, nil
+}
+
+func (g *grpcServer) GetAllChainParams(context.Context, *rpcTypes.QueryAllChainParamsRequest) (*rpcTypes.QueryAllChainParamsResponse, error) {
+	readCtx, err := g.GetBus().GetPersistenceModule().NewReadContext(g.getQueryHeight(0))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer readCtx.Release() //nolint:errcheck // We only need to make sure the readCtx is released
+
+	paramsSlice, err := readCtx.GetAllParams()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	params := make([]*rpcTypes.QueryParamResponse, 0, len(paramsSlice))
+	for _, param := range paramsSlice {
+		params = append(params, &rpcTypes.QueryParamResponse{
+			ParamName:  param[0],
+			ParamValue: param[1],
+		})
+	}
+	return &rpcTypes.QueryAllChainParamsResponse{Params: params}, nil
+}
+
+func (g *grpcServer) GetSupply(_ context.Context, req *rpcTypes.QueryHeightRequest) (*rpcTypes.QuerySupplyResponse, error) {
+	height, err := g.resolveGRPCQueryHeight(req.GetHeight(), req.GetBlockHash(), req.GetTimestamp())
+	if err != nil {
+		return nil, err
+	}
+	readCtx, err := g.GetBus().GetPersistenceModule().NewReadContext(height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer readCtx.Release() //nolint:errcheck // We only need to make sure the readCtx is released
+
+	pools, err := readCtx.GetAllPools(height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	total := new(big.Int)
+	for _, pool := range pools {
+		amount, success := new(big.Int).SetString(pool.Amount, 10)
+		if !success {
+			return nil, status.Error(codes.Internal, "failed to convert amount to big.Int")
+		}
+		total = total.Add(total, amount)
+	}
+	return &rpcTypes.QuerySupplyResponse{
+		Pools: pools,
+		Total: total.String(),
+	}, nil
+}
+
+func (g *grpcServer) GetSupportedChains(_ context.Context, req *rpcTypes.QueryHeightRequest) (*rpcTypes.QuerySupportedChainsResponse, error) {
+	height, err := g.resolveGRPCQueryHeight(req.GetHeight(), req.GetBlockHash(), req.GetTimestamp())
+	if err != nil {
+		return nil, err
+	}
+	readCtx, err := g.GetBus().GetPersistenceModule().NewReadContext(height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer readCtx.Release() //nolint:errcheck // We only need to make sure the readCtx is released
+
+	chains, err := readCtx.GetSupportedChains(height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &rpcTypes.QuerySupportedChainsResponse{SupportedChains: chains}, nil
+}
+
+func (g *grpcServer) GetUpgrade(_ context.Context, req *rpcTypes.QueryHeightRequest) (*rpcTypes.QueryUpgradeResponse, error) {
+	height, err := g.resolveGRPCQueryHeight(req.GetHeight(), req.GetBlockHash(), req.GetTimestamp())
+	if err != nil {
+		return nil, err
+	}
+	readCtx, err := g.GetBus().GetPersistenceModule().NewReadContext(height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer readCtx.Release() //nolint:errcheck // We only need to make sure the readCtx is released
+
+	version, err := readCtx.GetVersionAtHeight(height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	pending, err := readCtx.GetPendingUpgrade(height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &rpcTypes.QueryUpgradeResponse{
+		Height:         height,
+		Version:        version,
+		PendingUpgrade: pending,
+	}, nil
+}
+
+func (g *grpcServer) GetNodeRoles(context.Context, *rpcTypes.QueryNodeRolesRequest) (*rpcTypes.QueryNodeRolesResponse, error) {
+	roles := make([]string, 0)
+	for _, m := range g.GetBus().GetUtilityModule().GetActorModules() {
+		roles = append(roles, m.GetModuleName())
+	}
+	return &rpcTypes.QueryNodeRolesResponse{NodeRoles: roles}, nil
+}
+
+func (g *grpcServer) BroadcastTxSync(_ context.Context, req *rpcTypes.BroadcastTxRequest) (*rpcTypes.BroadcastTxResponse, error) {
+	if err := g.handleGRPCTx(req.GetTx()); err != nil {
+		return nil, err
+	}
+	return &rpcTypes.BroadcastTxResponse{Hash: hex.EncodeToString(crypto.SHA3Hash(req.GetTx()))}, nil
+}
+
+func (g *grpcServer) BroadcastTxAsync(_ context.Context, req *rpcTypes.BroadcastTxRequest) (*rpcTypes.BroadcastTxResponse, error) {
+	if len(req.GetTx()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "tx is required")
+	}
+	txHash, err := g.checkTxHash(req.GetTx())
+	if err != nil {
+		if _, ok := err.(coreTypes.Error); ok {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	go g.handleTxAsync(req.GetTx(), txHash)
+
+	return &rpcTypes.BroadcastTxResponse{Hash: txHash}, nil
+}
+
+// BroadcastTxCommit responds with an uncommitted transaction instead of an error if it is not committed before the timeout
+func (g *grpcServer) BroadcastTxCommit(ctx context.Context, req *rpcTypes.BroadcastTxRequest) (*rpcTypes.BroadcastTxResponse, error) {
+	if err := g.handleGRPCTx(req.GetTx()); err != nil {
+		return nil, err
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, g.getBroadcastTxCommitTimeout())
+	defer cancel()
+
+	txHash := hex.EncodeToString(crypto.SHA3Hash(req.GetTx()))
+	idxTx, err := g.waitForTxCommit(waitCtx, req.GetTx())
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return &rpcTypes.BroadcastTxResponse{Hash: txHash}, nil
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &rpcTypes.BroadcastTxResponse{
+		Hash:      txHash,
+		Committed: true,
+		TxResult:  idxTx,
+	}, nil
+}
+
+func (g *grpcServer) SimulateTx(_ context.Context, req *rpcTypes.SimulateTxRequest) (*coreTypes.TxSimulation, error) {
+	if len(req.GetTx()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "tx is required")
+	}
+	simulation, err := g.GetBus().GetUtilityModule().SimulateTransaction(req.GetTx(), req.GetSignerAddr())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return simulation, nil
+}
+
+func (g *grpcServer) GetSession(_ context.Context, req *rpcTypes.SessionRequest) (*coreTypes.Session, error) {
+	session, err := g.GetBus().GetUtilityModule().GetSession(req.GetAppAddress(), req.GetSessionHeight(), req.GetChain(), req.GetGeozone())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return session, nil
+}
+
+func (g *grpcServer) Relay(_ context.Context, relay *coreTypes.Relay) (*coreTypes.RelayResponse, error) {
+	utility := g.GetBus().GetUtilityModule()
+	if _, err := utility.GetServicerModule(); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, "node is not a servicer")
+	}
+	relayResponse, err := utility.HandleRelay(relay)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return relayResponse, nil
+}
+
+// resolveGRPCQueryHeight resolves the block selected by a gRPC request with `resolveQueryHeight`.
+// An empty block hash and a nil timestamp are treated as not supplied.
+func (g *grpcServer) resolveGRPCQueryHeight(height int64, blockHash string, timestamp *timestamppb.Timestamp) (int64, error) {
+	var blockHashPtr *string
+	if blockHash != "" {
+		blockHashPtr = &blockHash
+	}
+	var timestampPtr *time.Time
+	if timestamp != nil {
+		t := timestamp.AsTime()
+		timestampPtr = &t
+	}
+	resolvedHeight, httpErr := g.resolveQueryHeight(height, blockHashPtr, timestampPtr)
+	if httpErr != nil {
+		return 0, grpcError(httpErr)
+	}
+	return resolvedHeight, nil
+}
+
+func (g *grpcServer) getGRPCBlock(height int64) (*coreTypes.Block, error) {
+	block, err := g.GetBus().GetPersistenceModule().GetBlockStore().GetBlock(uint64(height))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return block, nil
+}
+
+func (g *grpcServer) getGRPCAccountAmount(req *rpcTypes.QueryAddressRequest) (string, error) {
+	accBz, err := hex.DecodeString(req.GetAddress())
+	if err != nil {
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	}
+	height, err := g.resolveGRPCQueryHeight(req.GetHeight(), req.GetBlockHash(), req.GetTimestamp())
+	if err != nil {
+		return "", err
+	}
+	readCtx, err := g.GetBus().GetPersistenceModule().NewReadContext(height)
+	if err != nil {
+		return "", status.Error(codes.Internal, err.Error())
+	}
+	defer readCtx.Release() //nolint:errcheck // We only need to make sure the readCtx is released
+
+	amount, err := readCtx.GetAccountAmount(accBz, height)
+	if err != nil {
+		return "", status.Error(codes.Internal, err.Error())
+	}
+	return amount, nil
+}
+
+func (g *grpcServer) getGRPCActor(actorType coreTypes.ActorType, req *rpcTypes.QueryAddressRequest) (*coreTypes.Actor, error) {
+	addrBz, err := hex.DecodeString(req.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	height, err := g.resolveGRPCQueryHeight(req.GetHeight(), req.GetBlockHash(), req.GetTimestamp())
+	if err != nil {
+		return nil, err
+	}
+	readCtx, err := g.GetBus().GetPersistenceModule().NewReadContext(height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer readCtx.Release() //nolint:errcheck // We only need to make sure the readCtx is released
+
+	var getActor func(address []byte, height int64) (*coreTypes.Actor, error)
+	switch actorType {
+	case coreTypes.ActorType_ACTOR_TYPE_APP:
+		getActor = readCtx.GetApp
+	case coreTypes.ActorType_ACTOR_TYPE_FISH:
+		getActor = readCtx.GetFisherman
+	case coreTypes.ActorType_ACTOR_TYPE_SERVICER:
+		getActor = readCtx.GetServicer
+	case coreTypes.ActorType_ACTOR_TYPE_VAL:
+		getActor = readCtx.GetValidator
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported actor type: %s", actorType)
+	}
+	actor, err := getActor(addrBz, height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return actor, nil
+}
+
+func (g *grpcServer) getGRPCActorsPage(actorType coreTypes.ActorType, req *rpcTypes.QueryActorsRequest) (*rpcTypes.QueryActorsResponse, error) {
+	body := &QueryActorsPaginated{
+		Height:        req.GetHeight(),
+		Page:          req.GetPage(),
+		PerPage:       req.GetPerPage(),
+		BlockHash:     optionalString(req.GetBlockHash()),
+		Status:        optionalString(req.GetStatus()),
+		Chain:         optionalString(req.GetChain()),
+		MinStake:      optionalString(req.GetMinStake()),
+		OutputAddress: optionalString(req.GetOutputAddress()),
+	}
+	if req.GetTimestamp() != nil {
+		timestamp := req.GetTimestamp().AsTime()
+		body.Timestamp = &timestamp
+	}
+
+	actors, total, totalPages, httpErr := g.getActorsPage(actorType, body)
+	if httpErr != nil {
+		return nil, grpcError(httpErr)
+	}
+	if total == 0 {
+		return &rpcTypes.QueryActorsResponse{}, nil
+	}
+	return &rpcTypes.QueryActorsResponse{
+		Actors:      actors,
+		TotalActors: int64(total),
+		Page:        req.GetPage(),
+		TotalPages:  int64(totalPages),
+	}, nil
+}
+
+// handleGRPCTx validates the transaction, adds it to the mempool and broadcasts it to the rest of the network
+func (g *grpcServer) handleGRPCTx(txBz []byte) error {
+	if len(txBz) == 0 {
+		return status.Error(codes.InvalidArgument, "tx is required")
+	}
+	if err := g.GetBus().GetUtilityModule().HandleTransaction(txBz); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if err := g.broadcastMessage(txBz); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// optionalString returns nil for an empty string, i.e. a proto3 string field that is not set
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
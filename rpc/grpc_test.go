@@ -0,0 +1,177 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/pokt-network/pocket/logger"
+	rpcTypes "github.com/pokt-network/pocket/rpc/types"
+	"github.com/pokt-network/pocket/runtime/configs"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/modules"
+	mockModules "github.com/pokt-network/pocket/shared/modules/mocks"
+	"github.com/pokt-network/pocket/telemetry"
+)
+
+// restOnlyRoutes are the REST routes that are deliberately not exposed over gRPC
+var restOnlyRoutes = map[string]string{
+	"/v1/health":                         "served by the standard gRPC health checks",
+	"/v1/version":                        "node metadata rather than chain data",
+	"/v1/subscribe":                      "WebSocket subscriptions",
+	"/v1/consensus/state":                "debug route",
+	"/v1/p2p/staked_actors_address_book": "debug route",
+	"/v1/client/challenge":               "responds with the v0 challenge types",
+	"/v1/query/proof":                    "responds with hex encoded ICS23 proofs",
+	"/v1/query/account_txs":              "responds with the hydrated REST transactions",
+	"/v1/query/block_txs":                "responds with the hydrated REST transactions",
+	"/v1/query/unconfirmed_txs":          "responds with the hydrated REST transactions",
+}
+
+// TestGRPCRoutes_RESTParity checks that the `google.api.http` annotations of the gRPC methods, which grpc-gateway
+// would serve, match the REST routes and that every REST route is either exposed over gRPC or listed in `restOnlyRoutes`.
+func TestGRPCRoutes_RESTParity(t *testing.T) {
+	e := echo.New()
+	RegisterHandlers(e, &rpcServer{})
+	restRoutes := make(map[string]string)
+	for _, route := range e.Routes() {
+		restRoutes[route.Path] = route.Method
+	}
+
+	grpcRoutes := getGRPCRoutes()
+	services := rpcTypes.File_rpc_proto.Services()
+	numMethods := 0
+	for i := 0; i < services.Len(); i++ {
+		numMethods += services.Get(i).Methods().Len()
+	}
+	require.Len(t, grpcRoutes, numMethods, "every gRPC method must be annotated with the REST route it mirrors")
+
+	exposed := make(map[string]string)
+	for fullMethod, route := range grpcRoutes {
+		httpMethod, ok := restRoutes[route.path]
+		require.True(t, ok, "%s is annotated with %s which is not a REST route", fullMethod, route.path)
+		require.Equal(t, httpMethod, route.httpMethod, "%s is annotated with the wrong HTTP method", fullMethod)
+		require.NotContains(t, exposed, route.path, "%s and %s mirror the same REST route", fullMethod, exposed[route.path])
+		exposed[route.path] = fullMethod
+	}
+
+	for path := range restRoutes {
+		_, isExposed := exposed[path]
+		_, isRESTOnly := restOnlyRoutes[path]
+		require.True(t, isExposed != isRESTOnly, "%s must either be exposed over gRPC or listed in restOnlyRoutes", path)
+	}
+	for path := range restOnlyRoutes {
+		require.Contains(t, restRoutes, path, "%s is not a REST route anymore", path)
+	}
+}
+
+func TestGRPCServer(t *testing.T) {
+	txBz := []byte("tx")
+
+	ctrl := gomock.NewController(t)
+	consensusMock := mockModules.NewMockConsensusModule(ctrl)
+	consensusMock.EXPECT().CurrentHeight().Return(uint64(10)).AnyTimes()
+	utilityMock := mockModules.NewMockUtilityModule(ctrl)
+	utilityMock.EXPECT().SimulateTransaction(txBz, nil).Return(&coreTypes.TxSimulation{
+		Height:      9,
+		MessageType: "MessageSend",
+		Fee:         "10000",
+	}, nil).AnyTimes()
+	timeSeriesAgentMock := mockModules.NewMockTimeSeriesAgent(ctrl)
+	timeSeriesAgentMock.EXPECT().CounterIncrement(telemetry.RPC_REQUESTS_REJECTED_TIMESERIES_METRIC_NAME).Times(3)
+	eventMetricsAgentMock := mockModules.NewMockEventMetricsAgent(ctrl)
+	eventMetricsAgentMock.EXPECT().EmitEvent(telemetry.RPC_EVENT_METRICS_NAMESPACE, telemetry.RPC_REQUEST_REJECTED_EVENT_METRIC_NAME, gomock.Any()).Times(3)
+	telemetryMock := mockModules.NewMockTelemetryModule(ctrl)
+	telemetryMock.EXPECT().GetTimeSeriesAgent().Return(timeSeriesAgentMock).AnyTimes()
+	telemetryMock.EXPECT().GetEventMetricsAgent().Return(eventMetricsAgentMock).AnyTimes()
+
+	busMock := mockModules.NewMockBus(ctrl)
+	busMock.EXPECT().GetConsensusModule().Return(consensusMock).AnyTimes()
+	busMock.EXPECT().GetUtilityModule().Return(utilityMock).AnyTimes()
+	busMock.EXPECT().GetTelemetryModule().Return(telemetryMock).AnyTimes()
+
+	s := NewRPCServer(busMock)
+	s.logger = *logger.Global.CreateLoggerForModule(modules.RPCModuleName)
+	s.accessControl, _ = newAccessController(&configs.RPCConfig{
+		Auth: &configs.RPCAuthConfig{
+			Enabled:      true,
+			PublicScopes: []string{"query"},
+			ApiKeys:      []*configs.RPCAPIKey{{Name: "broadcaster", Key: "broadcast-key", Scopes: []string{"broadcast"}}},
+		},
+	})
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := s.newGRPCServer(true)
+	go server.Serve(listener) //nolint:errcheck // The server is stopped at the end of the test
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	ctx := context.Background()
+
+	t.Run("query", func(t *testing.T) {
+		res, err := rpcTypes.NewQueryServiceClient(conn).GetHeight(ctx, &rpcTypes.QueryHeightRequest{})
+		require.NoError(t, err)
+		require.Equal(t, int64(9), res.GetHeight())
+	})
+
+	t.Run("errors are converted to gRPC codes", func(t *testing.T) {
+		_, err := rpcTypes.NewQueryServiceClient(conn).GetBlock(ctx, &rpcTypes.QueryHeightRequest{Height: 1, BlockHash: "00"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("broadcast requires the broadcast scope", func(t *testing.T) {
+		client := rpcTypes.NewBroadcastServiceClient(conn)
+		_, err := client.BroadcastTxSync(ctx, &rpcTypes.BroadcastTxRequest{Tx: txBz})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		_, err = client.BroadcastTxSync(metadata.AppendToOutgoingContext(ctx, "x-api-key", "invalid-key"), &rpcTypes.BroadcastTxRequest{Tx: txBz})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		keyCtx := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer broadcast-key")
+		simulation, err := client.SimulateTx(keyCtx, &rpcTypes.SimulateTxRequest{Tx: txBz})
+		require.Equal(t, codes.PermissionDenied, status.Code(err), "simulate_tx is in the query scope")
+		require.Nil(t, simulation)
+
+		_, err = client.BroadcastTxAsync(keyCtx, &rpcTypes.BroadcastTxRequest{})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("native messages", func(t *testing.T) {
+		simulation, err := rpcTypes.NewBroadcastServiceClient(conn).SimulateTx(ctx, &rpcTypes.SimulateTxRequest{Tx: txBz})
+		require.NoError(t, err)
+		require.Equal(t, "10000", simulation.GetFee())
+		require.Equal(t, int64(9), simulation.GetHeight())
+	})
+
+	t.Run("reflection", func(t *testing.T) {
+		stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(&reflectionpb.ServerReflectionRequest{
+			MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+		}))
+		res, err := stream.Recv()
+		require.NoError(t, err)
+
+		services := make([]string, 0)
+		for _, service := range res.GetListServicesResponse().GetService() {
+			services = append(services, service.GetName())
+		}
+		require.Subset(t, services, []string{"rpc.QueryService", "rpc.BroadcastService", "rpc.SessionService", "rpc.RelayService", "grpc.health.v1.Health"})
+	})
+}
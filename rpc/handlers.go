@@ -1,12 +1,15 @@
 package rpc
 
 import (
+	"context"
 	"encoding/hex"
+	"errors"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
 	"github.com/pokt-network/pocket/app"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
 )
 
 // CONSIDER: Remove all the V1 prefixes from the RPC module
@@ -43,6 +46,113 @@ func (s *rpcServer) PostV1ClientBroadcastTxSync(ctx echo.Context) error {
 	return nil
 }
 
+func (s *rpcServer) PostV1ClientBroadcastTxAsync(ctx echo.Context) error {
+	txParams := new(RawTXRequest)
+	if err := ctx.Bind(txParams); err != nil {
+		return ctx.String(http.StatusBadRequest, "bad request")
+	}
+
+	txBz, err := hex.DecodeString(txParams.RawHexBytes)
+	if err != nil {
+		return ctx.String(http.StatusBadRequest, "cannot decode tx bytes")
+	}
+
+	txHash, err := s.checkTxHash(txBz)
+	if err != nil {
+		if _, ok := err.(coreTypes.Error); ok {
+			return ctx.String(http.StatusBadRequest, err.Error())
+		}
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+
+	go s.handleTxAsync(txBz, txHash)
+
+	return ctx.JSON(http.StatusOK, BroadcastTXResponse{Hash: txHash})
+}
+
+func (s *rpcServer) PostV1ClientBroadcastTxCommit(ctx echo.Context) error {
+	txParams := new(RawTXRequest)
+	if err := ctx.Bind(txParams); err != nil {
+		return ctx.String(http.StatusBadRequest, "bad request")
+	}
+
+	txBz, err := hex.DecodeString(txParams.RawHexBytes)
+	if err != nil {
+		return ctx.String(http.StatusBadRequest, "cannot decode tx bytes")
+	}
+
+	// Validate the transaction and add it to the mempool
+	if err := s.GetBus().GetUtilityModule().HandleTransaction(txBz); err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+
+	// Broadcast the transaction to the rest of the network if it passed the basic validation above
+	if err := s.broadcastMessage(txBz); err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx.Request().Context(), s.getBroadcastTxCommitTimeout())
+	defer cancel()
+
+	txHash := hex.EncodeToString(crypto.SHA3Hash(txBz))
+	idxTx, err := s.waitForTxCommit(waitCtx, txBz)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return ctx.JSON(http.StatusAccepted, BroadcastTXCommitResponse{Hash: txHash})
+		}
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+
+	height, index, resultCode, txError := idxTx.GetHeight(), idxTx.GetIndex(), idxTx.GetResultCode(), idxTx.GetError()
+	return ctx.JSON(http.StatusOK, BroadcastTXCommitResponse{
+		Hash:       txHash,
+		Committed:  true,
+		Height:     &height,
+		Index:      &index,
+		ResultCode: &resultCode,
+		Error:      &txError,
+	})
+}
+
+func (s *rpcServer) PostV1ClientSimulateTx(ctx echo.Context) error {
+	var body SimulateTXRequest
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.String(http.StatusBadRequest, "bad request")
+	}
+
+	txBz, err := hex.DecodeString(body.RawHexBytes)
+	if err != nil {
+		return ctx.String(http.StatusBadRequest, "cannot decode tx bytes")
+	}
+	var signer []byte
+	if body.SignerAddr != nil && *body.SignerAddr != "" {
+		signer, err = hex.DecodeString(*body.SignerAddr)
+		if err != nil {
+			return ctx.String(http.StatusBadRequest, "cannot decode signer address")
+		}
+	}
+
+	simulation, err := s.GetBus().GetUtilityModule().SimulateTransaction(txBz, signer)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+
+	signerCandidates := simulation.GetSignerCandidates()
+	if signerCandidates == nil {
+		signerCandidates = make([]string, 0)
+	}
+	return ctx.JSON(http.StatusOK, SimulateTXResponse{
+		Height:            simulation.GetHeight(),
+		MessageType:       simulation.GetMessageType(),
+		Fee:               simulation.GetFee(),
+		SignerAddr:        simulation.GetSignerAddr(),
+		SignerCandidates:  signerCandidates,
+		IsSignerCandidate: simulation.GetIsSignerCandidate(),
+		ResultCode:        simulation.GetResultCode(),
+		Error:             simulation.GetError(),
+	})
+}
+
 func (s *rpcServer) PostV1ClientGetSession(ctx echo.Context) error {
 	var body SessionRequest
 	if err := ctx.Bind(&body); err != nil {
@@ -95,27 +205,7 @@ func (s *rpcServer) PostV1ClientRelay(ctx echo.Context) error {
 		return ctx.String(http.StatusBadRequest, "bad request")
 	}
 
-	// Parse body into the protobuf messages
-	chain := &coreTypes.Identifiable{
-		Id:   body.Meta.Chain.Id,
-		Name: body.Meta.Chain.Name,
-	}
-	geozone := &coreTypes.Identifiable{
-		Id:   body.Meta.Geozone.Id,
-		Name: body.Meta.Geozone.Name,
-	}
-	relayMeta := &coreTypes.RelayMeta{
-		BlockHeight:       body.Meta.BlockHeight,
-		ServicerPublicKey: body.Meta.ServicerPubKey,
-		RelayChain:        chain,
-		GeoZone:           geozone,
-		Signature:         body.Meta.Signature,
-	}
-
-	relayRequest := buildJsonRPCRelayPayload(&body)
-	relayRequest.Meta = relayMeta
-
-	relayResponse, err := utility.HandleRelay(relayRequest)
+	relayResponse, err := utility.HandleRelay(RelayFromRequest(&body))
 	if err != nil {
 		return ctx.String(http.StatusInternalServerError, err.Error())
 	}
@@ -216,6 +306,29 @@ func (s *rpcServer) GetV1P2pStakedActorsAddressBook(ctx echo.Context, params Get
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// RelayFromRequest parses the body of a relay request into the protobuf relay served by the servicer.
+// The client uses it to rebuild the relay the servicer signed along with its response.
+func RelayFromRequest(body *RelayRequest) *coreTypes.Relay {
+	chain := &coreTypes.Identifiable{
+		Id:   body.Meta.Chain.Id,
+		Name: body.Meta.Chain.Name,
+	}
+	geozone := &coreTypes.Identifiable{
+		Id:   body.Meta.Geozone.Id,
+		Name: body.Meta.Geozone.Name,
+	}
+
+	relay := buildJsonRPCRelayPayload(body)
+	relay.Meta = &coreTypes.RelayMeta{
+		BlockHeight:       body.Meta.BlockHeight,
+		ServicerPublicKey: body.Meta.ServicerPubKey,
+		RelayChain:        chain,
+		GeoZone:           geozone,
+		Signature:         body.Meta.Signature,
+	}
+	return relay
+}
+
 // TECHDEBT: handle other relay payload types, e.g. JSON, GRPC, etc.
 func buildJsonRPCRelayPayload(body *RelayRequest) *coreTypes.Relay {
 	payload := &coreTypes.Relay_JsonRpcPayload{
@@ -1,6 +1,8 @@
 package rpc
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -9,7 +11,10 @@ import (
 	"strconv"
 
 	"github.com/labstack/echo/v4"
+	ibcStore "github.com/pokt-network/pocket/ibc/store"
+	"github.com/pokt-network/pocket/persistence/trees"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/smt"
 )
 
 // This file contains the handlers for the v1/query path in the RPC specification
@@ -26,7 +31,10 @@ func (s *rpcServer) PostV1QueryAccount(ctx echo.Context) error {
 		return ctx.String(http.StatusBadRequest, "bad request")
 	}
 
-	height := s.getQueryHeight(body.Height)
+	height, httpErr := s.resolveQueryHeight(body.Height, body.BlockHash, body.Timestamp)
+	if httpErr != nil {
+		return ctx.String(httpErr.Code, fmt.Sprint(httpErr.Message))
+	}
 	readCtx, err := s.GetBus().GetPersistenceModule().NewReadContext(height)
 	if err != nil {
 		return ctx.String(http.StatusInternalServerError, err.Error())
@@ -54,28 +62,36 @@ func (s *rpcServer) PostV1QueryAccounts(ctx echo.Context) error {
 		return ctx.String(http.StatusBadRequest, "bad request")
 	}
 
-	height := s.getQueryHeight(body.Height)
+	offset, limit, err := getPageOffset(body.Page, body.PerPage)
+	if err != nil {
+		return ctx.String(http.StatusBadRequest, err.Error())
+	}
+
+	height, httpErr := s.resolveQueryHeight(body.Height, body.BlockHash, body.Timestamp)
+	if httpErr != nil {
+		return ctx.String(httpErr.Code, fmt.Sprint(httpErr.Message))
+	}
 	readCtx, err := s.GetBus().GetPersistenceModule().NewReadContext(height)
 	if err != nil {
 		return ctx.String(http.StatusInternalServerError, err.Error())
 	}
 	defer readCtx.Release() //nolint:errcheck // We only need to make sure the readCtx is released
 
-	allAccounts, err := readCtx.GetAllAccounts(height)
+	pageAccounts, totalAccounts, err := readCtx.GetAccountsPaginated(height, offset, limit)
 	if err != nil {
 		return ctx.String(http.StatusInternalServerError, err.Error())
 	}
-
-	start, end, totalPages, err := getPageIndexes(len(allAccounts), int(body.Page), int(body.PerPage))
-	if err != nil && !errors.Is(err, errNoItems) {
-		return ctx.String(http.StatusBadRequest, err.Error())
-	}
-	if totalPages == 0 || errors.Is(err, errNoItems) {
+	if totalAccounts == 0 {
 		return ctx.JSON(http.StatusOK, QueryAccountsResponse{})
 	}
 
+	totalPages := getTotalPages(totalAccounts, limit)
+	if offset >= uint64(totalAccounts) {
+		return ctx.String(http.StatusBadRequest, fmt.Sprintf("starting page too high: got %d, total pages: %d", body.Page, totalPages))
+	}
+
 	accounts := make([]Account, 0)
-	for _, account := range allAccounts[start : end+1] {
+	for _, account := range pageAccounts {
 		accounts = append(accounts, Account{
 			Address: account.Address,
 			Coins:   []Coin{{Amount: account.Amount, Denom: denom}},
@@ -155,7 +171,10 @@ func (s *rpcServer) PostV1QueryApp(ctx echo.Context) error {
 		return ctx.String(http.StatusBadRequest, "bad request")
 	}
 
-	height := s.getQueryHeight(body.Height)
+	height, httpErr := s.resolveQueryHeight(body.Height, body.BlockHash, body.Timestamp)
+	if httpErr != nil {
+		return ctx.String(httpErr.Code, fmt.Sprint(httpErr.Message))
+	}
 	readCtx, err := s.GetBus().GetPersistenceModule().NewReadContext(height)
 	if err != nil {
 		return ctx.String(http.StatusInternalServerError, err.Error())
@@ -176,36 +195,22 @@ func (s *rpcServer) PostV1QueryApp(ctx echo.Context) error {
 }
 
 func (s *rpcServer) PostV1QueryApps(ctx echo.Context) error {
-	var body QueryHeightPaginated
+	var body QueryActorsPaginated
 	if err := ctx.Bind(&body); err != nil {
 		return ctx.String(http.StatusBadRequest, "bad request")
 	}
 
-	height := s.getQueryHeight(body.Height)
-	readCtx, err := s.GetBus().GetPersistenceModule().NewReadContext(height)
-	if err != nil {
-		return ctx.String(http.StatusInternalServerError, err.Error())
-	}
-	defer readCtx.Release() //nolint:errcheck // We only need to make sure the readCtx is released
-
-	allApps, err := readCtx.GetAllApps(height)
-	if err != nil {
-		return ctx.String(http.StatusInternalServerError, err.Error())
-	}
-
-	start, end, totalPages, err := getPageIndexes(len(allApps), int(body.Page), int(body.PerPage))
-	if err != nil && !errors.Is(err, errNoItems) {
-		return ctx.String(http.StatusBadRequest, err.Error())
+	actors, total, totalPages, httpErr := s.getActorsPage(coreTypes.ActorType_ACTOR_TYPE_APP, &body)
+	if httpErr != nil {
+		return ctx.String(httpErr.Code, fmt.Sprint(httpErr.Message))
 	}
-	if totalPages == 0 || errors.Is(err, errNoItems) {
+	if total == 0 {
 		return ctx.JSON(http.StatusOK, QueryAppsResponse{})
 	}
 
-	rpcApps := protocolActorToRPCProtocolActors(allApps[start : end+1])
-
 	return ctx.JSON(http.StatusOK, QueryAppsResponse{
-		Apps:       rpcApps,
-		TotalApps:  int64(len(allApps)),
+		Apps:       protocolActorToRPCProtocolActors(actors),
+		TotalApps:  int64(total),
 		Page:       body.Page,
 		TotalPages: int64(totalPages),
 	})
@@ -217,7 +222,10 @@ func (s *rpcServer) PostV1QueryBalance(ctx echo.Context) error {
 		return ctx.String(http.StatusBadRequest, "bad request")
 	}
 
-	height := s.getQueryHeight(body.Height)
+	height, httpErr := s.resolveQueryHeight(body.Height, body.BlockHash, body.Timestamp)
+	if httpErr != nil {
+		return ctx.String(httpErr.Code, fmt.Sprint(httpErr.Message))
+	}
 	readCtx, err := s.GetBus().GetPersistenceModule().NewReadContext(height)
 	if err != nil {
 		return ctx.String(http.StatusInternalServerError, err.Error())
@@ -242,13 +250,52 @@ func (s *rpcServer) PostV1QueryBalance(ctx echo.Context) error {
 	})
 }
 
+func (s *rpcServer) PostV1QueryDelegations(ctx echo.Context) error {
+	var body QueryAccountHeight
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.String(http.StatusBadRequest, "bad request")
+	}
+
+	height, httpErr := s.resolveQueryHeight(body.Height, body.BlockHash, body.Timestamp)
+	if httpErr != nil {
+		return ctx.String(httpErr.Code, fmt.Sprint(httpErr.Message))
+	}
+	readCtx, err := s.GetBus().GetPersistenceModule().NewReadContext(height)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	defer readCtx.Release() //nolint:errcheck // We only need to make sure the readCtx is released
+
+	accBz, err := hex.DecodeString(body.Address)
+	if err != nil {
+		return ctx.String(http.StatusBadRequest, err.Error())
+	}
+	delegations, err := readCtx.GetDelegations(accBz, height)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	unbondings, err := readCtx.GetUnbondingDelegations(accBz, height)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+
+	return ctx.JSON(http.StatusOK, QueryDelegationsResponse{
+		Delegations:          protocolDelegationsToRPCDelegations(delegations),
+		UnbondingDelegations: protocolUnbondingDelegationsToRPCUnbondingDelegations(unbondings),
+	})
+}
+
 func (s *rpcServer) PostV1QueryBlock(ctx echo.Context) error {
 	var body QueryHeight
 	if err := ctx.Bind(&body); err != nil {
 		return ctx.String(http.StatusBadRequest, "bad request")
 	}
 
-	height := uint64(s.getQueryHeight(body.Height))
+	queryHeight, httpErr := s.resolveQueryHeight(body.Height, body.BlockHash, body.Timestamp)
+	if httpErr != nil {
+		return ctx.String(httpErr.Code, fmt.Sprint(httpErr.Message))
+	}
+	height := uint64(queryHeight)
 	blockStore := s.GetBus().GetPersistenceModule().GetBlockStore()
 	block, err := blockStore.GetBlock(height)
 	if err != nil {
@@ -262,6 +309,28 @@ func (s *rpcServer) PostV1QueryBlock(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, rpcBlock)
 }
 
+func (s *rpcServer) PostV1QueryBlockByHash(ctx echo.Context) error {
+	var body QueryHash
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.String(http.StatusBadRequest, "bad request")
+	}
+
+	height, httpErr := s.resolveQueryHeight(0, &body.Hash, nil)
+	if httpErr != nil {
+		return ctx.String(httpErr.Code, fmt.Sprint(httpErr.Message))
+	}
+	block, err := s.GetBus().GetPersistenceModule().GetBlockStore().GetBlock(uint64(height))
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	rpcBlock, err := s.blockToRPCBlock(block)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+
+	return ctx.JSON(http.StatusOK, rpcBlock)
+}
+
 func (s *rpcServer) PostV1QueryBlockTxs(ctx echo.Context) error {
 	var body QueryHeightPaginated
 	if err := ctx.Bind(&body); err != nil {
@@ -269,7 +338,11 @@ func (s *rpcServer) PostV1QueryBlockTxs(ctx echo.Context) error {
 	}
 	sortDesc := checkSortDesc(*body.Sort)
 
-	height := uint64(s.getQueryHeight(body.Height))
+	queryHeight, httpErr := s.resolveQueryHeight(body.Height, body.BlockHash, body.Timestamp)
+	if httpErr != nil {
+		return ctx.String(httpErr.Code, fmt.Sprint(httpErr.Message))
+	}
+	height := uint64(queryHeight)
 	blockStore := s.GetBus().GetPersistenceModule().GetBlockStore()
 	block, err := blockStore.GetBlock(height)
 	if err != nil {
@@ -309,7 +382,10 @@ func (s *rpcServer) PostV1QueryFisherman(ctx echo.Context) error {
 		return ctx.String(http.StatusBadRequest, "bad request")
 	}
 
-	height := s.getQueryHeight(body.Height)
+	height, httpErr := s.resolveQueryHeight(body.Height, body.BlockHash, body.Timestamp)
+	if httpErr != nil {
+		return ctx.String(httpErr.Code, fmt.Sprint(httpErr.Message))
+	}
 	readCtx, err := s.GetBus().GetPersistenceModule().NewReadContext(height)
 	if err != nil {
 		return ctx.String(http.StatusInternalServerError, err.Error())
@@ -330,36 +406,22 @@ func (s *rpcServer) PostV1QueryFisherman(ctx echo.Context) error {
 }
 
 func (s *rpcServer) PostV1QueryFishermen(ctx echo.Context) error {
-	var body QueryHeightPaginated
+	var body QueryActorsPaginated
 	if err := ctx.Bind(&body); err != nil {
 		return ctx.String(http.StatusBadRequest, "bad request")
 	}
 
-	height := s.getQueryHeight(body.Height)
-	readCtx, err := s.GetBus().GetPersistenceModule().NewReadContext(height)
-	if err != nil {
-		return ctx.String(http.StatusInternalServerError, err.Error())
-	}
-	defer readCtx.Release() //nolint:errcheck // We only need to make sure the readCtx is released
-
-	allFishermen, err := readCtx.GetAllFishermen(height)
-	if err != nil {
-		return ctx.String(http.StatusInternalServerError, err.Error())
+	actors, total, totalPages, httpErr := s.getActorsPage(coreTypes.ActorType_ACTOR_TYPE_FISH, &body)
+	if httpErr != nil {
+		return ctx.String(httpErr.Code, fmt.Sprint(httpErr.Message))
 	}
-
-	start, end, totalPages, err := getPageIndexes(len(allFishermen), int(body.Page), int(body.PerPage))
-	if err != nil && !errors.Is(err, errNoItems) {
-		return ctx.String(http.StatusBadRequest, err.Error())
-	}
-	if totalPages == 0 || errors.Is(err, errNoItems) {
+	if total == 0 {
 		return ctx.JSON(http.StatusOK, QueryFishermenResponse{})
 	}
 
-	rpcFishermen := protocolActorToRPCProtocolActors(allFishermen[start : end+1])
-
 	return ctx.JSON(http.StatusOK, QueryFishermenResponse{
-		Fishermen:      rpcFishermen,
-		TotalFishermen: int64(len(allFishermen)),
+		Fishermen:      protocolActorToRPCProtocolActors(actors),
+		TotalFishermen: int64(total),
 		Page:           body.Page,
 		TotalPages:     int64(totalPages),
 	})
@@ -371,13 +433,38 @@ func (s *rpcServer) GetV1QueryHeight(ctx echo.Context) error {
 	})
 }
 
+func (s *rpcServer) PostV1QueryHeightAtTime(ctx echo.Context) error {
+	var body QueryTimestamp
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.String(http.StatusBadRequest, "bad request")
+	}
+
+	height, httpErr := s.resolveQueryHeight(0, nil, &body.Timestamp)
+	if httpErr != nil {
+		return ctx.String(httpErr.Code, fmt.Sprint(httpErr.Message))
+	}
+	block, err := s.GetBus().GetPersistenceModule().GetBlockStore().GetBlock(uint64(height))
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+
+	return ctx.JSON(http.StatusOK, QueryHeightAtTimeResponse{
+		Height:    height,
+		BlockHash: block.BlockHeader.GetStateHash(),
+		BlockTime: block.BlockHeader.GetTimestamp().AsTime(),
+	})
+}
+
 func (s *rpcServer) PostV1QueryParam(ctx echo.Context) error {
 	var body QueryParameter
 	if err := ctx.Bind(&body); err != nil {
 		return ctx.String(http.StatusBadRequest, "bad request")
 	}
 
-	height := s.getQueryHeight(body.Height)
+	height, httpErr := s.resolveQueryHeight(body.Height, body.BlockHash, body.Timestamp)
+	if httpErr != nil {
+		return ctx.String(httpErr.Code, fmt.Sprint(httpErr.Message))
+	}
 	readCtx, err := s.GetBus().GetPersistenceModule().NewReadContext(height)
 	if err != nil {
 		return ctx.String(http.StatusInternalServerError, err.Error())
@@ -395,63 +482,219 @@ func (s *rpcServer) PostV1QueryParam(ctx echo.Context) error {
 	})
 }
 
-func (s *rpcServer) PostV1QueryServicer(ctx echo.Context) error {
-	var body QueryAccountHeight
+// PostV1QueryProof returns an ICS23 proof for the key in the requested state tree along with the
+// tree roots needed to link it to the state hash of the latest committed block.
+// NB: The TreeStore only holds the latest state so proofs cannot be generated at previous heights.
+func (s *rpcServer) PostV1QueryProof(ctx echo.Context) error {
+	var body QueryProof
 	if err := ctx.Bind(&body); err != nil {
 		return ctx.String(http.StatusBadRequest, "bad request")
 	}
 
-	height := s.getQueryHeight(body.Height)
+	treeStore := s.GetBus().GetTreeStore()
+	treeHashes := treeStore.GetTreeHashes()
+	if _, ok := treeHashes[body.Tree]; !ok {
+		return ctx.String(http.StatusBadRequest, fmt.Sprintf("unknown state tree: %s", body.Tree))
+	}
+	key, err := proofTreeKey(body.Tree, body.Key)
+	if err != nil {
+		return ctx.String(http.StatusBadRequest, err.Error())
+	}
+
+	// Make sure the trees have not been updated past the latest committed block before proving against them
+	height := s.getQueryHeight(0)
+	block, err := s.GetBus().GetPersistenceModule().GetBlockStore().GetBlock(uint64(height))
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	stateRoot, rootNodeStore := treeStore.GetTree(trees.RootTreeName)
+	stateHash := hex.EncodeToString(stateRoot)
+	if stateHash != block.BlockHeader.GetStateHash() {
+		return ctx.String(http.StatusServiceUnavailable, "state trees do not match the latest committed block, retry later")
+	}
+
+	treeRoot, nodeStore := treeStore.GetTree(body.Tree)
+	tree := smt.ImportSparseMerkleTree(nodeStore, sha256.New(), treeRoot)
+	valueHash, proof, err := ibcStore.CreateCommitmentProof(tree, key)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	proofBz, err := proof.Marshal()
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+
+	rootTree := smt.ImportSparseMerkleTree(rootNodeStore, sha256.New(), stateRoot)
+	_, rootProof, err := ibcStore.CreateCommitmentProof(rootTree, []byte(body.Tree))
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	rootProofBz, err := rootProof.Marshal()
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+
+	response := QueryProofResponse{
+		Height:          height,
+		Tree:            body.Tree,
+		Key:             body.Key,
+		Exists:          valueHash != nil,
+		ValueHash:       hex.EncodeToString(valueHash),
+		Proof:           hex.EncodeToString(proofBz),
+		TreeRoot:        hex.EncodeToString(treeRoot),
+		RootProof:       hex.EncodeToString(rootProofBz),
+		StateHash:       stateHash,
+		StateTreeHashes: QueryProofResponse_StateTreeHashes{AdditionalProperties: treeHashes},
+	}
+
+	if response.Exists {
+		value, err := s.getProofValue(body.Tree, key, height)
+		if err != nil {
+			return ctx.String(http.StatusInternalServerError, err.Error())
+		}
+		if value != nil {
+			if digest := sha256.Sum256(value); !bytes.Equal(digest[:], valueHash) {
+				return ctx.String(http.StatusInternalServerError, "value does not match the one committed to the state tree")
+			}
+			hexValue := hex.EncodeToString(value)
+			response.Value = &hexValue
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// PostV1QueryProposal returns the governance proposal with the requested id along with the last vote of each validator on it
+func (s *rpcServer) PostV1QueryProposal(ctx echo.Context) error {
+	var body QueryProposal
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.String(http.StatusBadRequest, "bad request")
+	}
+
+	height, httpErr := s.resolveQueryHeight(body.Height, body.BlockHash, body.Timestamp)
+	if httpErr != nil {
+		return ctx.String(httpErr.Code, fmt.Sprint(httpErr.Message))
+	}
 	readCtx, err := s.GetBus().GetPersistenceModule().NewReadContext(height)
 	if err != nil {
 		return ctx.String(http.StatusInternalServerError, err.Error())
 	}
 	defer readCtx.Release() //nolint:errcheck // We only need to make sure the readCtx is released
 
-	addrBz, err := hex.DecodeString(body.Address)
+	proposal, err := readCtx.GetProposal(uint64(body.Id), height)
 	if err != nil {
+		var coreErr coreTypes.Error
+		if errors.As(err, &coreErr) && coreErr.Code() == coreTypes.CodeProposalNotFoundError {
+			return ctx.String(http.StatusNotFound, err.Error())
+		}
 		return ctx.String(http.StatusInternalServerError, err.Error())
 	}
-	servicer, err := readCtx.GetServicer(addrBz, height)
+	rpcProposal, err := protocolProposalToRPCProposal(proposal)
 	if err != nil {
 		return ctx.String(http.StatusInternalServerError, err.Error())
 	}
 
-	actor := protocolActorToRPCProtocolActor(servicer)
-	return ctx.JSON(http.StatusOK, actor)
+	votes, err := readCtx.GetProposalVotes(proposal.GetId(), height)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	rpcVotes := make([]ProposalVote, 0, len(votes))
+	for _, vote := range votes {
+		rpcVotes = append(rpcVotes, protocolVoteToRPCVote(vote))
+	}
+
+	return ctx.JSON(http.StatusOK, QueryProposalResponse{
+		Proposal: rpcProposal,
+		Votes:    rpcVotes,
+	})
 }
 
-func (s *rpcServer) PostV1QueryServicers(ctx echo.Context) error {
-	var body QueryHeightPaginated
+// PostV1QueryProposals returns the governance proposals with the requested status, or all of them if no status is provided
+func (s *rpcServer) PostV1QueryProposals(ctx echo.Context) error {
+	var body QueryProposals
 	if err := ctx.Bind(&body); err != nil {
 		return ctx.String(http.StatusBadRequest, "bad request")
 	}
+	status, err := rpcProposalStatusEnumToProtocolProposalStatus(body.Status)
+	if err != nil {
+		return ctx.String(http.StatusBadRequest, err.Error())
+	}
 
-	height := s.getQueryHeight(body.Height)
+	height, httpErr := s.resolveQueryHeight(body.Height, body.BlockHash, body.Timestamp)
+	if httpErr != nil {
+		return ctx.String(httpErr.Code, fmt.Sprint(httpErr.Message))
+	}
 	readCtx, err := s.GetBus().GetPersistenceModule().NewReadContext(height)
 	if err != nil {
 		return ctx.String(http.StatusInternalServerError, err.Error())
 	}
 	defer readCtx.Release() //nolint:errcheck // We only need to make sure the readCtx is released
 
-	allServicers, err := readCtx.GetAllServicers(height)
+	proposals, err := readCtx.GetProposals(status, height)
 	if err != nil {
 		return ctx.String(http.StatusInternalServerError, err.Error())
 	}
+	rpcProposals := make([]Proposal, 0, len(proposals))
+	for _, proposal := range proposals {
+		rpcProposal, err := protocolProposalToRPCProposal(proposal)
+		if err != nil {
+			return ctx.String(http.StatusInternalServerError, err.Error())
+		}
+		rpcProposals = append(rpcProposals, rpcProposal)
+	}
 
-	start, end, totalPages, err := getPageIndexes(len(allServicers), int(body.Page), int(body.PerPage))
-	if err != nil && !errors.Is(err, errNoItems) {
-		return ctx.String(http.StatusBadRequest, err.Error())
+	return ctx.JSON(http.StatusOK, QueryProposalsResponse{
+		Proposals:      rpcProposals,
+		TotalProposals: int64(len(rpcProposals)),
+	})
+}
+
+func (s *rpcServer) PostV1QueryServicer(ctx echo.Context) error {
+	var body QueryAccountHeight
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.String(http.StatusBadRequest, "bad request")
 	}
-	if totalPages == 0 || errors.Is(err, errNoItems) {
-		return ctx.JSON(http.StatusOK, QueryServicersResponse{})
+
+	height, httpErr := s.resolveQueryHeight(body.Height, body.BlockHash, body.Timestamp)
+	if httpErr != nil {
+		return ctx.String(httpErr.Code, fmt.Sprint(httpErr.Message))
+	}
+	readCtx, err := s.GetBus().GetPersistenceModule().NewReadContext(height)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	defer readCtx.Release() //nolint:errcheck // We only need to make sure the readCtx is released
+
+	addrBz, err := hex.DecodeString(body.Address)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	servicer, err := readCtx.GetServicer(addrBz, height)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
 	}
 
-	rpcServicers := protocolActorToRPCProtocolActors(allServicers[start : end+1])
+	actor := protocolActorToRPCProtocolActor(servicer)
+	return ctx.JSON(http.StatusOK, actor)
+}
+
+func (s *rpcServer) PostV1QueryServicers(ctx echo.Context) error {
+	var body QueryActorsPaginated
+	if err := ctx.Bind(&body); err != nil {
+		return ctx.String(http.StatusBadRequest, "bad request")
+	}
+
+	actors, total, totalPages, httpErr := s.getActorsPage(coreTypes.ActorType_ACTOR_TYPE_SERVICER, &body)
+	if httpErr != nil {
+		return ctx.String(httpErr.Code, fmt.Sprint(httpErr.Message))
+	}
+	if total == 0 {
+		return ctx.JSON(http.StatusOK, QueryServicersResponse{})
+	}
 
 	return ctx.JSON(http.StatusOK, QueryServicersResponse{
-		Servicers:      rpcServicers,
-		TotalServicers: int64(len(allServicers)),
+		Servicers:      protocolActorToRPCProtocolActors(actors),
+		TotalServicers: int64(total),
 		Page:           body.Page,
 		TotalPages:     int64(totalPages),
 	})
@@ -463,7 +706,10 @@ func (s *rpcServer) PostV1QuerySupply(ctx echo.Context) error {
 		return ctx.String(http.StatusBadRequest, "bad request")
 	}
 
-	height := s.getQueryHeight(body.Height)
+	height, httpErr := s.resolveQueryHeight(body.Height, body.BlockHash, body.Timestamp)
+	if httpErr != nil {
+		return ctx.String(httpErr.Code, fmt.Sprint(httpErr.Message))
+	}
 	readCtx, err := s.GetBus().GetPersistenceModule().NewReadContext(height)
 	if err != nil {
 		return ctx.String(http.StatusInternalServerError, err.Error())
@@ -507,7 +753,10 @@ func (s *rpcServer) PostV1QuerySupportedChains(ctx echo.Context) error {
 		return ctx.String(http.StatusBadRequest, "bad request")
 	}
 
-	height := s.getQueryHeight(body.Height)
+	height, httpErr := s.resolveQueryHeight(body.Height, body.BlockHash, body.Timestamp)
+	if httpErr != nil {
+		return ctx.String(httpErr.Code, fmt.Sprint(httpErr.Message))
+	}
 	readCtx, err := s.GetBus().GetPersistenceModule().NewReadContext(height)
 	if err != nil {
 		return ctx.String(http.StatusInternalServerError, err.Error())
@@ -604,20 +853,29 @@ func (s *rpcServer) PostV1QueryUpgrade(ctx echo.Context) error {
 		return ctx.String(http.StatusBadRequest, "bad request")
 	}
 
-	height := s.getQueryHeight(body.Height)
-	reatCtx, err := s.GetBus().GetPersistenceModule().NewReadContext(height)
+	height, httpErr := s.resolveQueryHeight(body.Height, body.BlockHash, body.Timestamp)
+	if httpErr != nil {
+		return ctx.String(httpErr.Code, fmt.Sprint(httpErr.Message))
+	}
+	readCtx, err := s.GetBus().GetPersistenceModule().NewReadContext(height)
 	if err != nil {
 		return ctx.String(http.StatusInternalServerError, err.Error())
 	}
+	defer readCtx.Release() //nolint:errcheck // We only need to make sure the readCtx is released
 
-	version, err := reatCtx.GetVersionAtHeight(height)
+	version, err := readCtx.GetVersionAtHeight(height)
+	if err != nil {
+		return ctx.String(http.StatusInternalServerError, err.Error())
+	}
+	pending, err := readCtx.GetPendingUpgrade(height)
 	if err != nil {
 		return ctx.String(http.StatusInternalServerError, err.Error())
 	}
 
 	return ctx.JSON(http.StatusOK, QueryUpgradeResponse{
-		Height:  height,
-		Version: version,
+		Height:         height,
+		Version:        version,
+		PendingUpgrade: protocolUpgradeToRPCUpgrade(pending),
 	})
 }
 
@@ -627,7 +885,10 @@ func (s *rpcServer) PostV1QueryValidator(ctx echo.Context) error {
 		return ctx.String(http.StatusBadRequest, "bad request")
 	}
 
-	height := s.getQueryHeight(body.Height)
+	height, httpErr := s.resolveQueryHeight(body.Height, body.BlockHash, body.Timestamp)
+	if httpErr != nil {
+		return ctx.String(httpErr.Code, fmt.Sprint(httpErr.Message))
+	}
 	readCtx, err := s.GetBus().GetPersistenceModule().NewReadContext(height)
 	if err != nil {
 		return ctx.String(http.StatusInternalServerError, err.Error())
@@ -648,36 +909,22 @@ func (s *rpcServer) PostV1QueryValidator(ctx echo.Context) error {
 }
 
 func (s *rpcServer) PostV1QueryValidators(ctx echo.Context) error {
-	var body QueryHeightPaginated
+	var body QueryActorsPaginated
 	if err := ctx.Bind(&body); err != nil {
 		return ctx.String(http.StatusBadRequest, "bad request")
 	}
 
-	height := s.getQueryHeight(body.Height)
-	readCtx, err := s.GetBus().GetPersistenceModule().NewReadContext(height)
-	if err != nil {
-		return ctx.String(http.StatusInternalServerError, err.Error())
-	}
-	defer readCtx.Release() //nolint:errcheck // We only need to make sure the readCtx is released
-
-	allValidators, err := readCtx.GetAllValidators(height)
-	if err != nil {
-		return ctx.String(http.StatusInternalServerError, err.Error())
-	}
-
-	start, end, totalPages, err := getPageIndexes(len(allValidators), int(body.Page), int(body.PerPage))
-	if err != nil && !errors.Is(err, errNoItems) {
-		return ctx.String(http.StatusBadRequest, err.Error())
+	actors, total, totalPages, httpErr := s.getActorsPage(coreTypes.ActorType_ACTOR_TYPE_VAL, &body)
+	if httpErr != nil {
+		return ctx.String(httpErr.Code, fmt.Sprint(httpErr.Message))
 	}
-	if totalPages == 0 || errors.Is(err, errNoItems) {
+	if total == 0 {
 		return ctx.JSON(http.StatusOK, QueryValidatorsResponse{})
 	}
 
-	rpcValidators := protocolActorToRPCProtocolActors(allValidators[start : end+1])
-
 	return ctx.JSON(http.StatusOK, QueryValidatorsResponse{
-		Validators:      rpcValidators,
-		TotalValidators: int64(len(allValidators)),
+		Validators:      protocolActorToRPCProtocolActors(actors),
+		TotalValidators: int64(total),
 		Page:            body.Page,
 		TotalPages:      int64(totalPages),
 	})
@@ -691,3 +938,44 @@ func (s *rpcServer) PostV1QueryNodeRoles(ctx echo.Context) error {
 	}
 	return ctx.JSON(200, QueryNodeRolesResponse{NodeRoles: roles})
 }
+
+// getActorsPage returns the requested page of actors of actorType matching the filters of the request, along with
+// the total number of matching actors and pages. Failures are returned as the HTTP error to respond with.
+func (s *rpcServer) getActorsPage(actorType coreTypes.ActorType, body *QueryActorsPaginated) (actors []*coreTypes.Actor, totalActors, totalPages int, httpErr *echo.HTTPError) {
+	filter, err := toActorQueryFilter(body)
+	if err != nil {
+		return nil, 0, 0, echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if actorType == coreTypes.ActorType_ACTOR_TYPE_VAL && filter.GetChain() != "" {
+		return nil, 0, 0, echo.NewHTTPError(http.StatusBadRequest, "validators cannot be filtered by chain")
+	}
+	offset, limit, err := getPageOffset(body.Page, body.PerPage)
+	if err != nil {
+		return nil, 0, 0, echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	height, httpErr := s.resolveQueryHeight(body.Height, body.BlockHash, body.Timestamp)
+	if httpErr != nil {
+		return nil, 0, 0, httpErr
+	}
+	readCtx, err := s.GetBus().GetPersistenceModule().NewReadContext(height)
+	if err != nil {
+		return nil, 0, 0, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	defer readCtx.Release() //nolint:errcheck // We only need to make sure the readCtx is released
+
+	pageActors, totalActors, err := readCtx.GetActorsPaginated(actorType, height, filter, offset, limit)
+	if err != nil {
+		return nil, 0, 0, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if totalActors == 0 {
+		return nil, 0, 0, nil
+	}
+
+	totalPages = getTotalPages(totalActors, limit)
+	if offset >= uint64(totalActors) {
+		return nil, 0, 0, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("starting page too high: got %d, total pages: %d", body.Page, totalPages))
+	}
+
+	return pageActors, totalActors, totalPages, nil
+}
@@ -0,0 +1,382 @@
+package rpc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	ics23 "github.com/cosmos/ics23/go"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/pokt-network/smt"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ibcStore "github.com/pokt-network/pocket/ibc/store"
+	"github.com/pokt-network/pocket/persistence/kvstore"
+	"github.com/pokt-network/pocket/persistence/trees"
+	mockTypes "github.com/pokt-network/pocket/persistence/types/mocks"
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	mockModules "github.com/pokt-network/pocket/shared/modules/mocks"
+	moduleTypes "github.com/pokt-network/pocket/shared/modules/types"
+)
+
+func TestRPCServer_PostV1QueryProof(t *testing.T) {
+	const (
+		height       = int64(4)
+		address      = "00404a570febd061274f72b50d0a37f611dfe339"
+		unknownAddr  = "00104055c00bed7c983a48aac7dc6335d7c607a7"
+		amount       = "100000000"
+		otherAddress = "00204737d2a165ebe4be3a7d5b0af905b0ea91d8"
+	)
+
+	// Build the account tree and the root tree the same way the TreeStore does
+	accountNodeStore := kvstore.NewMemKVStore()
+	accountTree := smt.NewSparseMerkleTree(accountNodeStore, sha256.New())
+	for _, acc := range []*coreTypes.Account{{Address: address, Amount: amount}, {Address: otherAddress, Amount: "1"}} {
+		addrBz, err := hex.DecodeString(acc.Address)
+		require.NoError(t, err)
+		accBz, err := codec.GetCodec().Marshal(acc)
+		require.NoError(t, err)
+		require.NoError(t, accountTree.Update(addrBz, accBz))
+	}
+	require.NoError(t, accountTree.Commit())
+
+	rootNodeStore := kvstore.NewMemKVStore()
+	rootTree := smt.NewSparseMerkleTree(rootNodeStore, sha256.New())
+	require.NoError(t, rootTree.Update([]byte(trees.AccountTreeName), accountTree.Root()))
+	require.NoError(t, rootTree.Commit())
+	stateHash := hex.EncodeToString(rootTree.Root())
+
+	t.Cleanup(func() {
+		require.NoError(t, accountNodeStore.Stop())
+		require.NoError(t, rootNodeStore.Stop())
+	})
+
+	ctrl := gomock.NewController(t)
+
+	treeStoreMock := mockModules.NewMockTreeStoreModule(ctrl)
+	treeStoreMock.EXPECT().GetTreeHashes().Return(map[string]string{
+		trees.AccountTreeName: hex.EncodeToString(accountTree.Root()),
+	}).AnyTimes()
+	treeStoreMock.EXPECT().GetTree(trees.RootTreeName).Return(rootTree.Root(), rootNodeStore).AnyTimes()
+	treeStoreMock.EXPECT().GetTree(trees.AccountTreeName).Return(accountTree.Root(), accountNodeStore).AnyTimes()
+
+	blockStoreMock := mockTypes.NewMockBlockStore(ctrl)
+	blockStoreMock.EXPECT().GetBlock(uint64(height)).Return(&coreTypes.Block{
+		BlockHeader: &coreTypes.BlockHeader{Height: uint64(height), StateHash: stateHash},
+	}, nil).AnyTimes()
+
+	readCtxMock := mockModules.NewMockPersistenceReadContext(ctrl)
+	readCtxMock.EXPECT().GetAccountAmount(gomock.Any(), height).Return(amount, nil).AnyTimes()
+	readCtxMock.EXPECT().Release().AnyTimes()
+
+	persistenceMock := mockModules.NewMockPersistenceModule(ctrl)
+	persistenceMock.EXPECT().GetBlockStore().Return(blockStoreMock).AnyTimes()
+	persistenceMock.EXPECT().NewReadContext(height).Return(readCtxMock, nil).AnyTimes()
+
+	consensusMock := mockModules.NewMockConsensusModule(ctrl)
+	consensusMock.EXPECT().CurrentHeight().Return(uint64(height + 1)).AnyTimes()
+
+	busMock := mockModules.NewMockBus(ctrl)
+	busMock.EXPECT().GetTreeStore().Return(treeStoreMock).AnyTimes()
+	busMock.EXPECT().GetPersistenceModule().Return(persistenceMock).AnyTimes()
+	busMock.EXPECT().GetConsensusModule().Return(consensusMock).AnyTimes()
+
+	s := NewRPCServer(busMock)
+	e := echo.New()
+
+	queryProof := func(t *testing.T, tree, key string) *httptest.ResponseRecorder {
+		t.Helper()
+		reqBody, err := json.Marshal(QueryProof{Tree: tree, Key: key})
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, "/v1/query/proof", strings.NewReader(string(reqBody)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		require.NoError(t, s.PostV1QueryProof(e.NewContext(req, rec)))
+		return rec
+	}
+
+	decodeProof := func(t *testing.T, hexProof string) *ics23.CommitmentProof {
+		t.Helper()
+		proofBz, err := hex.DecodeString(hexProof)
+		require.NoError(t, err)
+		proof := new(ics23.CommitmentProof)
+		require.NoError(t, proof.Unmarshal(proofBz))
+		return proof
+	}
+
+	verifyRootProof := func(t *testing.T, res *QueryProofResponse) {
+		t.Helper()
+		treeRoot, err := hex.DecodeString(res.TreeRoot)
+		require.NoError(t, err)
+		stateRoot, err := hex.DecodeString(res.StateHash)
+		require.NoError(t, err)
+		treeRootHash := sha256.Sum256(treeRoot)
+		require.True(t, ibcStore.VerifyMembership(stateRoot, decodeProof(t, res.RootProof), []byte(res.Tree), treeRootHash[:]))
+	}
+
+	t.Run("membership proof", func(t *testing.T) {
+		rec := queryProof(t, trees.AccountTreeName, address)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		var res QueryProofResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.True(t, res.Exists)
+		require.Equal(t, height, res.Height)
+		require.Equal(t, stateHash, res.StateHash)
+		require.Equal(t, res.TreeRoot, res.StateTreeHashes.AdditionalProperties[trees.AccountTreeName])
+		require.NotNil(t, res.Value)
+
+		value, err := hex.DecodeString(*res.Value)
+		require.NoError(t, err)
+		account := new(coreTypes.Account)
+		require.NoError(t, codec.GetCodec().Unmarshal(value, account))
+		require.Equal(t, amount, account.GetAmount())
+
+		valueHash := sha256.Sum256(value)
+		require.Equal(t, hex.EncodeToString(valueHash[:]), res.ValueHash)
+
+		treeRoot, err := hex.DecodeString(res.TreeRoot)
+		require.NoError(t, err)
+		key, err := hex.DecodeString(address)
+		require.NoError(t, err)
+		require.True(t, ibcStore.VerifyMembership(treeRoot, decodeProof(t, res.Proof), key, valueHash[:]))
+		verifyRootProof(t, &res)
+	})
+
+	t.Run("non-membership proof", func(t *testing.T) {
+		rec := queryProof(t, trees.AccountTreeName, unknownAddr)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		var res QueryProofResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.False(t, res.Exists)
+		require.Nil(t, res.Value)
+		require.Empty(t, res.ValueHash)
+
+		treeRoot, err := hex.DecodeString(res.TreeRoot)
+		require.NoError(t, err)
+		key, err := hex.DecodeString(unknownAddr)
+		require.NoError(t, err)
+		require.True(t, ibcStore.VerifyNonMembership(treeRoot, decodeProof(t, res.Proof), key))
+		verifyRootProof(t, &res)
+	})
+
+	t.Run("unknown tree", func(t *testing.T) {
+		rec := queryProof(t, "not_a_tree", address)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		rec := queryProof(t, trees.AccountTreeName, "not hex")
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestRPCServer_PostV1QueryApps(t *testing.T) {
+	const height = int64(4)
+
+	ctrl := gomock.NewController(t)
+
+	apps := []*coreTypes.Actor{
+		{ActorType: coreTypes.ActorType_ACTOR_TYPE_APP, Address: "00104055c00bed7c983a48aac7dc6335d7c607a7", StakedAmount: "100", Chains: []string{"0001"}},
+		{ActorType: coreTypes.ActorType_ACTOR_TYPE_APP, Address: "00204737d2a165ebe4be3a7d5b0af905b0ea91d8", StakedAmount: "200", Chains: []string{"0001"}},
+	}
+	readCtxMock := mockModules.NewMockPersistenceReadContext(ctrl)
+	readCtxMock.EXPECT().
+		GetActorsPaginated(coreTypes.ActorType_ACTOR_TYPE_APP, height, gomock.Any(), uint64(2), uint64(2)).
+		DoAndReturn(func(_ coreTypes.ActorType, _ int64, filter *moduleTypes.ActorQueryFilter, _, _ uint64) ([]*coreTypes.Actor, int, error) {
+			require.Equal(t, int32(coreTypes.StakeStatus_Staked), filter.GetStatus())
+			require.Equal(t, "0001", filter.GetChain())
+			return apps, 5, nil
+		}).
+		AnyTimes()
+	readCtxMock.EXPECT().Release().AnyTimes()
+
+	persistenceMock := mockModules.NewMockPersistenceModule(ctrl)
+	persistenceMock.EXPECT().NewReadContext(height).Return(readCtxMock, nil).AnyTimes()
+
+	consensusMock := mockModules.NewMockConsensusModule(ctrl)
+	consensusMock.EXPECT().CurrentHeight().Return(uint64(height + 1)).AnyTimes()
+
+	busMock := mockModules.NewMockBus(ctrl)
+	busMock.EXPECT().GetPersistenceModule().Return(persistenceMock).AnyTimes()
+	busMock.EXPECT().GetConsensusModule().Return(consensusMock).AnyTimes()
+
+	s := NewRPCServer(busMock)
+	e := echo.New()
+
+	queryApps := func(t *testing.T, body QueryActorsPaginated) *httptest.ResponseRecorder {
+		t.Helper()
+		reqBody, err := json.Marshal(body)
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, "/v1/query/apps", strings.NewReader(string(reqBody)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		require.NoError(t, s.PostV1QueryApps(e.NewContext(req, rec)))
+		return rec
+	}
+
+	staked, chain := "staked", "0001"
+
+	t.Run("filtered page", func(t *testing.T) {
+		rec := queryApps(t, QueryActorsPaginated{Page: 2, PerPage: 2, Status: &staked, Chain: &chain})
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		var res QueryAppsResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Equal(t, int64(2), res.Page)
+		require.Equal(t, int64(3), res.TotalPages)
+		require.Equal(t, int64(5), res.TotalApps)
+		require.Len(t, res.Apps, 2)
+		require.Equal(t, apps[0].Address, res.Apps[0].Address)
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		invalid := "jailed"
+		rec := queryApps(t, QueryActorsPaginated{Page: 1, PerPage: 2, Status: &invalid})
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("invalid min stake", func(t *testing.T) {
+		invalid := "1 OR 1=1"
+		rec := queryApps(t, QueryActorsPaginated{Page: 1, PerPage: 2, MinStake: &invalid})
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("per_page too high", func(t *testing.T) {
+		rec := queryApps(t, QueryActorsPaginated{Page: 1, PerPage: maxPerPage + 1})
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("page offset overflow", func(t *testing.T) {
+		rec := queryApps(t, QueryActorsPaginated{Page: math.MaxInt64/2 + 2, PerPage: 2})
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("page zero", func(t *testing.T) {
+		rec := queryApps(t, QueryActorsPaginated{Page: 0, PerPage: 2})
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestRPCServer_HistoricalQueries(t *testing.T) {
+	const (
+		latestHeight = int64(9)
+		blockHeight  = int64(4)
+		blockHash    = "6b9bd24a4d9a4de7a35a1c4d3c3b1b53f0b9bcf4d2cd0c4e6e4b1e1d0a6f2c3e"
+		unknownHash  = "00"
+	)
+	blockTime := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	ctrl := gomock.NewController(t)
+
+	latestReadCtxMock := mockModules.NewMockPersistenceReadContext(ctrl)
+	latestReadCtxMock.EXPECT().GetHeightByBlockHash(blockHash).Return(blockHeight, nil).AnyTimes()
+	latestReadCtxMock.EXPECT().GetHeightByBlockHash(unknownHash).Return(int64(0), coreTypes.ErrBlockNotFound(unknownHash)).AnyTimes()
+	latestReadCtxMock.EXPECT().GetHeightAtTime(gomock.Any()).DoAndReturn(func(at time.Time) (int64, error) {
+		if at.Before(blockTime) {
+			return 0, coreTypes.ErrBlockNotFound(at.String())
+		}
+		return blockHeight, nil
+	}).AnyTimes()
+	latestReadCtxMock.EXPECT().Release().AnyTimes()
+
+	readCtxMock := mockModules.NewMockPersistenceReadContext(ctrl)
+	readCtxMock.EXPECT().GetAccountAmount(gomock.Any(), blockHeight).Return("100", nil).AnyTimes()
+	readCtxMock.EXPECT().Release().AnyTimes()
+
+	blockStoreMock := mockTypes.NewMockBlockStore(ctrl)
+	blockStoreMock.EXPECT().GetBlock(uint64(blockHeight)).Return(&coreTypes.Block{
+		BlockHeader: &coreTypes.BlockHeader{
+			Height:    uint64(blockHeight),
+			StateHash: blockHash,
+			Timestamp: timestamppb.New(blockTime),
+		},
+	}, nil).AnyTimes()
+
+	persistenceMock := mockModules.NewMockPersistenceModule(ctrl)
+	persistenceMock.EXPECT().NewReadContext(latestHeight).Return(latestReadCtxMock, nil).AnyTimes()
+	persistenceMock.EXPECT().NewReadContext(blockHeight).Return(readCtxMock, nil).AnyTimes()
+	persistenceMock.EXPECT().GetBlockStore().Return(blockStoreMock).AnyTimes()
+
+	consensusMock := mockModules.NewMockConsensusModule(ctrl)
+	consensusMock.EXPECT().CurrentHeight().Return(uint64(latestHeight + 1)).AnyTimes()
+
+	busMock := mockModules.NewMockBus(ctrl)
+	busMock.EXPECT().GetPersistenceModule().Return(persistenceMock).AnyTimes()
+	busMock.EXPECT().GetConsensusModule().Return(consensusMock).AnyTimes()
+
+	s := NewRPCServer(busMock)
+	e := echo.New()
+
+	post := func(t *testing.T, handler func(echo.Context) error, body any) *httptest.ResponseRecorder {
+		t.Helper()
+		reqBody, err := json.Marshal(body)
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(reqBody)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		require.NoError(t, handler(e.NewContext(req, rec)))
+		return rec
+	}
+
+	t.Run("block by hash", func(t *testing.T) {
+		rec := post(t, s.PostV1QueryBlockByHash, QueryHash{Hash: blockHash})
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		var res Block
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Equal(t, blockHeight, res.BlockHeader.Height)
+
+		rec = post(t, s.PostV1QueryBlockByHash, QueryHash{Hash: unknownHash})
+		require.Equal(t, http.StatusNotFound, rec.Code)
+
+		rec = post(t, s.PostV1QueryBlockByHash, QueryHash{Hash: "not hex"})
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("height at time", func(t *testing.T) {
+		rec := post(t, s.PostV1QueryHeightAtTime, QueryTimestamp{Timestamp: blockTime.Add(time.Minute)})
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		var res QueryHeightAtTimeResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Equal(t, blockHeight, res.Height)
+		require.Equal(t, blockHash, res.BlockHash)
+		require.True(t, blockTime.Equal(res.BlockTime))
+
+		rec = post(t, s.PostV1QueryHeightAtTime, QueryTimestamp{Timestamp: blockTime.Add(-time.Minute)})
+		require.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("query at block hash or timestamp", func(t *testing.T) {
+		const address = "00104055c00bed7c983a48aac7dc6335d7c607a7"
+		hash, at := blockHash, blockTime
+
+		rec := post(t, s.PostV1QueryAccount, QueryAccountHeight{Address: address, BlockHash: &hash})
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		rec = post(t, s.PostV1QueryAccount, QueryAccountHeight{Address: address, Timestamp: &at})
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		var res Account
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Equal(t, "100", res.Coins[0].Amount)
+	})
+
+	t.Run("conflicting selectors", func(t *testing.T) {
+		hash, at := blockHash, blockTime
+		rec := post(t, s.PostV1QueryAccount, QueryAccountHeight{Height: 3, BlockHash: &hash})
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		rec = post(t, s.PostV1QueryAccount, QueryAccountHeight{BlockHash: &hash, Timestamp: &at})
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
@@ -0,0 +1,223 @@
+package rpc
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/pocket/logger"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/modules"
+	mockModules "github.com/pokt-network/pocket/shared/modules/mocks"
+	utilTypes "github.com/pokt-network/pocket/utility/types"
+)
+
+func TestRPCServer_PostV1ClientSimulateTx(t *testing.T) {
+	const signerAddr = "00404a570febd061274f72b50d0a37f611dfe339"
+	txBz := []byte("tx")
+	signer, err := hex.DecodeString(signerAddr)
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	utilityMock := mockModules.NewMockUtilityModule(ctrl)
+	utilityMock.EXPECT().SimulateTransaction(txBz, signer).Return(&coreTypes.TxSimulation{
+		Height:            5,
+		MessageType:       "MessageSend",
+		Fee:               "10000",
+		SignerAddr:        signerAddr,
+		IsSignerCandidate: false,
+		ResultCode:        int32(coreTypes.CodeInvalidSignerError),
+		Error:             "invalid signer",
+	}, nil).AnyTimes()
+
+	busMock := mockModules.NewMockBus(ctrl)
+	busMock.EXPECT().GetUtilityModule().Return(utilityMock).AnyTimes()
+
+	s := NewRPCServer(busMock)
+	e := echo.New()
+
+	simulateTx := func(t *testing.T, body string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPost, "/v1/client/simulate_tx", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		require.NoError(t, s.PostV1ClientSimulateTx(e.NewContext(req, rec)))
+		return rec
+	}
+
+	t.Run("simulation outcome", func(t *testing.T) {
+		signerAddr := signerAddr
+		reqBody, err := json.Marshal(SimulateTXRequest{RawHexBytes: hex.EncodeToString(txBz), SignerAddr: &signerAddr})
+		require.NoError(t, err)
+		rec := simulateTx(t, string(reqBody))
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		var res SimulateTXResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Equal(t, "10000", res.Fee)
+		require.Equal(t, int32(coreTypes.CodeInvalidSignerError), res.ResultCode)
+		require.False(t, res.IsSignerCandidate)
+		require.NotNil(t, res.SignerCandidates)
+	})
+
+	t.Run("invalid tx bytes", func(t *testing.T) {
+		rec := simulateTx(t, `{"raw_hex_bytes":"not hex"}`)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("invalid signer address", func(t *testing.T) {
+		rec := simulateTx(t, `{"raw_hex_bytes":"00","signer_addr":"not hex"}`)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestRPCServer_PostV1ClientBroadcastTxAsync(t *testing.T) {
+	txBz := []byte("tx")
+	txHash := hex.EncodeToString(crypto.SHA3Hash(txBz))
+	committedTxBz := []byte("committed tx")
+
+	ctrl := gomock.NewController(t)
+	txMempool := utilTypes.NewTxFIFOMempool(1000000, 1000)
+
+	handled := make(chan struct{})
+	utilityMock := mockModules.NewMockUtilityModule(ctrl)
+	utilityMock.EXPECT().GetMempool().Return(txMempool).AnyTimes()
+	utilityMock.EXPECT().HandleTransaction(txBz).DoAndReturn(func([]byte) error {
+		close(handled)
+		return coreTypes.ErrDuplicateTransaction()
+	}).Times(1)
+
+	persistenceMock := mockModules.NewMockPersistenceModule(ctrl)
+	persistenceMock.EXPECT().TransactionExists(gomock.Any(), txBz).Return(false, nil).AnyTimes()
+	persistenceMock.EXPECT().TransactionExists(gomock.Any(), committedTxBz).Return(true, nil).AnyTimes()
+
+	busMock := mockModules.NewMockBus(ctrl)
+	busMock.EXPECT().GetUtilityModule().Return(utilityMock).AnyTimes()
+	busMock.EXPECT().GetPersistenceModule().Return(persistenceMock).AnyTimes()
+
+	s := NewRPCServer(busMock)
+	s.logger = *logger.Global.CreateLoggerForModule(modules.RPCModuleName)
+	e := echo.New()
+
+	broadcastTxAsync := func(t *testing.T, txBz []byte) *httptest.ResponseRecorder {
+		t.Helper()
+		reqBody, err := json.Marshal(RawTXRequest{RawHexBytes: hex.EncodeToString(txBz)})
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, "/v1/client/broadcast_tx_async", strings.NewReader(string(reqBody)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		require.NoError(t, s.PostV1ClientBroadcastTxAsync(e.NewContext(req, rec)))
+		return rec
+	}
+
+	t.Run("returns the hash before handling the transaction", func(t *testing.T) {
+		rec := broadcastTxAsync(t, txBz)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		var res BroadcastTXResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Equal(t, txHash, res.Hash)
+
+		select {
+		case <-handled:
+		case <-time.After(5 * time.Second):
+			t.Fatal("the transaction should be handled in the background")
+		}
+	})
+
+	t.Run("rejects committed transactions", func(t *testing.T) {
+		rec := broadcastTxAsync(t, committedTxBz)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("rejects transactions in the mempool", func(t *testing.T) {
+		mempoolTxBz := []byte("mempool tx")
+		require.NoError(t, txMempool.AddTx(mempoolTxBz))
+		rec := broadcastTxAsync(t, mempoolTxBz)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestRPCServer_PostV1ClientBroadcastTxCommit(t *testing.T) {
+	txBz := []byte("tx")
+	timedOutTxBz := []byte("timed out tx")
+	txHash := hex.EncodeToString(crypto.SHA3Hash(txBz))
+
+	ctrl := gomock.NewController(t)
+
+	committed := make(chan struct{})
+	utilityMock := mockModules.NewMockUtilityModule(ctrl)
+	utilityMock.EXPECT().HandleTransaction(gomock.Any()).Return(nil).AnyTimes()
+	utilityMock.EXPECT().GetIndexedTransaction(txBz).DoAndReturn(func([]byte) (*coreTypes.IndexedTransaction, error) {
+		select {
+		case <-committed:
+			return &coreTypes.IndexedTransaction{Height: 7, Index: 1, ResultCode: int32(coreTypes.CodeInsufficientAmountError), Error: "insufficient amount"}, nil
+		default:
+			return nil, coreTypes.ErrTransactionNotCommitted()
+		}
+	}).AnyTimes()
+	utilityMock.EXPECT().GetIndexedTransaction(timedOutTxBz).Return(nil, coreTypes.ErrTransactionNotCommitted()).AnyTimes()
+
+	p2pMock := mockModules.NewMockP2PModule(ctrl)
+	p2pMock.EXPECT().Broadcast(gomock.Any()).Return(nil).AnyTimes()
+
+	busMock := mockModules.NewMockBus(ctrl)
+	busMock.EXPECT().GetUtilityModule().Return(utilityMock).AnyTimes()
+	busMock.EXPECT().GetP2PModule().Return(p2pMock).AnyTimes()
+
+	s := NewRPCServer(busMock)
+	s.logger = *logger.Global.CreateLoggerForModule(modules.RPCModuleName)
+	e := echo.New()
+
+	broadcastTxCommit := func(t *testing.T, txBz []byte) *httptest.ResponseRecorder {
+		t.Helper()
+		reqBody, err := json.Marshal(RawTXRequest{RawHexBytes: hex.EncodeToString(txBz)})
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, broadcastTxCommitPath, strings.NewReader(string(reqBody)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		require.NoError(t, s.PostV1ClientBroadcastTxCommit(e.NewContext(req, rec)))
+		return rec
+	}
+
+	t.Run("waits for the transaction to be committed", func(t *testing.T) {
+		s.timeout = 10 * time.Second
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			close(committed)
+			s.committedHeights.notify()
+		}()
+
+		rec := broadcastTxCommit(t, txBz)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		var res BroadcastTXCommitResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Equal(t, txHash, res.Hash)
+		require.True(t, res.Committed)
+		require.Equal(t, int64(7), *res.Height)
+		require.Equal(t, int32(1), *res.Index)
+		require.Equal(t, int32(coreTypes.CodeInsufficientAmountError), *res.ResultCode)
+	})
+
+	t.Run("times out", func(t *testing.T) {
+		s.timeout = 100 * time.Millisecond
+
+		rec := broadcastTxCommit(t, timedOutTxBz)
+		require.Equal(t, http.StatusAccepted, rec.Code, rec.Body.String())
+
+		var res BroadcastTXCommitResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.False(t, res.Committed)
+		require.Nil(t, res.Height)
+	})
+}
@@ -0,0 +1,267 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	jsonRPCPath    = "/v1/jsonrpc"
+	jsonRPCVersion = "2.0"
+
+	// maxJSONRPCBatchSize limits the number of calls in a single batch request
+	maxJSONRPCBatchSize = 100
+)
+
+// JSON-RPC 2.0 error codes: https://www.jsonrpc.org/specification#error_object
+const (
+	jsonRPCParseError     = -32700
+	jsonRPCInvalidRequest = -32600
+	jsonRPCMethodNotFound = -32601
+	jsonRPCInvalidParams  = -32602
+	jsonRPCInternalError  = -32603
+	// jsonRPCServerError is used for the handler failures that are not caused by the params, e.g. timeouts
+	jsonRPCServerError = -32000
+)
+
+type jsonRPCRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	// ID is nil for notifications, which must not be answered
+	ID json.RawMessage `json:"id,omitempty"`
+}
+
+type jsonRPCResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *jsonRPCError   `json:"error,omitempty"`
+	ID      json.RawMessage `json:"id"`
+}
+
+type jsonRPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    any    `json:"data,omitempty"`
+}
+
+// jsonRPCMethod is the REST route a JSON-RPC method is dispatched to
+type jsonRPCMethod struct {
+	httpMethod string
+	path       string
+}
+
+// newJSONRPCRouter registers the REST handlers on a router without any middleware so that JSON-RPC calls
+// can be dispatched to the exact same handlers, and returns it along with the JSON-RPC methods it serves.
+// Method names are derived from the REST paths, e.g. `/v1/query/account` is `query_account` and
+// `/v1/client/broadcast_tx_sync` is `broadcast_tx_sync`.
+func newJSONRPCRouter(s ServerInterface) (*echo.Echo, map[string]jsonRPCMethod) {
+	router := echo.New()
+	RegisterHandlers(router, s)
+
+	methods := make(map[string]jsonRPCMethod)
+	for _, route := range router.Routes() {
+		// WebSocket subscriptions cannot be served over JSON-RPC
+		if route.Path == subscribePath {
+			continue
+		}
+		name := strings.TrimPrefix(route.Path, "/v1/")
+		name = strings.TrimPrefix(name, "client/")
+		name = strings.ReplaceAll(name, "/", "_")
+		methods[name] = jsonRPCMethod{
+			httpMethod: route.Method,
+			path:       route.Path,
+		}
+	}
+	return router, methods
+}
+
+// PostV1JSONRPC serves single and batched JSON-RPC 2.0 requests.
+func (s *rpcServer) PostV1JSONRPC(ctx echo.Context) error {
+	var body json.RawMessage
+	if err := json.NewDecoder(ctx.Request().Body).Decode(&body); err != nil {
+		return ctx.JSON(http.StatusOK, newJSONRPCErrorResponse(nil, jsonRPCParseError, "parse error", err.Error()))
+	}
+
+	// A batch is an array of requests, anything else is handled as a single request
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '[' {
+		var batch []json.RawMessage
+		if err := json.Unmarshal(body, &batch); err != nil {
+			return ctx.JSON(http.StatusOK, newJSONRPCErrorResponse(nil, jsonRPCParseError, "parse error", err.Error()))
+		}
+		if len(batch) == 0 {
+			return ctx.JSON(http.StatusOK, newJSONRPCErrorResponse(nil, jsonRPCInvalidRequest, "invalid request", "empty batch"))
+		}
+		if len(batch) > maxJSONRPCBatchSize {
+			return ctx.JSON(http.StatusOK, newJSONRPCErrorResponse(nil, jsonRPCInvalidRequest, "invalid request",
+				fmt.Sprintf("batch size %d exceeds the maximum of %d", len(batch), maxJSONRPCBatchSize)))
+		}
+
+		responses := make([]*jsonRPCResponse, 0, len(batch))
+		for _, rawReq := range batch {
+			if res := s.handleJSONRPCRequest(ctx.Request(), rawReq); res != nil {
+				responses = append(responses, res)
+			}
+		}
+		// The server must not respond to a batch that only contains notifications
+		if len(responses) == 0 {
+			return ctx.NoContent(http.StatusNoContent)
+		}
+		return ctx.JSON(http.StatusOK, responses)
+	}
+
+	res := s.handleJSONRPCRequest(ctx.Request(), body)
+	if res == nil {
+		return ctx.NoContent(http.StatusNoContent)
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// handleJSONRPCRequest dispatches a single JSON-RPC request to its REST handler. It returns nil for notifications.
+func (s *rpcServer) handleJSONRPCRequest(httpReq *http.Request, rawReq json.RawMessage) *jsonRPCResponse {
+	var req jsonRPCRequest
+	if err := json.Unmarshal(rawReq, &req); err != nil {
+		return newJSONRPCErrorResponse(nil, jsonRPCInvalidRequest, "invalid request", err.Error())
+	}
+	if req.JSONRPC != jsonRPCVersion || req.Method == "" {
+		return newJSONRPCErrorResponse(req.ID, jsonRPCInvalidRequest, "invalid request", fmt.Sprintf(`"jsonrpc" must be %q and "method" is required`, jsonRPCVersion))
+	}
+
+	res := s.callJSONRPCMethod(httpReq, &req)
+	if req.ID == nil {
+		return nil
+	}
+	return res
+}
+
+// callJSONRPCMethod replays the call as a request to the REST route of the method and converts the response.
+// The params must be passed by name: as the JSON body of POST routes and as the query parameters of GET routes.
+func (s *rpcServer) callJSONRPCMethod(httpReq *http.Request, req *jsonRPCRequest) *jsonRPCResponse {
+	method, ok := s.jsonRPCMethods[req.Method]
+	if !ok {
+		return newJSONRPCErrorResponse(req.ID, jsonRPCMethodNotFound, "method not found", req.Method)
+	}
+
+	params := map[string]json.RawMessage{}
+	if len(req.Params) > 0 && !bytes.Equal(bytes.TrimSpace(req.Params), []byte("null")) {
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return newJSONRPCErrorResponse(req.ID, jsonRPCInvalidParams, "invalid params", "params must be an object")
+		}
+	}
+
+	target := method.path
+	var body []byte
+	if method.httpMethod == http.MethodGet {
+		query := url.Values{}
+		for key, value := range params {
+			var str string
+			if err := json.Unmarshal(value, &str); err != nil {
+				// Non string values (e.g. numbers) are passed as is
+				str = string(value)
+			}
+			query.Set(key, str)
+		}
+		if len(query) > 0 {
+			target += "?" + query.Encode()
+		}
+	} else {
+		body = req.Params
+		if len(params) == 0 {
+			body = []byte("{}")
+		}
+	}
+
+	restReq, err := http.NewRequestWithContext(httpReq.Context(), method.httpMethod, target, bytes.NewReader(body))
+	if err != nil {
+		return newJSONRPCErrorResponse(req.ID, jsonRPCInternalError, err.Error(), nil)
+	}
+	restReq.RemoteAddr = httpReq.RemoteAddr
+	restReq.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := newJSONRPCResponseRecorder()
+	s.jsonRPCRouter.ServeHTTP(rec, restReq)
+
+	resBody := bytes.TrimSpace(rec.body.Bytes())
+	if rec.code < http.StatusOK || rec.code >= http.StatusMultipleChoices {
+		code := jsonRPCServerError
+		switch {
+		case rec.code == http.StatusBadRequest:
+			code = jsonRPCInvalidParams
+		case rec.code >= http.StatusInternalServerError && rec.code != http.StatusServiceUnavailable:
+			code = jsonRPCInternalError
+		}
+		return newJSONRPCErrorResponse(req.ID, code, string(resBody), map[string]int{"http_status": rec.code})
+	}
+
+	// JSON responses are returned as is, plain text ones as a JSON string and empty ones as null
+	var result json.RawMessage
+	switch {
+	case len(resBody) == 0:
+		result = json.RawMessage("null")
+	case strings.HasPrefix(rec.Header().Get(echo.HeaderContentType), echo.MIMEApplicationJSON):
+		result = resBody
+	default:
+		strBz, err := json.Marshal(string(resBody))
+		if err != nil {
+			return newJSONRPCErrorResponse(req.ID, jsonRPCInternalError, err.Error(), nil)
+		}
+		result = strBz
+	}
+	return &jsonRPCResponse{
+		JSONRPC: jsonRPCVersion,
+		Result:  result,
+		ID:      req.ID,
+	}
+}
+
+// jsonRPCResponseRecorder is the http.ResponseWriter capturing the response of the REST handler a JSON-RPC method is dispatched to
+type jsonRPCResponseRecorder struct {
+	header      http.Header
+	code        int
+	body        bytes.Buffer
+	wroteHeader bool
+}
+
+func newJSONRPCResponseRecorder() *jsonRPCResponseRecorder {
+	return &jsonRPCResponseRecorder{
+		header: make(http.Header),
+		code:   http.StatusOK,
+	}
+}
+
+func (r *jsonRPCResponseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *jsonRPCResponseRecorder) Write(bz []byte) (int, error) {
+	r.WriteHeader(http.StatusOK)
+	return r.body.Write(bz)
+}
+
+func (r *jsonRPCResponseRecorder) WriteHeader(code int) {
+	if r.wroteHeader {
+		return
+	}
+	r.code = code
+	r.wroteHeader = true
+}
+
+func newJSONRPCErrorResponse(id json.RawMessage, code int, message string, data any) *jsonRPCResponse {
+	if id == nil {
+		id = json.RawMessage("null")
+	}
+	return &jsonRPCResponse{
+		JSONRPC: jsonRPCVersion,
+		Error: &jsonRPCError{
+			Code:    code,
+			Message: message,
+			Data:    data,
+		},
+		ID: id,
+	}
+}
@@ -0,0 +1,142 @@
+package rpc
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/pocket/app"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	mockModules "github.com/pokt-network/pocket/shared/modules/mocks"
+)
+
+func TestRPCServer_PostV1JSONRPC(t *testing.T) {
+	txBz := []byte("tx")
+
+	ctrl := gomock.NewController(t)
+	utilityMock := mockModules.NewMockUtilityModule(ctrl)
+	utilityMock.EXPECT().SimulateTransaction(txBz, nil).Return(&coreTypes.TxSimulation{
+		Height:      5,
+		MessageType: "MessageSend",
+		Fee:         "10000",
+	}, nil).AnyTimes()
+
+	busMock := mockModules.NewMockBus(ctrl)
+	busMock.EXPECT().GetUtilityModule().Return(utilityMock).AnyTimes()
+
+	s := NewRPCServer(busMock)
+	e := echo.New()
+
+	callJSONRPC := func(t *testing.T, body string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPost, jsonRPCPath, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		require.NoError(t, s.PostV1JSONRPC(e.NewContext(req, rec)))
+		return rec
+	}
+
+	decodeResponse := func(t *testing.T, rec *httptest.ResponseRecorder) jsonRPCResponse {
+		t.Helper()
+		require.Equal(t, http.StatusOK, rec.Code)
+		var res jsonRPCResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Equal(t, jsonRPCVersion, res.JSONRPC)
+		return res
+	}
+
+	t.Run("method names are derived from the REST routes", func(t *testing.T) {
+		for _, name := range []string{"broadcast_tx_sync", "get_session", "relay", "query_account", "health", "consensus_state"} {
+			require.Contains(t, s.jsonRPCMethods, name)
+		}
+		require.NotContains(t, s.jsonRPCMethods, "subscribe")
+	})
+
+	t.Run("single call to a POST route", func(t *testing.T) {
+		rec := callJSONRPC(t, `{"jsonrpc":"2.0","id":1,"method":"simulate_tx","params":{"raw_hex_bytes":"`+hex.EncodeToString(txBz)+`"}}`)
+		res := decodeResponse(t, rec)
+		require.Nil(t, res.Error)
+		require.JSONEq(t, "1", string(res.ID))
+
+		var simulation SimulateTXResponse
+		require.NoError(t, json.Unmarshal(res.Result, &simulation))
+		require.Equal(t, "10000", simulation.Fee)
+	})
+
+	t.Run("single call to a GET route", func(t *testing.T) {
+		res := decodeResponse(t, callJSONRPC(t, `{"jsonrpc":"2.0","id":"v","method":"version"}`))
+		require.Nil(t, res.Error)
+		require.JSONEq(t, `"v"`, string(res.ID))
+
+		var version string
+		require.NoError(t, json.Unmarshal(res.Result, &version))
+		require.Equal(t, app.AppVersion, version)
+	})
+
+	t.Run("batch", func(t *testing.T) {
+		rec := callJSONRPC(t, `[
+			{"jsonrpc":"2.0","id":1,"method":"health"},
+			{"jsonrpc":"2.0","method":"health"},
+			{"jsonrpc":"2.0","id":2,"method":"unknown"},
+			{"jsonrpc":"2.0","id":3,"method":"simulate_tx","params":{"raw_hex_bytes":"not hex"}}
+		]`)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var res []jsonRPCResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Len(t, res, 3)
+
+		require.Nil(t, res[0].Error)
+		require.JSONEq(t, "null", string(res[0].Result))
+		require.Equal(t, jsonRPCMethodNotFound, res[1].Error.Code)
+		require.JSONEq(t, "2", string(res[1].ID))
+		require.Equal(t, jsonRPCInvalidParams, res[2].Error.Code)
+	})
+
+	t.Run("notifications are not answered", func(t *testing.T) {
+		rec := callJSONRPC(t, `{"jsonrpc":"2.0","method":"health"}`)
+		require.Equal(t, http.StatusNoContent, rec.Code)
+		require.Empty(t, rec.Body.String())
+
+		rec = callJSONRPC(t, `[{"jsonrpc":"2.0","method":"health"},{"jsonrpc":"2.0","method":"version"}]`)
+		require.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		tests := []struct {
+			name string
+			body string
+			code int
+		}{
+			{"parse error", `{"jsonrpc":`, jsonRPCParseError},
+			{"empty batch", `[]`, jsonRPCInvalidRequest},
+			{"wrong version", `{"jsonrpc":"1.0","id":1,"method":"health"}`, jsonRPCInvalidRequest},
+			{"missing method", `{"jsonrpc":"2.0","id":1}`, jsonRPCInvalidRequest},
+			{"positional params", `{"jsonrpc":"2.0","id":1,"method":"simulate_tx","params":["00"]}`, jsonRPCInvalidParams},
+			{"websocket subscriptions", `{"jsonrpc":"2.0","id":1,"method":"subscribe"}`, jsonRPCMethodNotFound},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				res := decodeResponse(t, callJSONRPC(t, tt.body))
+				require.NotNil(t, res.Error)
+				require.Equal(t, tt.code, res.Error.Code)
+			})
+		}
+	})
+
+	t.Run("batch size is capped", func(t *testing.T) {
+		calls := make([]string, maxJSONRPCBatchSize+1)
+		for i := range calls {
+			calls[i] = `{"jsonrpc":"2.0","method":"health"}`
+		}
+		res := decodeResponse(t, callJSONRPC(t, "["+strings.Join(calls, ",")+"]"))
+		require.Equal(t, jsonRPCInvalidRequest, res.Error.Code)
+	})
+}
@@ -1,6 +1,8 @@
 package rpc
 
 import (
+	"google.golang.org/protobuf/types/known/anypb"
+
 	_ "github.com/getkin/kin-openapi/openapi3"
 	_ "github.com/labstack/echo/v4"
 	"github.com/pokt-network/pocket/logger"
@@ -17,6 +19,7 @@ type rpcModule struct {
 
 	logger *modules.Logger
 	config *configs.RPCConfig
+	server *rpcServer
 }
 
 func Create(bus modules.Bus, options ...modules.ModuleOption) (modules.Module, error) {
@@ -45,10 +48,19 @@ func (*rpcModule) Create(bus modules.Bus, options ...modules.ModuleOption) (modu
 
 func (u *rpcModule) Start() error {
 	u.logger = logger.Global.CreateLoggerForModule(u.GetModuleName())
-	go NewRPCServer(u.GetBus()).StartRPC(u.config.Port, u.config.Timeout, u.logger)
+	u.server = NewRPCServer(u.GetBus())
+	go u.server.StartRPC(u.config.Port, u.config.Timeout, u.logger)
 	return nil
 }
 
+// HandleEvent publishes the bus events consumed by the RPC server's WebSocket subscriptions
+func (u *rpcModule) HandleEvent(event *anypb.Any) error {
+	if u.server == nil {
+		return nil
+	}
+	return u.server.handleEvent(event)
+}
+
 func (u *rpcModule) GetModuleName() string {
 	return modules.RPCModuleName
 }
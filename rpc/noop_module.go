@@ -3,6 +3,8 @@ package rpc
 import (
 	"log"
 
+	"google.golang.org/protobuf/types/known/anypb"
+
 	"github.com/pokt-network/pocket/shared/modules"
 	"github.com/pokt-network/pocket/shared/modules/base_modules"
 )
@@ -26,3 +28,7 @@ func (m *noopRpcModule) Start() error {
 	log.Println("[WARN] RPC server: OFFLINE")
 	return nil
 }
+
+func (m *noopRpcModule) HandleEvent(_ *anypb.Any) error {
+	return nil
+}
@@ -8,12 +8,25 @@ import (
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/pokt-network/pocket/shared/modules"
 	"github.com/pokt-network/pocket/shared/modules/base_modules"
+	"github.com/pokt-network/pocket/telemetry"
 )
 
 type rpcServer struct {
 	base_modules.IntegrableModule
 
-	logger modules.Logger
+	logger  modules.Logger
+	useCors bool
+	timeout time.Duration
+
+	subscriptions    *subscriptionHub
+	committedHeights *heightNotifier
+
+	// jsonRPCRouter serves the REST handlers the JSON-RPC methods are dispatched to
+	jsonRPCRouter  *echo.Echo
+	jsonRPCMethods map[string]jsonRPCMethod
+
+	// accessControl is nil unless the API keys or the rate limits are enabled
+	accessControl *accessController
 }
 
 var (
@@ -22,18 +35,40 @@ var (
 )
 
 func NewRPCServer(bus modules.Bus) *rpcServer {
-	s := &rpcServer{}
+	s := &rpcServer{
+		subscriptions:    newSubscriptionHub(),
+		committedHeights: newHeightNotifier(),
+	}
 	s.SetBus(bus)
+	s.jsonRPCRouter, s.jsonRPCMethods = newJSONRPCRouter(s)
+	s.jsonRPCRouter.Use(s.jsonRPCAccessControlMiddleware)
 
 	return s
 }
 
 func (s *rpcServer) StartRPC(port string, timeout uint64, logger *modules.Logger) {
 	s.logger = *logger
+	s.timeout = time.Duration(timeout) * time.Millisecond
 
 	s.logger.Info().Msgf("Starting RPC on port " + port)
 
+	rpcCfg := s.GetBus().GetRuntimeMgr().GetConfig().RPC
+	accessControl, err := newAccessController(rpcCfg)
+	if err != nil {
+		s.logger.Fatal().Err(err).Msg("Invalid RPC access control configuration")
+	}
+	s.accessControl = accessControl
+
+	if grpcCfg := rpcCfg.GetGrpc(); grpcCfg.GetEnabled() {
+		go s.StartGRPC(grpcCfg)
+	}
+
 	e := echo.New()
+	// Clients are identified by the address of the connection unless the node runs behind a trusted reverse proxy
+	e.IPExtractor = echo.ExtractIPDirect()
+	if rpcCfg.TrustForwardedFor {
+		e.IPExtractor = echo.ExtractIPFromXFFHeader()
+	}
 	middlewares := []echo.MiddlewareFunc{
 		middleware.RequestLoggerWithConfig(middleware.RequestLoggerConfig{
 			LogURI:    true,
@@ -47,13 +82,32 @@ func (s *rpcServer) StartRPC(port string, timeout uint64, logger *modules.Logger
 				return nil
 			},
 		}),
+	}
+	if s.accessControl != nil {
+		s.logger.Info().
+			Bool("auth", rpcCfg.GetAuth().GetEnabled()).
+			Bool("rate_limit", rpcCfg.GetRateLimit().GetEnabled()).
+			Msg("Enabling access control middleware")
+		s.GetBus().GetTelemetryModule().GetTimeSeriesAgent().CounterRegister(
+			telemetry.RPC_REQUESTS_REJECTED_TIMESERIES_METRIC_NAME,
+			telemetry.RPC_REQUESTS_REJECTED_TIMESERIES_METRIC_DESCRIPTION,
+		)
+		middlewares = append(middlewares, s.accessControlMiddleware)
+	}
+	middlewares = append(middlewares,
 		middleware.TimeoutWithConfig(middleware.TimeoutConfig{
-			Skipper:      middleware.DefaultSkipper,
+			// WebSocket subscriptions are long-lived and need to hijack the underlying connection while
+			// `broadcast_tx_commit` enforces the timeout itself to report the transaction it was waiting for
+			Skipper: func(c echo.Context) bool {
+				path := c.Request().URL.Path
+				return path == subscribePath || path == broadcastTxCommitPath
+			},
 			ErrorMessage: "Request timed out",
-			Timeout:      time.Duration(timeout) * time.Millisecond,
+			Timeout:      s.timeout,
 		}),
-	}
-	if s.GetBus().GetRuntimeMgr().GetConfig().RPC.UseCors {
+	)
+	s.useCors = rpcCfg.UseCors
+	if s.useCors {
 		s.logger.Info().Msg("Enabling CORS middleware")
 		middlewares = append(middlewares, middleware.CORS())
 	}
@@ -62,6 +116,7 @@ func (s *rpcServer) StartRPC(port string, timeout uint64, logger *modules.Logger
 	)
 
 	RegisterHandlers(e, s)
+	e.POST(jsonRPCPath, s.PostV1JSONRPC)
 
 	if err := e.Start(":" + port); err != http.ErrServerClosed {
 		s.logger.Fatal().Err(err).Msg("RPC server failed to start")
@@ -0,0 +1,321 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"nhooyr.io/websocket"
+	"nhooyr.io/websocket/wsjson"
+
+	"github.com/pokt-network/pocket/shared/modules"
+)
+
+const (
+	subscribePath = "/v1/subscribe"
+
+	// TODO: Consider making these configurable via the RPC config.
+	// subscriberBufferSize is the number of messages buffered per WebSocket connection before
+	// the client is considered too slow and disconnected.
+	subscriberBufferSize = 256
+	// maxSubscriptionsPerClient limits the number of concurrent subscriptions on a single connection.
+	maxSubscriptionsPerClient = 64
+	// subscriptionWriteTimeout is the maximum amount of time allowed to write a message to a client.
+	subscriptionWriteTimeout = 10 * time.Second
+)
+
+var (
+	errSubscriptionIdRequired     = fmt.Errorf("subscription id is required")
+	errSubscriptionTopicRequired  = fmt.Errorf("subscription topic is required")
+	errSubscriptionIBCTopic       = fmt.Errorf("filter.ibc_topic is required to subscribe to IBC events")
+	errSubscriptionIBCUnavailable = fmt.Errorf("IBC events are not available on this node")
+	errSubscriptionLimitReached   = fmt.Errorf("maximum number of subscriptions (%d) reached", maxSubscriptionsPerClient)
+)
+
+// subscriber tracks the subscriptions of a single WebSocket connection along with the
+// buffered queue of messages waiting to be written to it.
+type subscriber struct {
+	mu            sync.Mutex
+	subscriptions map[string]SubscriptionRequest
+
+	send      chan SubscriptionMessage
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func newSubscriber() *subscriber {
+	return &subscriber{
+		subscriptions: make(map[string]SubscriptionRequest),
+		send:          make(chan SubscriptionMessage, subscriberBufferSize),
+		done:          make(chan struct{}),
+	}
+}
+
+// subscribe adds the subscription described by req, which must have already been validated.
+func (s *subscriber) subscribe(req SubscriptionRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.subscriptions[req.Id]; ok {
+		return fmt.Errorf("subscription %q already exists", req.Id)
+	}
+	if len(s.subscriptions) >= maxSubscriptionsPerClient {
+		return errSubscriptionLimitReached
+	}
+	s.subscriptions[req.Id] = req
+	return nil
+}
+
+func (s *subscriber) unsubscribe(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.subscriptions[id]; !ok {
+		return fmt.Errorf("subscription %q does not exist", id)
+	}
+	delete(s.subscriptions, id)
+	return nil
+}
+
+// matching returns the ids of the subscriptions to topic whose filter is accepted by matches.
+// A nil matches accepts every subscription to topic.
+func (s *subscriber) matching(topic SubscriptionTopic, matches func(filter *SubscriptionFilter) bool) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ids := make([]string, 0)
+	for id, req := range s.subscriptions {
+		if req.Topic == nil || *req.Topic != topic {
+			continue
+		}
+		if matches != nil && !matches(req.Filter) {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// deliver queues msg without blocking. If the queue is full the subscriber is closed so a
+// single slow client cannot hold up the delivery of events to everyone else.
+func (s *subscriber) deliver(msg SubscriptionMessage) bool {
+	select {
+	case <-s.done:
+		return false
+	default:
+	}
+
+	select {
+	case s.send <- msg:
+		return true
+	default:
+		s.close()
+		return false
+	}
+}
+
+func (s *subscriber) close() {
+	s.closeOnce.Do(func() { close(s.done) })
+}
+
+// subscriptionHub fans out the events received from the bus to the subscribers interested in them.
+type subscriptionHub struct {
+	mu          sync.RWMutex
+	subscribers map[*subscriber]struct{}
+}
+
+func newSubscriptionHub() *subscriptionHub {
+	return &subscriptionHub{
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+func (h *subscriptionHub) add(sub *subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.subscribers[sub] = struct{}{}
+}
+
+func (h *subscriptionHub) remove(sub *subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.subscribers, sub)
+	sub.close()
+}
+
+// hasSubscriptions returns true if at least one subscriber is subscribed to any of the topics.
+// It is used to avoid the cost of building messages that nobody will receive.
+func (h *subscriptionHub) hasSubscriptions(topics ...SubscriptionTopic) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for sub := range h.subscribers {
+		for _, topic := range topics {
+			if len(sub.matching(topic, nil)) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ibcTopics returns the deduplicated set of IBC event topics subscribed to.
+func (h *subscriptionHub) ibcTopics() []string {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	seen := make(map[string]struct{})
+	topics := make([]string, 0)
+	for sub := range h.subscribers {
+		sub.mu.Lock()
+		for _, req := range sub.subscriptions {
+			if req.Topic == nil || *req.Topic != IbcEvent || req.Filter == nil || req.Filter.IbcTopic == nil {
+				continue
+			}
+			if _, ok := seen[*req.Filter.IbcTopic]; ok {
+				continue
+			}
+			seen[*req.Filter.IbcTopic] = struct{}{}
+			topics = append(topics, *req.Filter.IbcTopic)
+		}
+		sub.mu.Unlock()
+	}
+	return topics
+}
+
+// publish delivers a copy of msg, tagged with the subscription id, to every subscription to
+// msg.Topic whose filter is accepted by matches.
+func (h *subscriptionHub) publish(msg SubscriptionMessage, matches func(filter *SubscriptionFilter) bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for sub := range h.subscribers {
+		for _, id := range sub.matching(*msg.Topic, matches) {
+			msg.Id = id
+			if !sub.deliver(msg) {
+				break
+			}
+		}
+	}
+}
+
+// validateSubscriptionRequest checks that req can be served by this node.
+func (s *rpcServer) validateSubscriptionRequest(req *SubscriptionRequest) error {
+	if req.Id == "" {
+		return errSubscriptionIdRequired
+	}
+	if req.Method == Unsubscribe {
+		return nil
+	}
+	if req.Method != Subscribe {
+		return fmt.Errorf("unknown subscription method %q", req.Method)
+	}
+	if req.Topic == nil {
+		return errSubscriptionTopicRequired
+	}
+
+	switch *req.Topic {
+	case NewBlock, NewTx, StateTransition:
+		return nil
+	case IbcEvent:
+		if req.Filter == nil || req.Filter.IbcTopic == nil || *req.Filter.IbcTopic == "" {
+			return errSubscriptionIBCTopic
+		}
+		if _, err := s.getEventLogger(); err != nil {
+			return errSubscriptionIBCUnavailable
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown subscription topic %q", *req.Topic)
+	}
+}
+
+// handleSubscriptionRequest applies req to sub and returns the acknowledgement to send back to the client.
+func (s *rpcServer) handleSubscriptionRequest(sub *subscriber, req *SubscriptionRequest) SubscriptionMessage {
+	ack := SubscriptionMessage{
+		Id:    req.Id,
+		Topic: req.Topic,
+	}
+
+	err := s.validateSubscriptionRequest(req)
+	if err == nil {
+		if req.Method == Subscribe {
+			err = sub.subscribe(*req)
+		} else {
+			err = sub.unsubscribe(req.Id)
+		}
+	}
+	if err != nil {
+		errMsg := err.Error()
+		ack.Error = &errMsg
+	}
+	return ack
+}
+
+// getEventLogger returns the IBC event logger if this node is an IBC host. The bus getter is
+// not used because it is fatal when the submodule is not registered.
+func (s *rpcServer) getEventLogger() (modules.EventLogger, error) {
+	mod, err := s.GetBus().GetModulesRegistry().GetModule(modules.EventLoggerModuleName)
+	if err != nil {
+		return nil, err
+	}
+	eventLogger, ok := mod.(modules.EventLogger)
+	if !ok {
+		return nil, fmt.Errorf("unexpected event logger type %T", mod)
+	}
+	return eventLogger, nil
+}
+
+// GetV1Subscribe upgrades the connection to a WebSocket over which clients manage their
+// subscriptions and receive the matching events.
+func (s *rpcServer) GetV1Subscribe(ctx echo.Context) error {
+	acceptOpts := &websocket.AcceptOptions{}
+	if s.useCors {
+		acceptOpts.OriginPatterns = []string{"*"}
+	}
+	// Accept writes the appropriate HTTP error response itself if the upgrade fails.
+	conn, err := websocket.Accept(ctx.Response(), ctx.Request(), acceptOpts)
+	if err != nil {
+		s.logger.Debug().Err(err).Msg("Failed to upgrade subscription connection")
+		return nil
+	}
+
+	sub := newSubscriber()
+	s.subscriptions.add(sub)
+	defer s.subscriptions.remove(sub)
+
+	connCtx, cancel := context.WithCancel(ctx.Request().Context())
+	defer cancel()
+
+	go func() {
+		defer cancel()
+		for {
+			var req SubscriptionRequest
+			if err := wsjson.Read(connCtx, conn, &req); err != nil {
+				return
+			}
+			sub.deliver(s.handleSubscriptionRequest(sub, &req))
+		}
+	}()
+
+	for {
+		select {
+		case <-connCtx.Done():
+			conn.Close(websocket.StatusNormalClosure, "")
+			return nil
+		case <-sub.done:
+			conn.Close(websocket.StatusPolicyViolation, "subscriber is too slow")
+			return nil
+		case msg := <-sub.send:
+			writeCtx, cancelWrite := context.WithTimeout(connCtx, subscriptionWriteTimeout)
+			err := wsjson.Write(writeCtx, conn, msg)
+			cancelWrite()
+			if err != nil {
+				s.logger.Debug().Err(err).Msg("Failed to write subscription message")
+				return nil
+			}
+		}
+	}
+}
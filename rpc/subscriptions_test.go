@@ -0,0 +1,161 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"nhooyr.io/websocket"
+	"nhooyr.io/websocket/wsjson"
+
+	"github.com/pokt-network/pocket/logger"
+	"github.com/pokt-network/pocket/shared/codec"
+	"github.com/pokt-network/pocket/shared/messaging"
+	"github.com/pokt-network/pocket/shared/modules"
+	mockModules "github.com/pokt-network/pocket/shared/modules/mocks"
+)
+
+func TestSubscriptions_WebSocket(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	registryMock := mockModules.NewMockModulesRegistry(ctrl)
+	registryMock.EXPECT().
+		GetModule(modules.EventLoggerModuleName).
+		Return(nil, fmt.Errorf("module not found")).
+		AnyTimes()
+	busMock := mockModules.NewMockBus(ctrl)
+	busMock.EXPECT().GetModulesRegistry().Return(registryMock).AnyTimes()
+
+	s := NewRPCServer(busMock)
+	s.logger = *logger.Global.CreateLoggerForModule(modules.RPCModuleName)
+
+	e := echo.New()
+	RegisterHandlers(e, s)
+	httpServer := httptest.NewServer(e)
+	t.Cleanup(httpServer.Close)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	conn, _, err := websocket.Dial(ctx, "ws"+strings.TrimPrefix(httpServer.URL, "http")+subscribePath, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close(websocket.StatusNormalClosure, "") })
+
+	request := func(req SubscriptionRequest) SubscriptionMessage {
+		t.Helper()
+		require.NoError(t, wsjson.Write(ctx, conn, req))
+		var ack SubscriptionMessage
+		require.NoError(t, wsjson.Read(ctx, conn, &ack))
+		require.Equal(t, req.Id, ack.Id)
+		return ack
+	}
+
+	stateTransition := StateTransition
+	ibcEvent := IbcEvent
+	ibcTopic := "send_packet"
+
+	ack := request(SubscriptionRequest{Method: Subscribe, Id: "fsm", Topic: &stateTransition})
+	require.Nil(t, ack.Error)
+
+	ack = request(SubscriptionRequest{Method: Subscribe, Id: "fsm", Topic: &stateTransition})
+	require.NotNil(t, ack.Error, "duplicate subscription ids should be rejected")
+
+	ack = request(SubscriptionRequest{Method: Subscribe, Id: "ibc", Topic: &ibcEvent})
+	require.NotNil(t, ack.Error)
+	require.Equal(t, errSubscriptionIBCTopic.Error(), *ack.Error)
+
+	ack = request(SubscriptionRequest{Method: Subscribe, Id: "ibc", Topic: &ibcEvent, Filter: &SubscriptionFilter{IbcTopic: &ibcTopic}})
+	require.NotNil(t, ack.Error)
+	require.Equal(t, errSubscriptionIBCUnavailable.Error(), *ack.Error)
+
+	ack = request(SubscriptionRequest{Method: Subscribe, Id: "", Topic: &stateTransition})
+	require.NotNil(t, ack.Error)
+	require.Equal(t, errSubscriptionIdRequired.Error(), *ack.Error)
+
+	transitionEvent, err := codec.GetCodec().ToAny(&messaging.StateMachineTransitionEvent{
+		Event:         "Start",
+		PreviousState: "Stopped",
+		NewState:      "P2P_Bootstrapping",
+	})
+	require.NoError(t, err)
+	require.NoError(t, s.handleEvent(transitionEvent))
+
+	var msg SubscriptionMessage
+	require.NoError(t, wsjson.Read(ctx, conn, &msg))
+	require.Equal(t, "fsm", msg.Id)
+	require.Equal(t, StateTransition, *msg.Topic)
+	require.Equal(t, SubscriptionStateTransition{
+		Event:         "Start",
+		PreviousState: "Stopped",
+		NewState:      "P2P_Bootstrapping",
+	}, *msg.StateTransition)
+
+	ack = request(SubscriptionRequest{Method: Unsubscribe, Id: "fsm"})
+	require.Nil(t, ack.Error)
+	require.False(t, s.subscriptions.hasSubscriptions(StateTransition))
+
+	ack = request(SubscriptionRequest{Method: Unsubscribe, Id: "fsm"})
+	require.NotNil(t, ack.Error, "unknown subscription ids should be rejected")
+}
+
+func TestSubscriptions_PublishTxFilter(t *testing.T) {
+	signer, recipient, other := "signer", "recipient", "other"
+	newTx := NewTx
+
+	hub := newSubscriptionHub()
+	sub := newSubscriber()
+	hub.add(sub)
+
+	subscriptions := map[string]*SubscriptionFilter{
+		"all":             nil,
+		"signer":          {SignerAddr: &signer},
+		"recipient":       {RecipientAddr: &recipient},
+		"both":            {SignerAddr: &signer, RecipientAddr: &recipient},
+		"other_signer":    {SignerAddr: &other},
+		"other_recipient": {SignerAddr: &signer, RecipientAddr: &other},
+	}
+	for id, filter := range subscriptions {
+		require.NoError(t, sub.subscribe(SubscriptionRequest{Method: Subscribe, Id: id, Topic: &newTx, Filter: filter}))
+	}
+
+	tx := &IndexedTransaction{SignerAddr: signer, RecipientAddr: recipient}
+	hub.publish(SubscriptionMessage{Topic: &newTx, Tx: tx}, func(filter *SubscriptionFilter) bool {
+		return txMatchesFilter(tx, filter)
+	})
+
+	received := make([]string, 0)
+	for len(sub.send) > 0 {
+		received = append(received, (<-sub.send).Id)
+	}
+	require.ElementsMatch(t, []string{"all", "signer", "recipient", "both"}, received)
+}
+
+func TestSubscriptions_SlowSubscriberIsClosed(t *testing.T) {
+	newBlock := NewBlock
+
+	hub := newSubscriptionHub()
+	sub := newSubscriber()
+	hub.add(sub)
+	require.NoError(t, sub.subscribe(SubscriptionRequest{Method: Subscribe, Id: "blocks", Topic: &newBlock}))
+
+	for i := 0; i < subscriberBufferSize; i++ {
+		hub.publish(SubscriptionMessage{Topic: &newBlock}, nil)
+	}
+	select {
+	case <-sub.done:
+		t.Fatal("subscriber should not be closed before its buffer is full")
+	default:
+	}
+
+	hub.publish(SubscriptionMessage{Topic: &newBlock}, nil)
+	select {
+	case <-sub.done:
+	default:
+		t.Fatal("subscriber should be closed once its buffer overflows")
+	}
+}
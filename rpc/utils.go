@@ -2,18 +2,29 @@ package rpc
 
 import (
 	"encoding/base64"
+	"encoding/binary"
 	"encoding/hex"
 	"fmt"
 	"math"
+	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
 
 	conTypes "github.com/pokt-network/pocket/consensus/types"
+	"github.com/pokt-network/pocket/persistence/trees"
 	"github.com/pokt-network/pocket/shared/codec"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
 	"github.com/pokt-network/pocket/shared/modules"
+	moduleTypes "github.com/pokt-network/pocket/shared/modules/types"
 	"github.com/pokt-network/pocket/utility"
 	utilTypes "github.com/pokt-network/pocket/utility/types"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 const (
@@ -60,6 +71,42 @@ func (s *rpcServer) getQueryHeight(height int64) int64 {
 	return currentHeight
 }
 
+// resolveQueryHeight returns the height of the block selected by its hash or by a time if either is supplied
+// and falls back to `getQueryHeight` otherwise. Only one of the height, block hash or timestamp can be supplied.
+func (s *rpcServer) resolveQueryHeight(height int64, blockHash *string, timestamp *time.Time) (int64, *echo.HTTPError) {
+	if blockHash == nil && timestamp == nil {
+		return s.getQueryHeight(height), nil
+	}
+	if height != 0 || (blockHash != nil && timestamp != nil) {
+		return 0, echo.NewHTTPError(http.StatusBadRequest, "only one of height, block_hash or timestamp can be supplied")
+	}
+	if blockHash != nil {
+		if _, err := hex.DecodeString(*blockHash); err != nil || *blockHash == "" {
+			return 0, echo.NewHTTPError(http.StatusBadRequest, "block_hash must be a hex encoded hash")
+		}
+	}
+
+	readCtx, err := s.GetBus().GetPersistenceModule().NewReadContext(s.getQueryHeight(0))
+	if err != nil {
+		return 0, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	defer readCtx.Release() //nolint:errcheck // We only need to make sure the readCtx is released
+
+	var resolvedHeight int64
+	if blockHash != nil {
+		resolvedHeight, err = readCtx.GetHeightByBlockHash(*blockHash)
+	} else {
+		resolvedHeight, err = readCtx.GetHeightAtTime(*timestamp)
+	}
+	if err != nil {
+		if coreErr, ok := err.(coreTypes.Error); ok && coreErr.Code() == coreTypes.CodeBlockNotFoundError {
+			return 0, echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return 0, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return resolvedHeight, nil
+}
+
 // checkSortDesc takes a sort string and returns whether to sort descending or not
 func checkSortDesc(sort string) bool {
 	return !strings.EqualFold(sort, "asc")
@@ -94,6 +141,58 @@ func getPageIndexes(totalItems, page, per_page int) (startIdx, endIdx, totalPage
 	return startIdx, endIdx, totalPages, nil
 }
 
+// getPageOffset validates the page requested and returns the offset and limit used to query it from the persistence layer
+func getPageOffset(page, perPage int64) (offset, limit uint64, err error) {
+	if perPage > maxPerPage {
+		return 0, 0, fmt.Errorf("per_page has a max value of %d", maxPerPage)
+	}
+	if page <= 0 || perPage <= 0 {
+		return 0, 0, fmt.Errorf("page and per_page must both be greater than 0")
+	}
+	// the offset must not overflow before being passed to the persistence layer
+	if page > math.MaxInt64/perPage+1 {
+		return 0, 0, fmt.Errorf("page has a max value of %d for a per_page of %d", math.MaxInt64/perPage+1, perPage)
+	}
+	return uint64((page - 1) * perPage), uint64(perPage), nil
+}
+
+// getTotalPages returns the number of pages of size perPage needed to hold totalItems
+func getTotalPages(totalItems int, perPage uint64) int {
+	return int(math.Ceil(float64(totalItems) / float64(perPage)))
+}
+
+// stakeStatusFilters maps the stake statuses accepted by the RPC to their protobuf values
+var stakeStatusFilters = map[string]coreTypes.StakeStatus{
+	"staked":    coreTypes.StakeStatus_Staked,
+	"unstaking": coreTypes.StakeStatus_Unstaking,
+	"unstaked":  coreTypes.StakeStatus_Unstaked,
+}
+
+// toActorQueryFilter converts and validates the optional filters of an actors query
+func toActorQueryFilter(body *QueryActorsPaginated) (*moduleTypes.ActorQueryFilter, error) {
+	filter := &moduleTypes.ActorQueryFilter{}
+	if body.Status != nil && *body.Status != "" {
+		status, ok := stakeStatusFilters[strings.ToLower(*body.Status)]
+		if !ok {
+			return nil, fmt.Errorf("invalid status %q: must be one of staked, unstaking or unstaked", *body.Status)
+		}
+		filter.Status = int32(status)
+	}
+	if body.Chain != nil {
+		filter.Chain = *body.Chain
+	}
+	if body.MinStake != nil {
+		filter.MinStake = *body.MinStake
+	}
+	if body.OutputAddress != nil {
+		filter.OutputAddress = *body.OutputAddress
+	}
+	if err := filter.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
 // protocolActorToRPCProtocolActor converts the coreTypes.Actor to an RPC ProtocolActor
 func protocolActorToRPCProtocolActor(actor *coreTypes.Actor) ProtocolActor {
 	return ProtocolActor{
@@ -163,6 +262,27 @@ func (s *rpcServer) txBytesToRPCTxMsg(txBz []byte, messageType string) (*TxMessa
 			Signature: hex.EncodeToString(sig.GetSignature()),
 		},
 	}
+	if multiSig := tx.GetMultiSignature(); multiSig != nil {
+		multisigKey, err := multiSig.GetPublicKey().ToCrypto()
+		if err != nil {
+			return nil, err
+		}
+		txMsg.MultiSignature = &MultiSignature{
+			Address:    multisigKey.Address().String(),
+			Threshold:  int64(multisigKey.Threshold),
+			PublicKeys: make([]string, 0, len(multisigKey.PublicKeys)),
+			Signatures: make([]Signature, 0, len(multiSig.GetSignatures())),
+		}
+		for _, publicKey := range multisigKey.PublicKeys {
+			txMsg.MultiSignature.PublicKeys = append(txMsg.MultiSignature.PublicKeys, publicKey.String())
+		}
+		for _, sig := range multiSig.GetSignatures() {
+			txMsg.MultiSignature.Signatures = append(txMsg.MultiSignature.Signatures, Signature{
+				PublicKey: hex.EncodeToString(sig.GetPublicKey()),
+				Signature: hex.EncodeToString(sig.GetSignature()),
+			})
+		}
+	}
 	switch messageType {
 	case "MessageSend":
 		m := new(utilTypes.MessageSend)
@@ -288,6 +408,121 @@ func (s *rpcServer) txBytesToRPCTxMsg(txBz []byte, messageType string) (*TxMessa
 				ParameterValue: values[1],
 			},
 		}
+	case "MessageSubmitProposal":
+		m := new(utilTypes.MessageSubmitProposal)
+		if err := anypb.UnmarshalTo(m); err != nil {
+			return nil, err
+		}
+		fee, err := s.calculateMessageFeeForActor(m.GetActorType(), messageType)
+		if err != nil {
+			return nil, err
+		}
+		txMsg.Fee = Fee{
+			Amount: fee,
+			Denom:  "upokt",
+		}
+		description := m.GetDescription()
+		msg := MessageSubmitProposal{
+			Proposer:    hex.EncodeToString(m.GetProposer()),
+			Title:       m.GetTitle(),
+			Description: &description,
+		}
+		if err := setRPCProposalContent(&msg.ParamChange, &msg.FlagChange, &msg.Upgrade, m.GetParamChange(), m.GetFlagChange(), m.GetUpgrade()); err != nil {
+			return nil, err
+		}
+		txMsg.Message = msg
+	case "MessageVote":
+		m := new(utilTypes.MessageVote)
+		if err := anypb.UnmarshalTo(m); err != nil {
+			return nil, err
+		}
+		fee, err := s.calculateMessageFeeForActor(m.GetActorType(), messageType)
+		if err != nil {
+			return nil, err
+		}
+		txMsg.Fee = Fee{
+			Amount: fee,
+			Denom:  "upokt",
+		}
+		txMsg.Message = MessageVote{
+			Signer:     hex.EncodeToString(m.GetSigner()),
+			Voter:      hex.EncodeToString(m.GetVoter()),
+			ProposalId: int64(m.GetProposalId()),
+			Option:     protocolVoteOptionToRPCVoteOptionEnum(m.GetOption()),
+		}
+	case "MessageUpgrade":
+		m := new(utilTypes.MessageUpgrade)
+		if err := anypb.UnmarshalTo(m); err != nil {
+			return nil, err
+		}
+		fee, err := s.calculateMessageFeeForActor(m.GetActorType(), messageType)
+		if err != nil {
+			return nil, err
+		}
+		txMsg.Fee = Fee{
+			Amount: fee,
+			Denom:  "upokt",
+		}
+		txMsg.Message = MessageUpgrade{
+			Signer:  hex.EncodeToString(m.GetSigner()),
+			Version: m.GetVersion(),
+			Height:  m.GetHeight(),
+		}
+	case "MessageDelegate":
+		m := new(utilTypes.MessageDelegate)
+		if err := anypb.UnmarshalTo(m); err != nil {
+			return nil, err
+		}
+		fee, err := s.calculateMessageFeeForActor(m.GetActorType(), messageType)
+		if err != nil {
+			return nil, err
+		}
+		txMsg.Fee = Fee{
+			Amount: fee,
+			Denom:  "upokt",
+		}
+		txMsg.Message = MessageDelegate{
+			Delegator:        hex.EncodeToString(m.GetDelegator()),
+			ValidatorAddress: hex.EncodeToString(m.GetValidatorAddress()),
+			Amount:           m.GetAmount(),
+		}
+	case "MessageUndelegate":
+		m := new(utilTypes.MessageUndelegate)
+		if err := anypb.UnmarshalTo(m); err != nil {
+			return nil, err
+		}
+		fee, err := s.calculateMessageFeeForActor(m.GetActorType(), messageType)
+		if err != nil {
+			return nil, err
+		}
+		txMsg.Fee = Fee{
+			Amount: fee,
+			Denom:  "upokt",
+		}
+		txMsg.Message = MessageUndelegate{
+			Delegator:        hex.EncodeToString(m.GetDelegator()),
+			ValidatorAddress: hex.EncodeToString(m.GetValidatorAddress()),
+			Amount:           m.GetAmount(),
+		}
+	case "MessageRedelegate":
+		m := new(utilTypes.MessageRedelegate)
+		if err := anypb.UnmarshalTo(m); err != nil {
+			return nil, err
+		}
+		fee, err := s.calculateMessageFeeForActor(m.GetActorType(), messageType)
+		if err != nil {
+			return nil, err
+		}
+		txMsg.Fee = Fee{
+			Amount: fee,
+			Denom:  "upokt",
+		}
+		txMsg.Message = MessageRedelegate{
+			Delegator:                   hex.EncodeToString(m.GetDelegator()),
+			SourceValidatorAddress:      hex.EncodeToString(m.GetSourceValidatorAddress()),
+			DestinationValidatorAddress: hex.EncodeToString(m.GetDestinationValidatorAddress()),
+			Amount:                      m.GetAmount(),
+		}
 	default:
 		return nil, fmt.Errorf("unknown message type: %s", messageType)
 	}
@@ -308,6 +543,24 @@ func (s *rpcServer) calculateMessageFeeForActor(actorType coreTypes.ActorType, m
 	if messageType == "MessageChangeParameter" {
 		return readCtx.GetStringParam(utilTypes.MessageChangeParameterFee, height)
 	}
+	if messageType == "MessageSubmitProposal" {
+		return readCtx.GetStringParam(utilTypes.MessageSubmitProposalFee, height)
+	}
+	if messageType == "MessageVote" {
+		return readCtx.GetStringParam(utilTypes.MessageVoteFee, height)
+	}
+	if messageType == "MessageUpgrade" {
+		return readCtx.GetStringParam(utilTypes.MessageUpgradeFee, height)
+	}
+	if messageType == "MessageDelegate" {
+		return readCtx.GetStringParam(utilTypes.MessageDelegateFee, height)
+	}
+	if messageType == "MessageUndelegate" {
+		return readCtx.GetStringParam(utilTypes.MessageUndelegateFee, height)
+	}
+	if messageType == "MessageRedelegate" {
+		return readCtx.GetStringParam(utilTypes.MessageRedelegateFee, height)
+	}
 	switch actorType {
 	case coreTypes.ActorType_ACTOR_TYPE_APP:
 		switch messageType {
@@ -440,6 +693,67 @@ func (s *rpcServer) blockToRPCBlock(protoBlock *coreTypes.Block) (*Block, error)
 	}, nil
 }
 
+// proofTreeKey converts the key of a proof query into the key used by the given state tree
+func proofTreeKey(treeName, key string) ([]byte, error) {
+	switch treeName {
+	case trees.ParamsTreeName, trees.FlagsTreeName:
+		return crypto.SHA3Hash([]byte(key)), nil
+	default:
+		return hex.DecodeString(key)
+	}
+}
+
+// getProofValue returns the serialised value stored at key in the account, pool, actor and proposals state trees.
+// It returns nil for the other trees as their values cannot be rebuilt from the persistence layer.
+func (s *rpcServer) getProofValue(treeName string, key []byte, height int64) ([]byte, error) {
+	readCtx, err := s.GetBus().GetPersistenceModule().NewReadContext(height)
+	if err != nil {
+		return nil, err
+	}
+	defer readCtx.Release() //nolint:errcheck // We only need to make sure the readCtx is released
+
+	var actorType coreTypes.ActorType
+	switch treeName {
+	case trees.AccountTreeName:
+		amount, err := readCtx.GetAccountAmount(key, height)
+		if err != nil {
+			return nil, err
+		}
+		return codec.GetCodec().Marshal(&coreTypes.Account{Address: hex.EncodeToString(key), Amount: amount})
+	case trees.PoolTreeName:
+		amount, err := readCtx.GetPoolAmount(key, height)
+		if err != nil {
+			return nil, err
+		}
+		return codec.GetCodec().Marshal(&coreTypes.Account{Address: hex.EncodeToString(key), Amount: amount})
+	case trees.ProposalsTreeName:
+		if len(key) != 8 {
+			return nil, fmt.Errorf("invalid proposal key length: %d", len(key))
+		}
+		proposal, err := readCtx.GetProposal(binary.BigEndian.Uint64(key), height)
+		if err != nil {
+			return nil, err
+		}
+		return codec.GetCodec().Marshal(proposal)
+	case trees.AppTreeName:
+		actorType = coreTypes.ActorType_ACTOR_TYPE_APP
+	case trees.ValTreeName:
+		actorType = coreTypes.ActorType_ACTOR_TYPE_VAL
+	case trees.FishTreeName:
+		actorType = coreTypes.ActorType_ACTOR_TYPE_FISH
+	case trees.ServicerTreeName:
+		actorType = coreTypes.ActorType_ACTOR_TYPE_SERVICER
+	default:
+		return nil, nil
+	}
+
+	actor, err := readCtx.GetActor(actorType, key, height)
+	if err != nil {
+		return nil, err
+	}
+	return codec.GetCodec().Marshal(actor)
+}
+
 // protocolActorToRPCActorTypeEnum converts a protocol actor type to the rpc actor type enum
 func protocolActorToRPCActorTypeEnum(protocolActorType coreTypes.ActorType) ActorTypesEnum {
 	switch protocolActorType {
@@ -456,6 +770,166 @@ func protocolActorToRPCActorTypeEnum(protocolActorType coreTypes.ActorType) Acto
 	}
 }
 
+// protocolProposalToRPCProposal converts a governance proposal protobuf to the rpc proposal type
+func protocolProposalToRPCProposal(proposal *coreTypes.Proposal) (Proposal, error) {
+	rpcProposal := Proposal{
+		Id:              int64(proposal.GetId()),
+		Proposer:        hex.EncodeToString(proposal.GetProposer()),
+		Title:           proposal.GetTitle(),
+		Description:     proposal.GetDescription(),
+		SubmitHeight:    proposal.GetSubmitHeight(),
+		VotingEndHeight: proposal.GetVotingEndHeight(),
+		Status:          protocolProposalStatusToRPCProposalStatusEnum(proposal.GetStatus()),
+	}
+	if err := setRPCProposalContent(&rpcProposal.ParamChange, &rpcProposal.FlagChange, &rpcProposal.Upgrade, proposal.GetParamChange(), proposal.GetFlagChange(), proposal.GetUpgrade()); err != nil {
+		return Proposal{}, err
+	}
+	if tally := proposal.GetTally(); tally != nil {
+		rpcProposal.Tally = &ProposalTally{
+			Yes:              tally.GetYes(),
+			No:               tally.GetNo(),
+			Abstain:          tally.GetAbstain(),
+			TotalVotingPower: tally.GetTotalVotingPower(),
+		}
+	}
+	return rpcProposal, nil
+}
+
+// setRPCProposalContent sets the rpc content of a proposal from whichever of the protocol contents is set
+func setRPCProposalContent(
+	paramChange **ParamChangeProposal, flagChange **FlagChangeProposal, upgrade **UpgradeProposal,
+	protocolParamChange *coreTypes.ParamChangeProposal, protocolFlagChange *coreTypes.FlagChangeProposal, protocolUpgrade *coreTypes.UpgradeProposal,
+) error {
+	switch {
+	case protocolParamChange != nil:
+		value, err := proposalValueToString(protocolParamChange.GetParameterValue())
+		if err != nil {
+			return err
+		}
+		*paramChange = &ParamChangeProposal{ParameterKey: protocolParamChange.GetParameterKey(), ParameterValue: value}
+	case protocolFlagChange != nil:
+		value, err := proposalValueToString(protocolFlagChange.GetFlagValue())
+		if err != nil {
+			return err
+		}
+		*flagChange = &FlagChangeProposal{FlagKey: protocolFlagChange.GetFlagKey(), FlagValue: value, Enabled: protocolFlagChange.GetEnabled()}
+	case protocolUpgrade != nil:
+		*upgrade = &UpgradeProposal{Version: protocolUpgrade.GetVersion(), Height: protocolUpgrade.GetHeight()}
+	}
+	return nil
+}
+
+// proposalValueToString returns the string representation of the wrapped value of a param or flag change proposal
+func proposalValueToString(value *anypb.Any) (string, error) {
+	msg, err := codec.GetCodec().FromAny(value)
+	if err != nil {
+		return "", err
+	}
+	switch v := msg.(type) {
+	case *wrapperspb.Int32Value:
+		return strconv.Itoa(int(v.GetValue())), nil
+	case *wrapperspb.StringValue:
+		return v.GetValue(), nil
+	case *wrapperspb.BytesValue:
+		return hex.EncodeToString(v.GetValue()), nil
+	default:
+		return "", fmt.Errorf("unsupported proposal value type: %T", msg)
+	}
+}
+
+// protocolVoteToRPCVote converts a governance proposal vote protobuf to the rpc vote type
+func protocolVoteToRPCVote(vote *coreTypes.ProposalVote) ProposalVote {
+	return ProposalVote{
+		ProposalId: int64(vote.GetProposalId()),
+		Voter:      hex.EncodeToString(vote.GetVoter()),
+		Option:     protocolVoteOptionToRPCVoteOptionEnum(vote.GetOption()),
+		Height:     vote.GetHeight(),
+	}
+}
+
+// protocolProposalStatusToRPCProposalStatusEnum converts a protocol proposal status to the rpc proposal status enum
+func protocolProposalStatusToRPCProposalStatusEnum(status coreTypes.ProposalStatus) ProposalStatusEnum {
+	switch status {
+	case coreTypes.ProposalStatus_PROPOSAL_STATUS_PASSED:
+		return Passed
+	case coreTypes.ProposalStatus_PROPOSAL_STATUS_REJECTED:
+		return Rejected
+	case coreTypes.ProposalStatus_PROPOSAL_STATUS_FAILED:
+		return Failed
+	default:
+		return Voting
+	}
+}
+
+// rpcProposalStatusEnumToProtocolProposalStatus converts an rpc proposal status to the protocol one, unspecified if nil
+func rpcProposalStatusEnumToProtocolProposalStatus(status *ProposalStatusEnum) (coreTypes.ProposalStatus, error) {
+	if status == nil {
+		return coreTypes.ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED, nil
+	}
+	switch *status {
+	case Voting:
+		return coreTypes.ProposalStatus_PROPOSAL_STATUS_VOTING, nil
+	case Passed:
+		return coreTypes.ProposalStatus_PROPOSAL_STATUS_PASSED, nil
+	case Rejected:
+		return coreTypes.ProposalStatus_PROPOSAL_STATUS_REJECTED, nil
+	case Failed:
+		return coreTypes.ProposalStatus_PROPOSAL_STATUS_FAILED, nil
+	default:
+		return coreTypes.ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED, fmt.Errorf("invalid proposal status: %s", *status)
+	}
+}
+
+// protocolVoteOptionToRPCVoteOptionEnum converts a protocol vote option to the rpc vote option enum
+func protocolVoteOptionToRPCVoteOptionEnum(option coreTypes.VoteOption) VoteOptionEnum {
+	switch option {
+	case coreTypes.VoteOption_VOTE_OPTION_YES:
+		return Yes
+	case coreTypes.VoteOption_VOTE_OPTION_NO:
+		return No
+	default:
+		return Abstain
+	}
+}
+
+// protocolUpgradeToRPCUpgrade converts a scheduled protocol upgrade to the rpc upgrade type, nil if there is none
+func protocolUpgradeToRPCUpgrade(upgrade *coreTypes.Upgrade) *Upgrade {
+	if upgrade == nil {
+		return nil
+	}
+	return &Upgrade{
+		Version:         upgrade.GetVersion(),
+		Height:          upgrade.GetHeight(),
+		ScheduledHeight: upgrade.GetScheduledHeight(),
+	}
+}
+
+func protocolDelegationsToRPCDelegations(delegations []*coreTypes.Delegation) []Delegation {
+	rpcDelegations := make([]Delegation, 0, len(delegations))
+	for _, delegation := range delegations {
+		rpcDelegations = append(rpcDelegations, Delegation{
+			Delegator: delegation.GetDelegator(),
+			Validator: delegation.GetValidator(),
+			Amount:    delegation.GetAmount(),
+		})
+	}
+	return rpcDelegations
+}
+
+func protocolUnbondingDelegationsToRPCUnbondingDelegations(unbondings []*coreTypes.UnbondingDelegation) []UnbondingDelegation {
+	rpcUnbondings := make([]UnbondingDelegation, 0, len(unbondings))
+	for _, unbonding := range unbondings {
+		rpcUnbondings = append(rpcUnbondings, UnbondingDelegation{
+			Delegator:        unbonding.GetDelegator(),
+			Validator:        unbonding.GetValidator(),
+			Amount:           unbonding.GetAmount(),
+			Height:           unbonding.GetHeight(),
+			CompletionHeight: unbonding.GetCompletionHeight(),
+		})
+	}
+	return rpcUnbondings
+}
+
 // getProtocolActorGetter returns the correct protocol actor getter function based on the actor type parameter
 func getProtocolActorGetter(persistenceContext modules.PersistenceReadContext, params GetV1P2pStakedActorsAddressBookParams) (protocolActorGetter func(height int64) ([]*coreTypes.Actor, error)) {
 	switch *params.ActorType {
@@ -136,6 +136,7 @@ func NewDefaultConfig(options ...func(*Config)) *Config {
 			Port:           defaults.DefaultP2PPort,
 			ConnectionType: defaults.DefaultP2PConnectionType,
 			MaxNonces:      defaults.DefaultP2PMaxNonces,
+			RelayMode:      defaults.DefaultP2PRelayMode,
 		},
 		Telemetry: &TelemetryConfig{
 			Enabled:  defaults.DefaultTelemetryEnabled,
@@ -151,11 +152,13 @@ func NewDefaultConfig(options ...func(*Config)) *Config {
 			Port:    defaults.DefaultRPCPort,
 		},
 		Keybase: &KeybaseConfig{
-			Type:           defaults.DefaultKeybaseType,
-			FilePath:       defaults.DefaultKeybaseFilePath,
-			VaultAddr:      defaults.DefaultKeybaseVaultAddr,
-			VaultToken:     defaults.DefaultKeybaseVaultToken,
-			VaultMountPath: defaults.DefaultKeybaseVaultMountPath,
+			Type:              defaults.DefaultKeybaseType,
+			FilePath:          defaults.DefaultKeybaseFilePath,
+			VaultAddr:         defaults.DefaultKeybaseVaultAddr,
+			VaultToken:        defaults.DefaultKeybaseVaultToken,
+			VaultMountPath:    defaults.DefaultKeybaseVaultMountPath,
+			LedgerTransport:   defaults.DefaultKeybaseLedgerTransport,
+			LedgerNumAccounts: defaults.DefaultKeybaseLedgerNumAccounts,
 		},
 		Validator: &ValidatorConfig{},
 		// INCOMPLETE(#858): use defaultServicerConfig once the default configuration issue is resolved, i.e. once configuring fisherman disables default servicer
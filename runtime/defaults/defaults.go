@@ -24,6 +24,7 @@ func initDefaultRootDirectory() {
 
 const (
 	DefaultRPCPort                          = "50832"
+	DefaultGRPCPort                         = "50833"
 	DefaultBusBufferSize                    = 100
 	DefaultRPCHost                          = "localhost"
 	Validator1EndpointDockerComposeHostname = "validator1"
@@ -39,6 +40,8 @@ var (
 	DefaultNetworkID = "localnet"
 	// consensus
 	DefaultConsensusMaxMempoolBytes = uint64(500000000)
+	// remote signer
+	DefaultRemoteSignerTimeoutMsec = uint64(5000)
 	// pacemaker
 	DefaultPacemakerTimeoutMsec               = uint64(10000)
 	DefaultPacemakerManual                    = true
@@ -54,6 +57,7 @@ var (
 	DefaultP2PUseRainTree    = true
 	DefaultP2PConnectionType = types.ConnectionType_TCPConnection
 	DefaultP2PMaxNonces      = uint64(1e5)
+	DefaultP2PRelayMode      = types.RelayMode_NoRelay
 	// telemetry
 	DefaultTelemetryEnabled  = true
 	DefaultTelemetryAddress  = "0.0.0.0:9000"
@@ -71,6 +75,9 @@ var (
 	DefaultKeybaseVaultAddr      = ""
 	DefaultKeybaseVaultToken     = ""
 	DefaultKeybaseVaultMountPath = ""
+	// ledger
+	DefaultKeybaseLedgerTransport   = "hid"
+	DefaultKeybaseLedgerNumAccounts = uint32(10)
 
 	// ibc
 	DefaultIBCEnabled              = false
@@ -82,6 +82,16 @@ func DefaultParams() *genesis.Params {
 		MessagePauseServicerFee:               utils.BigIntToString(big.NewInt(10000)),
 		MessageUnpauseServicerFee:             utils.BigIntToString(big.NewInt(10000)),
 		MessageChangeParameterFee:             utils.BigIntToString(big.NewInt(10000)),
+		MessageSubmitProposalFee:              utils.BigIntToString(big.NewInt(10000)),
+		MessageVoteFee:                        utils.BigIntToString(big.NewInt(10000)),
+		GovernanceVotingBlocks:                100,
+		GovernanceQuorumPercentage:            33,
+		GovernanceThresholdPercentage:         50,
+		MessageUpgradeFee:                     utils.BigIntToString(big.NewInt(10000)),
+		ValidatorCommissionPercentage:         10,
+		MessageDelegateFee:                    utils.BigIntToString(big.NewInt(10000)),
+		MessageUndelegateFee:                  utils.BigIntToString(big.NewInt(10000)),
+		MessageRedelegateFee:                  utils.BigIntToString(big.NewInt(10000)),
 		AclOwner:                              DefaultParamsOwner.Address().String(),
 		BlocksPerSessionOwner:                 DefaultParamsOwner.Address().String(),
 		AppMinimumStakeOwner:                  DefaultParamsOwner.Address().String(),
@@ -137,5 +147,15 @@ func DefaultParams() *genesis.Params {
 		MessagePauseServicerFeeOwner:          DefaultParamsOwner.Address().String(),
 		MessageUnpauseServicerFeeOwner:        DefaultParamsOwner.Address().String(),
 		MessageChangeParameterFeeOwner:        DefaultParamsOwner.Address().String(),
+		MessageSubmitProposalFeeOwner:         DefaultParamsOwner.Address().String(),
+		MessageVoteFeeOwner:                   DefaultParamsOwner.Address().String(),
+		GovernanceVotingBlocksOwner:           DefaultParamsOwner.Address().String(),
+		GovernanceQuorumPercentageOwner:       DefaultParamsOwner.Address().String(),
+		GovernanceThresholdPercentageOwner:    DefaultParamsOwner.Address().String(),
+		MessageUpgradeFeeOwner:                DefaultParamsOwner.Address().String(),
+		ValidatorCommissionPercentageOwner:    DefaultParamsOwner.Address().String(),
+		MessageDelegateFeeOwner:               DefaultParamsOwner.Address().String(),
+		MessageUndelegateFeeOwner:             DefaultParamsOwner.Address().String(),
+		MessageRedelegateFeeOwner:             DefaultParamsOwner.Address().String(),
 	}
 }
@@ -198,6 +198,27 @@ const (
 	CodeIBCStoreAlreadyExistsError        Code = 146
 	CodeIBCStoreDoesNotExistError         Code = 147
 	CodeIBCKeyDoesNotExistError           Code = 148
+	CodeBlockNotFoundError                Code = 149
+	CodeInvalidMultisigError              Code = 150
+	CodeMultipleSignatureStructuresError  Code = 151
+	CodeEmptyProposalContentError         Code = 152
+	CodeInvalidProposalError              Code = 153
+	CodeProposalNotFoundError             Code = 154
+	CodeProposalNotVotingError            Code = 155
+	CodeInvalidVoteOptionError            Code = 156
+	CodeSetProposalError                  Code = 157
+	CodeGetProposalsError                 Code = 158
+	CodeInvalidProtocolVersionError       Code = 159
+	CodeInvalidUpgradeHeightError         Code = 160
+	CodeProtocolVersionNotSupportedError  Code = 161
+	CodeSetUpgradeError                   Code = 162
+	CodeGetUpgradeError                   Code = 163
+	CodeInsufficientDelegationError       Code = 164
+	CodeInvalidRedelegationError          Code = 165
+	CodeSetDelegationError                Code = 166
+	CodeGetDelegationsError               Code = 167
+	CodeInvalidCommissionPercentageError  Code = 168
+	CodeUpgradeVersionNotIncreasingError  Code = 169
 )
 
 const (
@@ -344,6 +365,27 @@ const (
 	IBCStoreAlreadyExistsError        = "ibc store already exists in the store manager"
 	IBCStoreDoesNotExistError         = "ibc store does not exist in the store manager"
 	IBCKeyDoesNotExistError           = "key does not exist in the ibc store"
+	BlockNotFoundError                = "block not found"
+	InvalidMultisigError              = "the multi-signature is not valid"
+	MultipleSignatureStructuresError  = "the transaction must have either a signature or a multi-signature, not both"
+	EmptyProposalContentError         = "the proposal has no content"
+	InvalidProposalError              = "the proposal is not valid"
+	ProposalNotFoundError             = "the proposal is not found"
+	ProposalNotVotingError            = "the proposal is not open for voting"
+	InvalidVoteOptionError            = "the vote option is not valid"
+	SetProposalError                  = "an error occurred storing the proposal"
+	GetProposalsError                 = "an error occurred getting the proposals"
+	InvalidProtocolVersionError       = "the protocol version is not valid"
+	InvalidUpgradeHeightError         = "the upgrade height is not valid"
+	ProtocolVersionNotSupportedError  = "the protocol version is not supported by this binary"
+	SetUpgradeError                   = "an error occurred scheduling the upgrade"
+	GetUpgradeError                   = "an error occurred getting the protocol upgrades"
+	InsufficientDelegationError       = "the delegated amount is insufficient"
+	InvalidRedelegationError          = "the redelegation is not valid"
+	SetDelegationError                = "an error occurred storing the delegation"
+	GetDelegationsError               = "an error occurred getting the delegations"
+	InvalidCommissionPercentageError  = "the validator commission percentage is not valid"
+	UpgradeVersionNotIncreasingError  = "the upgrade version must be greater than the active and pending protocol versions"
 )
 
 func ErrUnknownParam(paramName string) Error {
@@ -922,3 +964,87 @@ func ErrIBCStoreDoesNotExist(name string) Error {
 func ErrIBCKeyDoesNotExist(key string) Error {
 	return NewError(CodeIBCKeyDoesNotExistError, fmt.Sprintf("%s: %s", IBCKeyDoesNotExistError, key))
 }
+
+func ErrBlockNotFound(selector string) Error {
+	return NewError(CodeBlockNotFoundError, fmt.Sprintf("%s: %s", BlockNotFoundError, selector))
+}
+
+func ErrInvalidMultisig(err error) Error {
+	return NewError(CodeInvalidMultisigError, fmt.Sprintf("%s: %s", InvalidMultisigError, err.Error()))
+}
+
+func ErrMultipleSignatureStructures() Error {
+	return NewError(CodeMultipleSignatureStructuresError, MultipleSignatureStructuresError)
+}
+
+func ErrEmptyProposalContent() Error {
+	return NewError(CodeEmptyProposalContentError, EmptyProposalContentError)
+}
+
+func ErrInvalidProposal(reason string) Error {
+	return NewError(CodeInvalidProposalError, fmt.Sprintf("%s: %s", InvalidProposalError, reason))
+}
+
+func ErrProposalNotFound(proposalID uint64) Error {
+	return NewError(CodeProposalNotFoundError, fmt.Sprintf("%s: %d", ProposalNotFoundError, proposalID))
+}
+
+func ErrProposalNotVoting(proposalID uint64, status ProposalStatus) Error {
+	return NewError(CodeProposalNotVotingError, fmt.Sprintf("%s: proposal %d is %s", ProposalNotVotingError, proposalID, status))
+}
+
+func ErrInvalidVoteOption(option VoteOption) Error {
+	return NewError(CodeInvalidVoteOptionError, fmt.Sprintf("%s: %s", InvalidVoteOptionError, option))
+}
+
+func ErrSetProposal(err error) Error {
+	return NewError(CodeSetProposalError, fmt.Sprintf("%s: %s", SetProposalError, err.Error()))
+}
+
+func ErrGetProposals(err error) Error {
+	return NewError(CodeGetProposalsError, fmt.Sprintf("%s: %s", GetProposalsError, err.Error()))
+}
+
+func ErrInvalidProtocolVersion(version string) Error {
+	return NewError(CodeInvalidProtocolVersionError, fmt.Sprintf("%s: %q", InvalidProtocolVersionError, version))
+}
+
+func ErrInvalidUpgradeHeight(upgradeHeight, height int64) Error {
+	return NewError(CodeInvalidUpgradeHeightError, fmt.Sprintf("%s: %d is not after the current height %d", InvalidUpgradeHeightError, upgradeHeight, height))
+}
+
+func ErrProtocolVersionNotSupported(binaryVersion, protocolVersion string, height int64) Error {
+	return NewError(CodeProtocolVersionNotSupportedError, fmt.Sprintf("%s: version %s is required from height %d but the binary is %s", ProtocolVersionNotSupportedError, protocolVersion, height, binaryVersion))
+}
+
+func ErrSetUpgrade(err error) Error {
+	return NewError(CodeSetUpgradeError, fmt.Sprintf("%s: %s", SetUpgradeError, err.Error()))
+}
+
+func ErrGetUpgrade(err error) Error {
+	return NewError(CodeGetUpgradeError, fmt.Sprintf("%s: %s", GetUpgradeError, err.Error()))
+}
+
+func ErrInsufficientDelegation(delegator, validator string) Error {
+	return NewError(CodeInsufficientDelegationError, fmt.Sprintf("%s: delegator %s to validator %s", InsufficientDelegationError, delegator, validator))
+}
+
+func ErrInvalidRedelegation(reason string) Error {
+	return NewError(CodeInvalidRedelegationError, fmt.Sprintf("%s: %s", InvalidRedelegationError, reason))
+}
+
+func ErrSetDelegation(err error) Error {
+	return NewError(CodeSetDelegationError, fmt.Sprintf("%s: %s", SetDelegationError, err.Error()))
+}
+
+func ErrGetDelegations(err error) Error {
+	return NewError(CodeGetDelegationsError, fmt.Sprintf("%s: %s", GetDelegationsError, err.Error()))
+}
+
+func ErrInvalidCommissionPercentage(percentage int) Error {
+	return NewError(CodeInvalidCommissionPercentageError, fmt.Sprintf("%s: %d", InvalidCommissionPercentageError, percentage))
+}
+
+func ErrUpgradeVersionNotIncreasing(version, currentVersion string) Error {
+	return NewError(CodeUpgradeVersionNotIncreasingError, fmt.Sprintf("%s: %s is not greater than %s", UpgradeVersionNotIncreasingError, version, currentVersion))
+}
@@ -1,5 +1,13 @@
 package types
 
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/pokt-network/pocket/shared/crypto"
+)
+
 func (s *Signature) ValidateBasic() error {
 	if s.Signature == nil {
 		return ErrEmptySignature()
@@ -9,3 +17,93 @@ func (s *Signature) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewMultisigPublicKey converts a multisig public key to its protobuf representation
+func NewMultisigPublicKey(key *crypto.MultisigPublicKey) *MultisigPublicKey {
+	publicKeys := make([][]byte, 0, len(key.PublicKeys))
+	for _, publicKey := range key.PublicKeys {
+		publicKeys = append(publicKeys, publicKey.Bytes())
+	}
+	return &MultisigPublicKey{
+		Threshold:  key.Threshold,
+		PublicKeys: publicKeys,
+	}
+}
+
+// ToCrypto validates the multisig public key and converts it to the type used to derive its address and verify its signatures
+func (m *MultisigPublicKey) ToCrypto() (*crypto.MultisigPublicKey, error) {
+	return crypto.NewMultisigPublicKeyFromBytes(m.GetThreshold(), m.GetPublicKeys())
+}
+
+// ValidateBasic also ensures the multi-signature is in its canonical form: the public keys of the members are sorted
+// and exactly `Threshold` signatures are provided in the order of the members. Otherwise, the signatures and the
+// public keys could be reordered, or a surplus signature added, to change the hash of a signed transaction and replay it.
+func (s *MultiSignature) ValidateBasic() error {
+	if s.PublicKey == nil {
+		return ErrEmptyPublicKey()
+	}
+	key, err := s.PublicKey.ToCrypto()
+	if err != nil {
+		return ErrInvalidMultisig(err)
+	}
+	for i, publicKey := range s.PublicKey.PublicKeys {
+		if !bytes.Equal(publicKey, key.PublicKeys[i].Bytes()) {
+			return ErrInvalidMultisig(crypto.ErrUnsortedMultisigKeys())
+		}
+	}
+	if numSignatures := uint32(len(s.Signatures)); numSignatures != key.Threshold {
+		return ErrInvalidMultisig(crypto.ErrInvalidMultisigSigCount(numSignatures, key.Threshold))
+	}
+	for i, signature := range s.Signatures {
+		if err := signature.ValidateBasic(); err != nil {
+			return err
+		}
+		if i > 0 && bytes.Compare(s.Signatures[i-1].GetPublicKey(), signature.GetPublicKey()) >= 0 {
+			return ErrInvalidMultisig(crypto.ErrUnsortedMultisigSigs())
+		}
+	}
+	return nil
+}
+
+// AddSignature adds the signature of a member of the multisig in the order of the members, replacing the previous
+// signature of that member if any. No more signatures can be added once the threshold is met.
+func (s *MultiSignature) AddSignature(signature *Signature) error {
+	key, err := s.GetPublicKey().ToCrypto()
+	if err != nil {
+		return err
+	}
+	if !key.IsMember(signature.GetPublicKey()) {
+		return crypto.ErrNotMultisigMember(fmt.Sprintf("%x", signature.GetPublicKey()))
+	}
+	idx := sort.Search(len(s.Signatures), func(i int) bool {
+		return bytes.Compare(s.Signatures[i].GetPublicKey(), signature.GetPublicKey()) >= 0
+	})
+	if idx < len(s.Signatures) && bytes.Equal(s.Signatures[idx].GetPublicKey(), signature.GetPublicKey()) {
+		s.Signatures[idx] = signature
+		return nil
+	}
+	if uint32(len(s.Signatures)) >= key.Threshold {
+		return crypto.ErrMultisigThresholdMet(key.Threshold)
+	}
+	s.Signatures = append(s.Signatures, nil)
+	copy(s.Signatures[idx+1:], s.Signatures[idx:])
+	s.Signatures[idx] = signature
+	return nil
+}
+
+// Verify checks that enough distinct members of the multisig signed the message
+func (s *MultiSignature) Verify(msg []byte) error {
+	key, err := s.GetPublicKey().ToCrypto()
+	if err != nil {
+		return err
+	}
+	signatures := make(map[string][]byte, len(s.Signatures))
+	for _, signature := range s.Signatures {
+		publicKey := string(signature.GetPublicKey())
+		if _, ok := signatures[publicKey]; ok {
+			return crypto.ErrDuplicateMultisigPublicKey(fmt.Sprintf("%x", signature.GetPublicKey()))
+		}
+		signatures[publicKey] = signature.GetSignature()
+	}
+	return key.Verify(msg, signatures)
+}
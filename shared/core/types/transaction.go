@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"fmt"
 
 	"github.com/pokt-network/pocket/shared/codec"
 	"github.com/pokt-network/pocket/shared/crypto"
@@ -42,7 +43,13 @@ func (tx *Transaction) ValidateBasic() error {
 		return ErrEmptyNonce()
 	}
 
-	// Is there a signature we can verify?
+	// Is there a single signature or a multi-signature we can verify?
+	if tx.Signature != nil && tx.MultiSignature != nil {
+		return ErrMultipleSignatureStructures()
+	}
+	if tx.MultiSignature != nil {
+		return tx.validateBasicMultiSignature()
+	}
 	if tx.Signature == nil {
 		return ErrEmptySignatureStructure()
 	}
@@ -73,6 +80,49 @@ func (tx *Transaction) ValidateBasic() error {
 	return nil
 }
 
+// validateBasicMultiSignature verifies that the threshold of the multisig is met by valid signatures of its members
+func (tx *Transaction) validateBasicMultiSignature() error {
+	if err := tx.MultiSignature.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// Is there a valid msg that can be decoded?
+	if _, err := tx.GetMessage(); err != nil {
+		return ErrDecodeMessage(err)
+	}
+
+	signBytes, err := tx.SignableBytes()
+	if err != nil {
+		return ErrRetrievingSignableBytes(err)
+	}
+
+	if err := tx.MultiSignature.Verify(signBytes); err != nil {
+		return ErrInvalidMultisig(err)
+	}
+
+	return nil
+}
+
+// SignerAddress returns the address of the signer of the transaction, i.e. the address of the multisig
+// for multi-signed transactions. The signatures are not verified.
+func (tx *Transaction) SignerAddress() (crypto.Address, Error) {
+	if tx.MultiSignature != nil {
+		key, err := tx.MultiSignature.GetPublicKey().ToCrypto()
+		if err != nil {
+			return nil, ErrInvalidMultisig(err)
+		}
+		return key.Address(), nil
+	}
+	if tx.Signature == nil {
+		return nil, ErrEmptySignatureStructure()
+	}
+	publicKey, err := crypto.NewPublicKeyFromBytes(tx.Signature.PublicKey)
+	if err != nil {
+		return nil, ErrNewPublicKeyFromBytes(err)
+	}
+	return publicKey.Address(), nil
+}
+
 func (tx *Transaction) GetMessage() (proto.Message, error) {
 	anyMsg, err := codec.GetCodec().FromAny(tx.Msg)
 	if err != nil {
@@ -97,6 +147,89 @@ func (tx *Transaction) Sign(privateKey crypto.PrivateKey) error {
 	return nil
 }
 
+// SignMultisig adds the signature of a member of the multisig to the transaction. The members can sign
+// copies of the transaction independently and their signatures can be combined with CombineMultiSignature.
+func (tx *Transaction) SignMultisig(privateKey crypto.PrivateKey, multisigKey *MultisigPublicKey) error {
+	txSignableBz, err := tx.SignableBytes()
+	if err != nil {
+		return err
+	}
+	signature, err := privateKey.Sign(txSignableBz)
+	if err != nil {
+		return err
+	}
+	return tx.AddMultisigSignature(multisigKey, &Signature{
+		PublicKey: privateKey.PublicKey().Bytes(),
+		Signature: signature,
+	})
+}
+
+// AddMultisigSignature adds the signature of the signable bytes of the transaction by a member of the multisig,
+// e.g. when it was produced by a signer that does not expose its private key
+func (tx *Transaction) AddMultisigSignature(multisigKey *MultisigPublicKey, signature *Signature) error {
+	if tx.Signature != nil {
+		return ErrMultipleSignatureStructures()
+	}
+	key, err := multisigKey.ToCrypto()
+	if err != nil {
+		return ErrInvalidMultisig(err)
+	}
+	if tx.MultiSignature == nil {
+		tx.MultiSignature = &MultiSignature{PublicKey: NewMultisigPublicKey(key)}
+	} else if err := tx.validateSameMultisig(key); err != nil {
+		return err
+	}
+	if err := tx.MultiSignature.AddSignature(signature); err != nil {
+		return ErrInvalidMultisig(err)
+	}
+	return nil
+}
+
+// CombineMultiSignature adds the signatures of the multisig members of another copy of the same transaction
+func (tx *Transaction) CombineMultiSignature(other *Transaction) error {
+	if tx.MultiSignature == nil || other.MultiSignature == nil {
+		return ErrEmptySignatureStructure()
+	}
+	otherKey, err := other.MultiSignature.GetPublicKey().ToCrypto()
+	if err != nil {
+		return ErrInvalidMultisig(err)
+	}
+	if err := tx.validateSameMultisig(otherKey); err != nil {
+		return err
+	}
+
+	signBytes, err := tx.SignableBytes()
+	if err != nil {
+		return ErrRetrievingSignableBytes(err)
+	}
+	otherSignBytes, err := other.SignableBytes()
+	if err != nil {
+		return ErrRetrievingSignableBytes(err)
+	}
+	if !bytes.Equal(signBytes, otherSignBytes) {
+		return ErrInvalidMultisig(fmt.Errorf("the transactions to combine are different"))
+	}
+
+	for _, signature := range other.MultiSignature.Signatures {
+		if err := tx.MultiSignature.AddSignature(signature); err != nil {
+			return ErrInvalidMultisig(err)
+		}
+	}
+	return nil
+}
+
+// validateSameMultisig checks that the multi-signature of the transaction belongs to the given multisig
+func (tx *Transaction) validateSameMultisig(key *crypto.MultisigPublicKey) error {
+	txKey, err := tx.MultiSignature.GetPublicKey().ToCrypto()
+	if err != nil {
+		return ErrInvalidMultisig(err)
+	}
+	if !txKey.Address().Equals(key.Address()) {
+		return ErrInvalidMultisig(fmt.Errorf("the transaction is signed by the multisig %s", txKey.Address()))
+	}
+	return nil
+}
+
 func (tx *Transaction) Hash() (string, error) {
 	txProtoBz, err := tx.Bytes()
 	if err != nil {
@@ -107,10 +240,11 @@ func (tx *Transaction) Hash() (string, error) {
 
 // The bytes of the transaction that should have been signed.
 func (tx *Transaction) SignableBytes() ([]byte, error) {
-	// All the contents of the transaction (including the nonce), with the exception of the signature
+	// All the contents of the transaction (including the nonce), with the exception of the signatures
 	// need to be signed by the signer.
 	txCopy := codec.GetCodec().Clone(tx).(*Transaction)
 	txCopy.Signature = nil
+	txCopy.MultiSignature = nil
 	return codec.GetCodec().Marshal(txCopy)
 }
 
@@ -1,7 +1,9 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
 	"testing"
 
 	"github.com/pokt-network/pocket/shared/codec"
@@ -86,6 +88,166 @@ func TestTransaction_ValidateBasic(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestTransaction_ValidateBasic_MultiSignature(t *testing.T) {
+	privateKeys, multisigKey := newTestingMultisig(t, 2, 3)
+
+	tx := newUnsignedTestingTransaction(t)
+	require.NoError(t, tx.SignMultisig(privateKeys[0], multisigKey))
+	require.Error(t, tx.ValidateBasic(), "the threshold is not met")
+
+	// Signing again with the same member replaces its signature
+	require.NoError(t, tx.SignMultisig(privateKeys[0], multisigKey))
+	require.Len(t, tx.MultiSignature.Signatures, 1)
+	require.Error(t, tx.ValidateBasic(), "the threshold is not met")
+
+	require.NoError(t, tx.SignMultisig(privateKeys[1], multisigKey))
+	require.NoError(t, tx.ValidateBasic())
+
+	expectedKey, err := multisigKey.ToCrypto()
+	require.NoError(t, err)
+	address, err := tx.SignerAddress()
+	require.NoError(t, err)
+	require.Equal(t, expectedKey.Address(), address)
+
+	nonMember, err := crypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	require.Error(t, tx.SignMultisig(nonMember, multisigKey), "only members can sign")
+
+	txWithBothSignatures := proto.Clone(&tx).(*Transaction)
+	require.NoError(t, txWithBothSignatures.Sign(privateKeys[0]))
+	require.Error(t, txWithBothSignatures.ValidateBasic())
+
+	txDuplicateSignature := proto.Clone(&tx).(*Transaction)
+	txDuplicateSignature.MultiSignature.Signatures[1] = txDuplicateSignature.MultiSignature.Signatures[0]
+	require.Error(t, txDuplicateSignature.ValidateBasic(), "a member cannot count twice towards the threshold")
+
+	txInvalidSignature := proto.Clone(&tx).(*Transaction)
+	txInvalidSignature.MultiSignature.Signatures[1].Signature = []byte("signature2")
+	require.Error(t, txInvalidSignature.ValidateBasic())
+
+	txInvalidThreshold := proto.Clone(&tx).(*Transaction)
+	txInvalidThreshold.MultiSignature.PublicKey.Threshold = 4
+	require.Error(t, txInvalidThreshold.ValidateBasic())
+
+	txDifferentNonce := proto.Clone(&tx).(*Transaction)
+	txDifferentNonce.Nonce = "different"
+	require.Error(t, txDifferentNonce.ValidateBasic(), "the signatures must cover the nonce")
+}
+
+func TestTransaction_ValidateBasic_MultiSignatureCanonical(t *testing.T) {
+	privateKeys, multisigKey := newTestingMultisig(t, 2, 3)
+
+	tx := newUnsignedTestingTransaction(t)
+	// the signatures are kept in the order of the members regardless of the order the members sign in
+	require.NoError(t, tx.SignMultisig(privateKeys[2], multisigKey))
+	require.NoError(t, tx.SignMultisig(privateKeys[0], multisigKey))
+	require.NoError(t, tx.ValidateBasic())
+	require.Equal(t, privateKeys[0].PublicKey().Bytes(), tx.MultiSignature.Signatures[0].PublicKey)
+	require.Equal(t, privateKeys[2].PublicKey().Bytes(), tx.MultiSignature.Signatures[1].PublicKey)
+
+	require.Error(t, tx.SignMultisig(privateKeys[1], multisigKey), "no signature can be added once the threshold is met")
+
+	// the variants of the transaction signed by the same members must be rejected, as their hash differs
+	txReorderedSignatures := proto.Clone(&tx).(*Transaction)
+	signatures := txReorderedSignatures.MultiSignature.Signatures
+	signatures[0], signatures[1] = signatures[1], signatures[0]
+	require.Error(t, txReorderedSignatures.ValidateBasic(), "the signatures must be in the order of the members")
+
+	txReorderedKeys := proto.Clone(&tx).(*Transaction)
+	publicKeys := txReorderedKeys.MultiSignature.PublicKey.PublicKeys
+	publicKeys[0], publicKeys[2] = publicKeys[2], publicKeys[0]
+	require.Error(t, txReorderedKeys.ValidateBasic(), "the public keys must be sorted")
+
+	txSurplusSignature := proto.Clone(&tx).(*Transaction)
+	signBytes, err := txSurplusSignature.SignableBytes()
+	require.NoError(t, err)
+	surplusSignature, err := privateKeys[1].Sign(signBytes)
+	require.NoError(t, err)
+	txSurplusSignature.MultiSignature.Signatures = []*Signature{
+		tx.MultiSignature.Signatures[0],
+		{PublicKey: privateKeys[1].PublicKey().Bytes(), Signature: surplusSignature},
+		tx.MultiSignature.Signatures[1],
+	}
+	require.Error(t, txSurplusSignature.ValidateBasic(), "exactly threshold signatures must be provided")
+}
+
+func TestTransaction_CombineMultiSignature(t *testing.T) {
+	privateKeys, multisigKey := newTestingMultisig(t, 2, 3)
+
+	tx := newUnsignedTestingTransaction(t)
+	tx1 := proto.Clone(&tx).(*Transaction)
+	require.NoError(t, tx1.SignMultisig(privateKeys[0], multisigKey))
+	tx2 := proto.Clone(&tx).(*Transaction)
+	require.NoError(t, tx2.SignMultisig(privateKeys[2], multisigKey))
+
+	require.NoError(t, tx1.CombineMultiSignature(tx2))
+	require.Len(t, tx1.MultiSignature.Signatures, 2)
+	require.NoError(t, tx1.ValidateBasic())
+
+	otherTx := newUnsignedTestingTransaction(t)
+	otherTx.Nonce = "other"
+	require.NoError(t, otherTx.SignMultisig(privateKeys[1], multisigKey))
+	require.Error(t, tx1.CombineMultiSignature(&otherTx), "the transactions must be the same")
+
+	_, otherMultisigKey := newTestingMultisig(t, 1, 2)
+	txOtherMultisig := proto.Clone(&tx).(*Transaction)
+	require.NoError(t, txOtherMultisig.SignMultisig(privateKeys[1], &MultisigPublicKey{
+		Threshold:  1,
+		PublicKeys: append(otherMultisigKey.PublicKeys, privateKeys[1].PublicKey().Bytes()),
+	}))
+	require.Error(t, tx1.CombineMultiSignature(txOtherMultisig), "the transactions must be signed by the same multisig")
+}
+
+func TestMultisigPublicKey_Address(t *testing.T) {
+	privateKeys, multisigKey := newTestingMultisig(t, 2, 3)
+	key, err := multisigKey.ToCrypto()
+	require.NoError(t, err)
+
+	reversed := &MultisigPublicKey{Threshold: 2}
+	for i := len(privateKeys) - 1; i >= 0; i-- {
+		reversed.PublicKeys = append(reversed.PublicKeys, privateKeys[i].PublicKey().Bytes())
+	}
+	reversedKey, err := reversed.ToCrypto()
+	require.NoError(t, err)
+	require.Equal(t, key.Address(), reversedKey.Address(), "the address must not depend on the order of the members")
+
+	otherThreshold := proto.Clone(multisigKey).(*MultisigPublicKey)
+	otherThreshold.Threshold = 3
+	otherThresholdKey, err := otherThreshold.ToCrypto()
+	require.NoError(t, err)
+	require.NotEqual(t, key.Address(), otherThresholdKey.Address(), "the address must depend on the threshold")
+
+	for _, invalid := range []*MultisigPublicKey{
+		{Threshold: 0, PublicKeys: multisigKey.PublicKeys},
+		{Threshold: 1},
+		{Threshold: 2, PublicKeys: [][]byte{multisigKey.PublicKeys[0], multisigKey.PublicKeys[0]}},
+		{Threshold: 1, PublicKeys: [][]byte{[]byte("publickey")}},
+	} {
+		_, err := invalid.ToCrypto()
+		require.Error(t, err)
+	}
+}
+
+func newTestingMultisig(t *testing.T, threshold uint32, numMembers int) ([]crypto.PrivateKey, *MultisigPublicKey) {
+	t.Helper()
+
+	privateKeys := make([]crypto.PrivateKey, 0, numMembers)
+	publicKeys := make([]crypto.PublicKey, 0, numMembers)
+	for i := 0; i < numMembers; i++ {
+		privateKey, err := crypto.GeneratePrivateKey()
+		require.NoError(t, err)
+		privateKeys = append(privateKeys, privateKey)
+		publicKeys = append(publicKeys, privateKey.PublicKey())
+	}
+	// the private keys are returned in the order of the members of the multisig
+	sort.Slice(privateKeys, func(i, j int) bool {
+		return bytes.Compare(privateKeys[i].PublicKey().Bytes(), privateKeys[j].PublicKey().Bytes()) < 0
+	})
+	multisigKey, err := crypto.NewMultisigPublicKey(threshold, publicKeys)
+	require.NoError(t, err)
+	return privateKeys, NewMultisigPublicKey(multisigKey)
+}
+
 func newUnsignedTestingTransaction(t *testing.T) Transaction {
 	txMsg := &Transaction{}
 	anyMsg, err := codec.GetCodec().ToAny(txMsg)
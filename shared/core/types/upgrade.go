@@ -0,0 +1,19 @@
+package types
+
+import "golang.org/x/mod/semver"
+
+// GenesisProtocolVersion is the protocol version active from genesis until the first upgrade
+const GenesisProtocolVersion = "v0.0.0"
+
+// ValidateProtocolVersion ensures the version is a canonical semantic version, e.g. v1.2.0 or v1.2.0-rc.1
+func ValidateProtocolVersion(version string) Error {
+	if !semver.IsValid(version) || semver.Canonical(version) != version {
+		return ErrInvalidProtocolVersion(version)
+	}
+	return nil
+}
+
+// SupportsProtocolVersion returns true if a binary of version `binaryVersion` can apply the blocks of `protocolVersion`
+func SupportsProtocolVersion(binaryVersion, protocolVersion string) bool {
+	return semver.Compare(binaryVersion, protocolVersion) >= 0
+}
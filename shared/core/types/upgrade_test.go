@@ -0,0 +1,40 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateProtocolVersion(t *testing.T) {
+	tests := []struct {
+		version string
+		valid   bool
+	}{
+		{"v1.2.0", true},
+		{"v1.2.0-rc.1", true},
+		{"v0.0.1-pre-alpha.1", true},
+		{"1.2.0", false},
+		{"v1.2", false},
+		{"v1.2.0+build", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			err := ValidateProtocolVersion(tt.version)
+			if tt.valid {
+				require.Nil(t, err)
+				return
+			}
+			require.Equal(t, CodeInvalidProtocolVersionError, err.Code())
+		})
+	}
+}
+
+func TestSupportsProtocolVersion(t *testing.T) {
+	require.True(t, SupportsProtocolVersion("v1.2.0", "v1.2.0"))
+	require.True(t, SupportsProtocolVersion("v1.3.0", "v1.2.0"))
+	require.True(t, SupportsProtocolVersion("v0.0.1-pre-alpha.1", GenesisProtocolVersion))
+	require.False(t, SupportsProtocolVersion("v1.2.0-rc.1", "v1.2.0"))
+	require.False(t, SupportsProtocolVersion("v1.1.9", "v1.2.0"))
+}
@@ -0,0 +1,45 @@
+package bip39
+
+import (
+	"fmt"
+	"strings"
+
+	goBip39 "github.com/tyler-smith/go-bip39"
+)
+
+const (
+	// DefaultMnemonicWords is the number of words of the mnemonics generated by default, i.e. 256 bits of entropy
+	DefaultMnemonicWords = 24
+	// Each word of a mnemonic encodes 11 bits: 32 bits of entropy and 1 bit of checksum for every 3 words
+	// Ref: https://github.com/bitcoin/bips/blob/master/bip-0039.mediawiki#generating-the-mnemonic
+	entropyBitsPerThreeWords = 32
+)
+
+var ErrInvalidMnemonic = fmt.Errorf("invalid BIP-39 mnemonic")
+
+// NewMnemonic generates a new random mnemonic of the given number of words, which must be one of 12, 15, 18, 21 or 24
+func NewMnemonic(numWords int) (string, error) {
+	if numWords < 12 || numWords > 24 || numWords%3 != 0 {
+		return "", fmt.Errorf("invalid number of mnemonic words: got %d, want one of 12, 15, 18, 21 or 24", numWords)
+	}
+
+	entropy, err := goBip39.NewEntropy(numWords / 3 * entropyBitsPerThreeWords)
+	if err != nil {
+		return "", err
+	}
+
+	return goBip39.NewMnemonic(entropy)
+}
+
+// NewSeed validates the mnemonic and returns the seed it encodes with the optional BIP-39 passphrase
+// The words of the mnemonic are case-insensitive and can be separated by any whitespace
+// The seed can be used to derive keys with `slip.DeriveChild`
+// Ref: https://github.com/bitcoin/bips/blob/master/bip-0039.mediawiki#from-mnemonic-to-seed
+func NewSeed(mnemonic, passphrase string) ([]byte, error) {
+	mnemonic = strings.Join(strings.Fields(strings.ToLower(mnemonic)), " ")
+	seed, err := goBip39.NewSeedWithErrorChecking(mnemonic, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidMnemonic, err.Error())
+	}
+	return seed, nil
+}
@@ -0,0 +1,71 @@
+package bip39
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// Test vector from https://github.com/trezor/python-mnemonic/blob/master/vectors.json
+	testMnemonic           = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	testMnemonicPassphrase = "TREZOR"
+	testSeedHex            = "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04"
+)
+
+func TestBip39_NewSeed(t *testing.T) {
+	tests := []struct {
+		name     string
+		mnemonic string
+		wantErr  bool
+	}{
+		{
+			name:     "seed matches the test vector",
+			mnemonic: testMnemonic,
+		},
+		{
+			name:     "mnemonic is case and whitespace insensitive",
+			mnemonic: "  ABANDON abandon\tabandon abandon abandon abandon abandon abandon abandon abandon abandon\nabout ",
+		},
+		{
+			name:     "mnemonic with an invalid checksum is rejected",
+			mnemonic: strings.Repeat("abandon ", 12),
+			wantErr:  true,
+		},
+		{
+			name:     "mnemonic with an unknown word is rejected",
+			mnemonic: strings.Replace(testMnemonic, "about", "pocket", 1),
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seed, err := NewSeed(tt.mnemonic, testMnemonicPassphrase)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidMnemonic)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, testSeedHex, hex.EncodeToString(seed))
+		})
+	}
+}
+
+func TestBip39_NewMnemonic(t *testing.T) {
+	for _, numWords := range []int{12, 15, 18, 21, 24} {
+		mnemonic, err := NewMnemonic(numWords)
+		require.NoError(t, err)
+		require.Len(t, strings.Fields(mnemonic), numWords)
+
+		_, err = NewSeed(mnemonic, "")
+		require.NoError(t, err)
+	}
+
+	for _, numWords := range []int{0, 11, 13, 27} {
+		_, err := NewMnemonic(numWords)
+		require.Error(t, err)
+	}
+}
@@ -15,6 +15,16 @@ const (
 	InvalidPublicKeyLenError      = "the public key length is not valid"
 	CreatePublicKeyError          = "an error occurred creating the public key"
 	decodePrivateKeyError         = "decoding private key"
+	InvalidMultisigSizeError      = "the number of public keys of the multisig is not valid"
+	InvalidMultisigThresholdError = "the threshold of the multisig is not valid"
+	DuplicateMultisigKeyError     = "the multisig has a duplicate public key"
+	NotMultisigMemberError        = "the public key is not a member of the multisig"
+	InvalidMultisigSignatureError = "the signature of the multisig member is not valid"
+	MultisigThresholdNotMetError  = "the multisig threshold is not met"
+	MultisigThresholdMetError     = "the multisig threshold is already met"
+	UnsortedMultisigKeysError     = "the public keys of the multisig are not sorted"
+	UnsortedMultisigSigsError     = "the signatures of the multisig are not in the order of its public keys"
+	InvalidMultisigSigCountError  = "the number of signatures of the multisig is not its threshold"
 )
 
 func ErrInvalidAddressLen(length int) error {
@@ -52,3 +62,43 @@ func ErrCreatePublicKey(err error) error {
 func errDecodePrivateKey(err error) error {
 	return fmt.Errorf("%s; %w", decodePrivateKeyError, err)
 }
+
+func ErrInvalidMultisigSize(numKeys int) error {
+	return fmt.Errorf("%s, expected between 1 and %d, actual %d", InvalidMultisigSizeError, MaxMultisigPublicKeys, numKeys)
+}
+
+func ErrInvalidMultisigThreshold(threshold uint32, numKeys int) error {
+	return fmt.Errorf("%s, expected between 1 and %d, actual %d", InvalidMultisigThresholdError, numKeys, threshold)
+}
+
+func ErrDuplicateMultisigPublicKey(publicKey string) error {
+	return fmt.Errorf("%s: %s", DuplicateMultisigKeyError, publicKey)
+}
+
+func ErrNotMultisigMember(publicKey string) error {
+	return fmt.Errorf("%s: %s", NotMultisigMemberError, publicKey)
+}
+
+func ErrInvalidMultisigSignature(publicKey string) error {
+	return fmt.Errorf("%s: %s", InvalidMultisigSignatureError, publicKey)
+}
+
+func ErrMultisigThresholdNotMet(numSignatures, threshold uint32) error {
+	return fmt.Errorf("%s, expected %d signatures, actual %d", MultisigThresholdNotMetError, threshold, numSignatures)
+}
+
+func ErrMultisigThresholdMet(threshold uint32) error {
+	return fmt.Errorf("%s, %d signatures", MultisigThresholdMetError, threshold)
+}
+
+func ErrUnsortedMultisigKeys() error {
+	return fmt.Errorf("%s", UnsortedMultisigKeysError)
+}
+
+func ErrUnsortedMultisigSigs() error {
+	return fmt.Errorf("%s", UnsortedMultisigSigsError)
+}
+
+func ErrInvalidMultisigSigCount(numSignatures, threshold uint32) error {
+	return fmt.Errorf("%s, expected %d signatures, actual %d", InvalidMultisigSigCountError, threshold, numSignatures)
+}
@@ -0,0 +1,108 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"sort"
+)
+
+const (
+	// MaxMultisigPublicKeys is the maximum number of members of a multisig
+	MaxMultisigPublicKeys = 32
+	// multisigAddressPrefix separates the addresses of the multisigs from the addresses of the single public keys
+	multisigAddressPrefix = "multisig"
+)
+
+// MultisigPublicKey is an m-of-n public key: a message is signed by the multisig when `Threshold` of its
+// members signed it. The members are sorted so the address does not depend on the order they are provided in.
+type MultisigPublicKey struct {
+	Threshold  uint32
+	PublicKeys []PublicKey
+}
+
+// NewMultisigPublicKey validates the threshold and the members of a multisig and returns its public key
+func NewMultisigPublicKey(threshold uint32, publicKeys []PublicKey) (*MultisigPublicKey, error) {
+	if len(publicKeys) == 0 || len(publicKeys) > MaxMultisigPublicKeys {
+		return nil, ErrInvalidMultisigSize(len(publicKeys))
+	}
+	if threshold == 0 || int(threshold) > len(publicKeys) {
+		return nil, ErrInvalidMultisigThreshold(threshold, len(publicKeys))
+	}
+
+	sorted := make([]PublicKey, len(publicKeys))
+	copy(sorted, publicKeys)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].Bytes(), sorted[j].Bytes()) < 0
+	})
+	for i := 1; i < len(sorted); i++ {
+		if bytes.Equal(sorted[i-1].Bytes(), sorted[i].Bytes()) {
+			return nil, ErrDuplicateMultisigPublicKey(sorted[i].String())
+		}
+	}
+
+	return &MultisigPublicKey{
+		Threshold:  threshold,
+		PublicKeys: sorted,
+	}, nil
+}
+
+// NewMultisigPublicKeyFromBytes is a convenience wrapper around NewMultisigPublicKey for serialized member public keys
+func NewMultisigPublicKeyFromBytes(threshold uint32, publicKeysBz [][]byte) (*MultisigPublicKey, error) {
+	publicKeys := make([]PublicKey, 0, len(publicKeysBz))
+	for _, bz := range publicKeysBz {
+		publicKey, err := NewPublicKeyFromBytes(bz)
+		if err != nil {
+			return nil, err
+		}
+		publicKeys = append(publicKeys, publicKey)
+	}
+	return NewMultisigPublicKey(threshold, publicKeys)
+}
+
+// Address is derived from the threshold and the sorted members of the multisig
+func (m *MultisigPublicKey) Address() Address {
+	hasher := sha256.New()
+	hasher.Write([]byte(multisigAddressPrefix))
+	threshold := make([]byte, 4)
+	binary.BigEndian.PutUint32(threshold, m.Threshold)
+	hasher.Write(threshold)
+	for _, publicKey := range m.PublicKeys {
+		hasher.Write(publicKey.Bytes())
+	}
+	return hasher.Sum(nil)[:AddressLen]
+}
+
+// IsMember returns whether the public key is one of the members of the multisig
+func (m *MultisigPublicKey) IsMember(publicKey []byte) bool {
+	for _, member := range m.PublicKeys {
+		if bytes.Equal(member.Bytes(), publicKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// Verify checks that at least `Threshold` distinct members signed the message. `signatures` maps the
+// serialized public key of the members to their signature of the message.
+func (m *MultisigPublicKey) Verify(msg []byte, signatures map[string][]byte) error {
+	numValid := uint32(0)
+	for publicKeyBz, signature := range signatures {
+		if !m.IsMember([]byte(publicKeyBz)) {
+			return ErrNotMultisigMember(hex.EncodeToString([]byte(publicKeyBz)))
+		}
+		publicKey, err := NewPublicKeyFromBytes([]byte(publicKeyBz))
+		if err != nil {
+			return err
+		}
+		if !publicKey.Verify(msg, signature) {
+			return ErrInvalidMultisigSignature(publicKey.String())
+		}
+		numValid++
+	}
+	if numValid < m.Threshold {
+		return ErrMultisigThresholdNotMet(numValid, m.Threshold)
+	}
+	return nil
+}
@@ -1,9 +1,14 @@
 package messaging
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
 )
@@ -29,3 +34,99 @@ func UnpackMessage[T protoreflect.ProtoMessage](envelope *PocketEnvelope) (T, er
 	}
 	return any(msg).(T), nil
 }
+
+var (
+	ErrEnvelopeNotSigned        = errors.New("envelope not signed")
+	ErrInvalidEnvelopeSignature = errors.New("invalid envelope signature")
+)
+
+// Sign signs the envelope with the given private key, assigning the signer's
+// address & public key, the current time and the resulting signature to
+// `envelope.Signature`. Any existing signature is replaced.
+func (envelope *PocketEnvelope) Sign(privKey cryptoPocket.PrivateKey) error {
+	envelope.PrepareSignature(privKey.PublicKey())
+
+	signBz, err := envelope.SignBytes()
+	if err != nil {
+		return err
+	}
+
+	signature, err := privKey.Sign(signBz)
+	if err != nil {
+		return fmt.Errorf("signing envelope: %w", err)
+	}
+	envelope.Signature.Signature = signature
+	return nil
+}
+
+// PrepareSignature assigns the signer's address & public key and the current
+// time to `envelope.Signature`, leaving the signature itself unset. It allows
+// the envelope to be signed by a signer which does not expose its private key,
+// which signs the result of `SignBytes()`.
+func (envelope *PocketEnvelope) PrepareSignature(pubKey cryptoPocket.PublicKey) {
+	envelope.Signature = &EnvelopeSignature{
+		OriginAddress: pubKey.Address().Bytes(),
+		PublicKey:     pubKey.Bytes(),
+		Timestamp:     timestamppb.New(time.Now()),
+	}
+}
+
+// IsSigned returns whether the envelope carries a signature.
+func (envelope *PocketEnvelope) IsSigned() bool {
+	return envelope.GetSignature() != nil
+}
+
+// VerifySignature verifies that the envelope's signature is valid for the
+// included public key and that the public key corresponds to the included
+// origin address. It returns the origin's public key if so.
+//
+// NB: it does NOT check that the public key is that of the origin on-chain nor
+// whether the timestamp is recent; callers are responsible for doing so, as
+// appropriate.
+func (envelope *PocketEnvelope) VerifySignature() (cryptoPocket.PublicKey, error) {
+	signature := envelope.GetSignature()
+	if signature == nil {
+		return nil, ErrEnvelopeNotSigned
+	}
+
+	pubKey, err := cryptoPocket.NewPublicKeyFromBytes(signature.GetPublicKey())
+	if err != nil {
+		return nil, fmt.Errorf("%w: parsing public key: %s", ErrInvalidEnvelopeSignature, err)
+	}
+
+	if !pubKey.Address().Equals(signature.GetOriginAddress()) {
+		return nil, fmt.Errorf("%w: public key does not match origin address", ErrInvalidEnvelopeSignature)
+	}
+
+	if signature.GetTimestamp() == nil {
+		return nil, fmt.Errorf("%w: missing timestamp", ErrInvalidEnvelopeSignature)
+	}
+
+	signBz, err := envelope.SignBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	if !pubKey.Verify(signBz, signature.GetSignature()) {
+		return nil, ErrInvalidEnvelopeSignature
+	}
+	return pubKey, nil
+}
+
+// SignBytes returns the bytes which are signed; i.e. the deterministically
+// serialized envelope, including the signature's metadata but excluding the
+// signature itself.
+func (envelope *PocketEnvelope) SignBytes() ([]byte, error) {
+	if envelope.GetSignature() == nil {
+		return nil, ErrEnvelopeNotSigned
+	}
+
+	unsignedEnvelope := proto.Clone(envelope).(*PocketEnvelope)
+	unsignedEnvelope.Signature.Signature = nil
+
+	signBz, err := proto.MarshalOptions{Deterministic: true}.Marshal(unsignedEnvelope)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling envelope: %w", err)
+	}
+	return signBz, nil
+}
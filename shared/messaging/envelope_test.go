@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
+
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
 )
 
 func Test_UnpackMessage_Roundtrip(t *testing.T) {
@@ -17,3 +19,78 @@ func Test_UnpackMessage_Roundtrip(t *testing.T) {
 
 	require.True(t, proto.Equal(someMsg, unpackedMsg))
 }
+
+func Test_PocketEnvelope_SignVerify(t *testing.T) {
+	privKey, err := cryptoPocket.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	otherPrivKey, err := cryptoPocket.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		tamper      func(envelope *PocketEnvelope)
+		expectedErr error
+	}{
+		{
+			name:   "valid signature",
+			tamper: func(*PocketEnvelope) {},
+		},
+		{
+			name: "unsigned envelope",
+			tamper: func(envelope *PocketEnvelope) {
+				envelope.Signature = nil
+			},
+			expectedErr: ErrEnvelopeNotSigned,
+		},
+		{
+			name: "altered content",
+			tamper: func(envelope *PocketEnvelope) {
+				envelope.Content.Value = append(envelope.Content.Value, 0x01)
+			},
+			expectedErr: ErrInvalidEnvelopeSignature,
+		},
+		{
+			name: "altered nonce",
+			tamper: func(envelope *PocketEnvelope) {
+				envelope.Nonce++
+			},
+			expectedErr: ErrInvalidEnvelopeSignature,
+		},
+		{
+			name: "forged origin address",
+			tamper: func(envelope *PocketEnvelope) {
+				envelope.Signature.OriginAddress = otherPrivKey.Address().Bytes()
+			},
+			expectedErr: ErrInvalidEnvelopeSignature,
+		},
+		{
+			name: "forged public key",
+			tamper: func(envelope *PocketEnvelope) {
+				envelope.Signature.OriginAddress = otherPrivKey.Address().Bytes()
+				envelope.Signature.PublicKey = otherPrivKey.PublicKey().Bytes()
+			},
+			expectedErr: ErrInvalidEnvelopeSignature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			envelope, err := PackMessage(&DebugMessage{Action: DebugMessageAction_DEBUG_PERSISTENCE_CLEAR_STATE})
+			require.NoError(t, err)
+
+			err = envelope.Sign(privKey)
+			require.NoError(t, err)
+
+			tt.tamper(envelope)
+
+			pubKey, err := envelope.VerifySignature()
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, privKey.PublicKey().Equals(pubKey))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package modules
 
 import (
 	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/messaging"
 )
 
 type Module interface {
@@ -75,8 +76,22 @@ type InjectableModule interface {
 
 // KeyholderModule is a module that can provide a private key.
 type KeyholderModule interface {
-	// GetPrivateKey returns the private key held by the module.
+	// GetPrivateKey returns the private key held by the module, or an error if it is held by a remote signer.
 	GetPrivateKey() (cryptoPocket.PrivateKey, error)
+	// GetSigner returns the signer of the key held by the module, which is either the private key or a remote signer.
+	GetSigner() Signer
+}
+
+// Signer signs the consensus votes and the P2P envelopes on behalf of a key which may not be held by the node,
+// see `signer/README.md`.
+type Signer interface {
+	// PublicKey returns the public key of the signer.
+	PublicKey() cryptoPocket.PublicKey
+	// SignVote returns the signature of the signable bytes of a hotstuff vote (see `consensus/types.GetSignableBytes`).
+	// A remote signer refuses to sign a vote conflicting with a vote it already signed.
+	SignVote(signBytes []byte) ([]byte, error)
+	// SignEnvelope signs the envelope, replacing any existing signature.
+	SignEnvelope(envelope *messaging.PocketEnvelope) error
 }
 
 // ObservableModule is a module that can provide observability via a Logger.
@@ -3,6 +3,8 @@ package modules
 //go:generate mockgen -destination=./mocks/p2p_module_mock.go github.com/pokt-network/pocket/shared/modules P2PModule
 
 import (
+	"context"
+
 	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
 	"google.golang.org/protobuf/types/known/anypb"
 )
@@ -26,10 +28,34 @@ type P2PModule interface {
 	// HandleEvent is used to react to events that occur inside the application
 	HandleEvent(*anypb.Any) error
 
-	// CONSIDERATION: The P2P module currently does implement a synchronous "request-response" pattern
-	//                for core business logic between nodes. Rather, all communication is done
-	//                asynchronously via a "fire-and-forget" pattern using `Send` and `Broadcast`.
-	//                There are pros and cons to both, and future readers/maintainers/developers may
-	//                consider the addition of a function similar to the one below.
-	// Request(addr cryptoPocket.Address, msg *anypb.Any) *anypb.Any
+	// Request sends `msg` to the peer with the given address and blocks until
+	// the peer responds, `ctx` is done, or the default request timeout elapses
+	// (only applied if `ctx` has no deadline).
+	Request(ctx context.Context, addr cryptoPocket.Address, msg *anypb.Any) (*anypb.Any, error)
+
+	// Publish broadcasts `msg` to all peers subscribed to the given pubsub topic
+	// via gossipsub (i.e. not RainTree). The topic need not be subscribed to.
+	Publish(topic string, msg *anypb.Any) error
+
+	// Subscribe subscribes this node to the given pubsub topic. Messages received
+	// on the topic which pass validation, including `validator` (if not nil), are
+	// published to the bus. Subscriptions may be made before the module is started.
+	Subscribe(topic string, validator P2PTopicValidator) error
+
+	// Unsubscribe cancels a subscription made via `Subscribe`.
+	Unsubscribe(topic string) error
+
+	// RegisterRequestHandler registers `handler` to respond to incoming requests
+	// whose content matches the given protobuf type URL. Only one handler may be
+	// registered per type URL.
+	RegisterRequestHandler(typeURL string, handler P2PRequestHandler) error
 }
+
+// P2PRequestHandler is called with the address of the requesting peer and the
+// content of its request. The returned message is sent back as the response.
+type P2PRequestHandler func(from cryptoPocket.Address, req *anypb.Any) (*anypb.Any, error)
+
+// P2PTopicValidator is called with the content of each message received on a
+// subscribed pubsub topic. Messages for which it returns false are neither
+// propagated nor published to the bus.
+type P2PTopicValidator func(msg *anypb.Any) bool
@@ -4,6 +4,7 @@ package modules
 
 import (
 	"math/big"
+	"time"
 
 	"github.com/pokt-network/pocket/persistence/blockstore"
 	"github.com/pokt-network/pocket/persistence/indexer"
@@ -25,6 +26,8 @@ type PersistenceModule interface {
 	//		e.g. when getting the App Token sessions multiplier for the starting height of a session.
 	NewReadContext(height int64) (PersistenceReadContext, error)
 	ReleaseWriteContext() error // The module can maintain many read contexts, but only one write context can exist at a time
+	// NewSimulationContext returns a throwaway read-write context, independent of the write context, that can never be committed
+	NewSimulationContext(height int64) (PersistenceRWContext, error)
 
 	// BlockStore maps a block height to an *coreTypes.IndexedTransaction
 	GetBlockStore() blockstore.BlockStore
@@ -158,6 +161,22 @@ type PersistenceWriteContext interface {
 	// SetIBCEvent stores an IBC event in the persistence context at the current height
 	SetIBCEvent(event *coreTypes.IBCEvent) error
 
+	// Governance Proposal Operations
+	// SetProposal stores the proposal (e.g. its status or tally) at the current height
+	SetProposal(proposal *coreTypes.Proposal) error
+	// SetProposalVote stores the vote of a validator on a proposal at the current height, replacing its previous vote
+	SetProposalVote(vote *coreTypes.ProposalVote) error
+
+	// Upgrade Operations
+	// SetUpgrade schedules the version at the upgrade height, superseding the upgrade pending at the current height if any
+	SetUpgrade(version string, upgradeHeight int64) error
+
+	// Delegation Operations
+	// SetDelegation sets the amount delegated by the delegator to the validator at the current height, removing the delegation if it is 0
+	SetDelegation(delegator, validator []byte, amount string) error
+	// SetUnbondingDelegation starts unbonding the amount undelegated from the validator at the current height until the completion height
+	SetUnbondingDelegation(delegator, validator []byte, amount string, completionHeight int64) error
+
 	// Relay Operations
 	RecordRelayService(applicationAddress string, key []byte, relay *coreTypes.Relay, response *coreTypes.RelayResponse) error
 }
@@ -169,17 +188,20 @@ type PersistenceReadContext interface {
 	Release()                  // Releases the read context
 
 	// Version queries
-	GetVersionAtHeight(height int64) (string, error) // TODO: Implement this
-	GetRevisionNumber(height int64) uint64           // TODO(#882): Implement this
+	GetVersionAtHeight(height int64) (string, error)            // Returns the protocol version active at the height provided
+	GetPendingUpgrade(height int64) (*coreTypes.Upgrade, error) // Returns the upgrade scheduled but not active yet at the height provided, or nil if there is none
+	GetRevisionNumber(height int64) uint64                      // TODO(#882): Implement this
 
 	// Supported Chains Queries
-	GetSupportedChains(height int64) ([]string, error) // TODO: Implement this
+	GetSupportedChains(height int64) ([]string, error) // Returns the chains that the servicers staked at the height provided are staked for
 
 	// CONSOLIDATE: BlockHash / AppHash / StateHash
 	// Block Queries
-	GetMaximumBlockHeight() (uint64, error)    // Returns the height of the latest block in the persistence layer
-	GetMinimumBlockHeight() (uint64, error)    // Returns the min block height in the persistence layer
-	GetBlockHash(height int64) (string, error) // Returns the app hash corresponding to the height provided
+	GetMaximumBlockHeight() (uint64, error)               // Returns the height of the latest block in the persistence layer
+	GetMinimumBlockHeight() (uint64, error)               // Returns the min block height in the persistence layer
+	GetBlockHash(height int64) (string, error)            // Returns the app hash corresponding to the height provided
+	GetHeightByBlockHash(blockHash string) (int64, error) // Returns the height of the block with the hash provided
+	GetHeightAtTime(blockTime time.Time) (int64, error)   // Returns the height of the latest block committed at or before the time provided
 
 	// Pool Queries
 
@@ -192,9 +214,13 @@ type PersistenceReadContext interface {
 	// Returns "0" if the account does not exist
 	GetAccountAmount(address []byte, height int64) (string, error)
 	GetAllAccounts(height int64) ([]*coreTypes.Account, error)
+	// Returns the page of accounts ordered by address along with the total number of accounts
+	GetAccountsPaginated(height int64, offset, limit uint64) (accounts []*coreTypes.Account, totalCount int, err error)
 
 	// Actor Queries
 	GetActor(actorType coreTypes.ActorType, address []byte, height int64) (*coreTypes.Actor, error)
+	// Returns the page of actors matching the filter ordered by address along with the total number of matching actors
+	GetActorsPaginated(actorType coreTypes.ActorType, height int64, filter *moduleTypes.ActorQueryFilter, offset, limit uint64) (actors []*coreTypes.Actor, totalCount int, err error)
 
 	// App Queries
 	GetApp(address []byte, height int64) (*coreTypes.Actor, error)
@@ -258,6 +284,30 @@ type PersistenceReadContext interface {
 	GetIBCStoreEntry(key []byte, height uint64) ([]byte, error)
 	// GetIBCEvent returns the matching IBC events for any topic at the height provied
 	GetIBCEvents(height uint64, topic string) ([]*coreTypes.IBCEvent, error)
+
+	// Governance Proposal Queries
+	// GetProposal returns the latest version of the proposal at the given height
+	GetProposal(id uint64, height int64) (*coreTypes.Proposal, error)
+	// GetProposals returns the proposals with the given status at the given height; all of them if the status is unspecified
+	GetProposals(status coreTypes.ProposalStatus, height int64) ([]*coreTypes.Proposal, error)
+	// GetLastProposalID returns the id of the last proposal submitted at or before the given height, or 0 if there are none
+	GetLastProposalID(height int64) (uint64, error)
+	// GetProposalVotes returns the last vote of every validator that voted on the proposal at the given height
+	GetProposalVotes(proposalID uint64, height int64) ([]*coreTypes.ProposalVote, error)
+
+	// Delegation Queries
+	// GetDelegation returns the amount delegated by the delegator to the validator at the given height, or "0" if there is none
+	GetDelegation(delegator, validator []byte, height int64) (string, error)
+	// GetDelegations returns the delegations of the delegator at the given height
+	GetDelegations(delegator []byte, height int64) ([]*coreTypes.Delegation, error)
+	// GetValidatorDelegations returns the delegations to the validator at the given height
+	GetValidatorDelegations(validator []byte, height int64) ([]*coreTypes.Delegation, error)
+	// GetAllDelegations returns all the delegations at the given height
+	GetAllDelegations(height int64) ([]*coreTypes.Delegation, error)
+	// GetUnbondingDelegations returns the stake of the delegator that is still unbonding at the given height
+	GetUnbondingDelegations(delegator []byte, height int64) ([]*coreTypes.UnbondingDelegation, error)
+	// GetUnbondingDelegationsReadyToUnbond returns the stake that finishes unbonding at the given height
+	GetUnbondingDelegationsReadyToUnbond(height int64) ([]*coreTypes.UnbondingDelegation, error)
 }
 
 // PersistenceLocalContext defines the set of operations specific to local persistence.
@@ -2,8 +2,16 @@ package modules
 
 //go:generate mockgen -destination=./mocks/rpc_module_mock.go github.com/pokt-network/pocket/shared/modules RPCModule
 
+import (
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
 const RPCModuleName = "rpc"
 
 type RPCModule interface {
 	Module
+
+	// HandleEvent is used to publish the events that occur inside the application to the
+	// clients subscribed to them via the RPC server
+	HandleEvent(*anypb.Any) error
 }
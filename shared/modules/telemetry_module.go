@@ -52,6 +52,25 @@ type TimeSeriesAgent interface {
 
 	// Retrieves a gauge vector by name
 	GetGaugeVec(name string) (prometheus.GaugeVec, error)
+
+	// Sets the gauge of the vector with the given label values to an arbitrary value
+	GaugeVecSet(name string, value float64, labelValues ...string) error
+
+	/*** Counter Vectors ***/
+
+	// Registers a counter vector by name and provide labels
+	CounterVecRegister(namespace, module, name, description string, labels []string)
+
+	// Adds the given value to the counter of the vector with the given label values. The value must not be negative.
+	CounterVecAdd(name string, value float64, labelValues ...string) error
+
+	/*** Histogram Vectors ***/
+
+	// Registers a histogram vector by name and provide labels. The default Prometheus buckets are used if none are provided.
+	HistogramVecRegister(namespace, module, name, description string, labels []string, buckets []float64)
+
+	// Adds an observation to the histogram of the vector with the given label values
+	HistogramVecObserve(name string, value float64, labelValues ...string) error
 }
 
 // Interface for the event metrics agent
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"regexp"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+// chainIdRegex matches the relay chain identifiers stored by the persistence module, i.e. at most 4 alphanumeric characters
+var chainIdRegex = regexp.MustCompile(`^[0-9A-Za-z]{1,4}$`)
+
+// IsEmpty returns true if none of the filter's fields restrict the results
+func (f *ActorQueryFilter) IsEmpty() bool {
+	return f == nil || (f.Status == int32(coreTypes.StakeStatus_UnknownStatus) && f.Chain == "" && f.MinStake == "" && f.OutputAddress == "")
+}
+
+// ValidateBasic checks that the filter's fields are well formed so they can safely be used to build queries
+func (f *ActorQueryFilter) ValidateBasic() error {
+	if f == nil {
+		return nil
+	}
+	if _, ok := coreTypes.StakeStatus_name[f.Status]; !ok {
+		return fmt.Errorf("invalid stake status: %d", f.Status)
+	}
+	if f.Chain != "" && !chainIdRegex.MatchString(f.Chain) {
+		return fmt.Errorf("invalid chain: %q", f.Chain)
+	}
+	if f.MinStake != "" {
+		minStake, ok := new(big.Int).SetString(f.MinStake, 10)
+		if !ok || minStake.Sign() < 0 {
+			return fmt.Errorf("invalid minimum stake: %q", f.MinStake)
+		}
+	}
+	if f.OutputAddress != "" {
+		if _, err := hex.DecodeString(f.OutputAddress); err != nil {
+			return fmt.Errorf("invalid output address %q: %w", f.OutputAddress, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+)
+
+func TestActorQueryFilter_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  *ActorQueryFilter
+		wantErr bool
+	}{
+		{name: "nil filter", filter: nil},
+		{name: "empty filter", filter: &ActorQueryFilter{}},
+		{
+			name: "valid filter",
+			filter: &ActorQueryFilter{
+				Status:        int32(coreTypes.StakeStatus_Staked),
+				Chain:         "0001",
+				MinStake:      "15000000000",
+				OutputAddress: "00404a570febd061274f72b50d0a37f611dfe339",
+			},
+		},
+		{name: "unknown status", filter: &ActorQueryFilter{Status: 42}, wantErr: true},
+		{name: "chain too long", filter: &ActorQueryFilter{Chain: "00001"}, wantErr: true},
+		{name: "chain with quotes", filter: &ActorQueryFilter{Chain: "0'"}, wantErr: true},
+		{name: "negative min stake", filter: &ActorQueryFilter{MinStake: "-1"}, wantErr: true},
+		{name: "non numeric min stake", filter: &ActorQueryFilter{MinStake: "1 OR 1=1"}, wantErr: true},
+		{name: "non hex output address", filter: &ActorQueryFilter{OutputAddress: "not hex"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.ValidateBasic()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,6 +1,6 @@
 package modules
 
-//go:generate mockgen -destination=./mocks/utility_module_mock.go github.com/pokt-network/pocket/shared/modules UtilityModule,UnstakingActor,UtilityUnitOfWork,LeaderUtilityUnitOfWork,ReplicaUtilityUnitOfWork
+//go:generate mockgen -destination=./mocks/utility_module_mock.go github.com/pokt-network/pocket/shared/modules UtilityModule,UnstakingActor,UtilityUnitOfWork,LeaderUtilityUnitOfWork,ReplicaUtilityUnitOfWork,SimulationUtilityUnitOfWork
 
 import (
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
@@ -23,6 +23,10 @@ type UtilityModule interface {
 	// if it's valid. It does not process the business logic of the underlying message; see UtilityUnitOfWork.HandleTransaction.
 	HandleTransaction(tx []byte) error
 
+	// SimulateTransaction runs the transaction through a throwaway unit of work at the latest height and returns the
+	// outcome without affecting the state or the mempool. See SimulationUtilityUnitOfWork.SimulateTransaction.
+	SimulateTransaction(txProtoBytes, signer []byte) (*coreTypes.TxSimulation, error)
+
 	// GetIndexedTransaction returns the indexed transaction if it is available in this node's view of the world state.
 	GetIndexedTransaction(tx []byte) (*coreTypes.IndexedTransaction, error)
 
@@ -125,3 +129,12 @@ type LeaderUtilityUnitOfWork interface {
 type ReplicaUtilityUnitOfWork interface {
 	UtilityUnitOfWork
 }
+
+type SimulationUtilityUnitOfWork interface {
+	UtilityUnitOfWork
+
+	// SimulateTransaction dry runs the transaction on behalf of the signer of a signed transaction or, for unsigned
+	// transactions, the owner of the transaction's public key or the signer provided. The unit of work must be
+	// released afterwards to roll back the changes made.
+	SimulateTransaction(tx *coreTypes.Transaction, signer []byte) *coreTypes.TxSimulation
+}
@@ -185,11 +185,13 @@ func (node *Node) handleEvent(message *messaging.PocketEnvelope) error {
 	case messaging.ConsensusNewHeightEventType:
 		err_p2p := node.GetBus().GetP2PModule().HandleEvent(message.Content)
 		err_ibc := node.GetBus().GetIBCModule().HandleEvent(message.Content)
-		return errors.Join(err_p2p, err_ibc)
+		err_rpc := node.GetBus().GetRPCModule().HandleEvent(message.Content)
+		return errors.Join(err_p2p, err_ibc, err_rpc)
 	case messaging.StateMachineTransitionEventType:
 		err_consensus := node.GetBus().GetConsensusModule().HandleEvent(message.Content)
 		err_p2p := node.GetBus().GetP2PModule().HandleEvent(message.Content)
-		return errors.Join(err_consensus, err_p2p)
+		err_rpc := node.GetBus().GetRPCModule().HandleEvent(message.Content)
+		return errors.Join(err_consensus, err_p2p, err_rpc)
 	default:
 		logger.Global.Warn().Msgf("Unsupported message content type: %s", contentType)
 	}
@@ -0,0 +1,31 @@
+package signer
+
+import (
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/messaging"
+	"github.com/pokt-network/pocket/shared/modules"
+)
+
+var _ modules.Signer = &localSigner{}
+
+// localSigner signs with a private key held by the node
+type localSigner struct {
+	privateKey crypto.PrivateKey
+}
+
+// NewLocalSigner returns a signer using the private key provided, e.g. `ConsensusConfig.PrivateKey`
+func NewLocalSigner(privateKey crypto.PrivateKey) *localSigner {
+	return &localSigner{privateKey: privateKey}
+}
+
+func (s *localSigner) PublicKey() crypto.PublicKey {
+	return s.privateKey.PublicKey()
+}
+
+func (s *localSigner) SignVote(signBytes []byte) ([]byte, error) {
+	return s.privateKey.Sign(signBytes)
+}
+
+func (s *localSigner) SignEnvelope(envelope *messaging.PocketEnvelope) error {
+	return envelope.Sign(s.privateKey)
+}
@@ -0,0 +1,158 @@
+package signer
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+)
+
+var (
+	ErrVoteRegression  = errors.New("refusing to sign a vote for a height, round and step lower than the last vote signed")
+	ErrConflictingVote = errors.New("refusing to sign a vote conflicting with the vote already signed for the same height, round and step")
+)
+
+// signedVote is the last vote signed, persisted so that no conflicting vote is signed after a restart
+type signedVote struct {
+	Height        uint64                 `json:"height"`
+	Round         uint64                 `json:"round"`
+	Step          typesCons.HotstuffStep `json:"step"`
+	SignBytesHash []byte                 `json:"sign_bytes_hash"`
+	Signature     []byte                 `json:"signature"`
+}
+
+// compare orders the votes by height, then round, then step
+func (v *signedVote) compare(height, round uint64, step typesCons.HotstuffStep) int {
+	switch {
+	case v.Height != height:
+		return compareUint64(v.Height, height)
+	case v.Round != round:
+		return compareUint64(v.Round, round)
+	default:
+		return compareUint64(uint64(v.Step), uint64(step))
+	}
+}
+
+func compareUint64(a, b uint64) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
+
+// SlashingProtection guarantees that a validator never signs two different votes for the same height, round
+// and step, nor a vote lower than the last vote it signed. The last vote signed is persisted to `stateFile`
+// before its signature is returned.
+type SlashingProtection struct {
+	m         sync.Mutex
+	stateFile string
+	last      *signedVote
+}
+
+// NewSlashingProtection loads the last vote signed from `stateFile`, if it exists. An empty `stateFile` keeps the state in memory.
+func NewSlashingProtection(stateFile string) (*SlashingProtection, error) {
+	p := &SlashingProtection{stateFile: stateFile}
+	if stateFile == "" {
+		return p, nil
+	}
+
+	bz, err := os.ReadFile(stateFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return p, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading the slashing protection state: %w", err)
+	}
+	last := new(signedVote)
+	if err := json.Unmarshal(bz, last); err != nil {
+		return nil, fmt.Errorf("parsing the slashing protection state: %w", err)
+	}
+	p.last = last
+	return p, nil
+}
+
+// SignVote signs the vote with `sign` unless it conflicts with the last vote signed. Signing the same vote
+// again returns the signature of the last vote.
+func (p *SlashingProtection) SignVote(
+	height, round uint64,
+	step typesCons.HotstuffStep,
+	signBytes []byte,
+	sign func([]byte) ([]byte, error),
+) ([]byte, error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	signBytesHash := sha256.Sum256(signBytes)
+	if p.last != nil {
+		switch p.last.compare(height, round, step) {
+		case 1:
+			return nil, fmt.Errorf("%w: last signed height %d, round %d, step %s", ErrVoteRegression,
+				p.last.Height, p.last.Round, typesCons.StepToString[p.last.Step])
+		case 0:
+			if !bytes.Equal(p.last.SignBytesHash, signBytesHash[:]) {
+				return nil, fmt.Errorf("%w: height %d, round %d, step %s", ErrConflictingVote,
+					height, round, typesCons.StepToString[step])
+			}
+			return p.last.Signature, nil
+		}
+	}
+
+	signature, err := sign(signBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	vote := &signedVote{
+		Height:        height,
+		Round:         round,
+		Step:          step,
+		SignBytesHash: signBytesHash[:],
+		Signature:     signature,
+	}
+	if err := p.persist(vote); err != nil {
+		return nil, err
+	}
+	p.last = vote
+	return signature, nil
+}
+
+// persist atomically replaces the state file with the vote
+func (p *SlashingProtection) persist(vote *signedVote) error {
+	if p.stateFile == "" {
+		return nil
+	}
+
+	bz, err := json.Marshal(vote)
+	if err != nil {
+		return err
+	}
+	tmpFile, err := os.CreateTemp(filepath.Dir(p.stateFile), filepath.Base(p.stateFile)+".tmp")
+	if err != nil {
+		return fmt.Errorf("persisting the slashing protection state: %w", err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.Write(bz); err != nil {
+		tmpFile.Close()
+		return fmt.Errorf("persisting the slashing protection state: %w", err)
+	}
+	if err := tmpFile.Sync(); err != nil {
+		tmpFile.Close()
+		return fmt.Errorf("persisting the slashing protection state: %w", err)
+	}
+	if err := tmpFile.Close(); err != nil {
+		return fmt.Errorf("persisting the slashing protection state: %w", err)
+	}
+	if err := os.Rename(tmpFile.Name(), p.stateFile); err != nil {
+		return fmt.Errorf("persisting the slashing protection state: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package signer
+
+import (
+	"path/filepath"
+	"testing"
+
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlashingProtection_SignVote(t *testing.T) {
+	privateKey, err := crypto.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	type vote struct {
+		height uint64
+		round  uint64
+		step   typesCons.HotstuffStep
+		bz     string
+	}
+	tests := []struct {
+		name        string
+		last        *vote
+		vote        vote
+		expectedErr error
+	}{
+		{
+			name: "first vote",
+			vote: vote{height: 1, round: 0, step: typesCons.HotstuffStep_HOTSTUFF_STEP_PREPARE, bz: "a"},
+		},
+		{
+			name: "next step",
+			last: &vote{height: 1, round: 0, step: typesCons.HotstuffStep_HOTSTUFF_STEP_PREPARE, bz: "a"},
+			vote: vote{height: 1, round: 0, step: typesCons.HotstuffStep_HOTSTUFF_STEP_PRECOMMIT, bz: "a"},
+		},
+		{
+			name: "next round with a different block",
+			last: &vote{height: 1, round: 0, step: typesCons.HotstuffStep_HOTSTUFF_STEP_COMMIT, bz: "a"},
+			vote: vote{height: 1, round: 1, step: typesCons.HotstuffStep_HOTSTUFF_STEP_PREPARE, bz: "b"},
+		},
+		{
+			name: "same vote signed again",
+			last: &vote{height: 2, round: 1, step: typesCons.HotstuffStep_HOTSTUFF_STEP_PRECOMMIT, bz: "a"},
+			vote: vote{height: 2, round: 1, step: typesCons.HotstuffStep_HOTSTUFF_STEP_PRECOMMIT, bz: "a"},
+		},
+		{
+			name:        "conflicting vote for the same height, round and step",
+			last:        &vote{height: 2, round: 1, step: typesCons.HotstuffStep_HOTSTUFF_STEP_PRECOMMIT, bz: "a"},
+			vote:        vote{height: 2, round: 1, step: typesCons.HotstuffStep_HOTSTUFF_STEP_PRECOMMIT, bz: "b"},
+			expectedErr: ErrConflictingVote,
+		},
+		{
+			name:        "lower step",
+			last:        &vote{height: 2, round: 1, step: typesCons.HotstuffStep_HOTSTUFF_STEP_PRECOMMIT, bz: "a"},
+			vote:        vote{height: 2, round: 1, step: typesCons.HotstuffStep_HOTSTUFF_STEP_PREPARE, bz: "a"},
+			expectedErr: ErrVoteRegression,
+		},
+		{
+			name:        "lower round",
+			last:        &vote{height: 2, round: 1, step: typesCons.HotstuffStep_HOTSTUFF_STEP_PREPARE, bz: "a"},
+			vote:        vote{height: 2, round: 0, step: typesCons.HotstuffStep_HOTSTUFF_STEP_COMMIT, bz: "a"},
+			expectedErr: ErrVoteRegression,
+		},
+		{
+			name:        "lower height",
+			last:        &vote{height: 2, round: 0, step: typesCons.HotstuffStep_HOTSTUFF_STEP_PREPARE, bz: "a"},
+			vote:        vote{height: 1, round: 5, step: typesCons.HotstuffStep_HOTSTUFF_STEP_COMMIT, bz: "a"},
+			expectedErr: ErrVoteRegression,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			protection, err := NewSlashingProtection("")
+			require.NoError(t, err)
+
+			var lastSignature []byte
+			if tt.last != nil {
+				lastSignature, err = protection.SignVote(tt.last.height, tt.last.round, tt.last.step, []byte(tt.last.bz), privateKey.Sign)
+				require.NoError(t, err)
+			}
+
+			signature, err := protection.SignVote(tt.vote.height, tt.vote.round, tt.vote.step, []byte(tt.vote.bz), privateKey.Sign)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, privateKey.PublicKey().Verify([]byte(tt.vote.bz), signature))
+			if tt.last != nil && *tt.last == tt.vote {
+				require.Equal(t, lastSignature, signature)
+			}
+		})
+	}
+}
+
+func TestSlashingProtection_PersistedAcrossRestarts(t *testing.T) {
+	privateKey, err := crypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	stateFile := filepath.Join(t.TempDir(), "signer_state.json")
+
+	protection, err := NewSlashingProtection(stateFile)
+	require.NoError(t, err)
+	_, err = protection.SignVote(5, 2, typesCons.HotstuffStep_HOTSTUFF_STEP_COMMIT, []byte("block"), privateKey.Sign)
+	require.NoError(t, err)
+
+	restarted, err := NewSlashingProtection(stateFile)
+	require.NoError(t, err)
+	_, err = restarted.SignVote(5, 2, typesCons.HotstuffStep_HOTSTUFF_STEP_COMMIT, []byte("other block"), privateKey.Sign)
+	require.ErrorIs(t, err, ErrConflictingVote)
+	_, err = restarted.SignVote(5, 1, typesCons.HotstuffStep_HOTSTUFF_STEP_COMMIT, []byte("block"), privateKey.Sign)
+	require.ErrorIs(t, err, ErrVoteRegression)
+	_, err = restarted.SignVote(6, 0, typesCons.HotstuffStep_HOTSTUFF_STEP_PREPARE, []byte("block"), privateKey.Sign)
+	require.NoError(t, err)
+}
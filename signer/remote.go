@@ -0,0 +1,112 @@
+package signer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/pokt-network/pocket/runtime/configs"
+	"github.com/pokt-network/pocket/runtime/defaults"
+	"github.com/pokt-network/pocket/shared/codec"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/messaging"
+	"github.com/pokt-network/pocket/shared/modules"
+	signerTypes "github.com/pokt-network/pocket/signer/types"
+)
+
+var _ modules.Signer = &remoteSigner{}
+
+// remoteSigner is the client of the `RemoteSigner` service of a signer daemon holding the validator key
+type remoteSigner struct {
+	conn      *grpc.ClientConn
+	client    signerTypes.RemoteSignerClient
+	publicKey crypto.PublicKey
+	timeout   time.Duration
+}
+
+// NewRemoteSigner connects to the remote signer over mutual TLS and retrieves the public key it holds
+func NewRemoteSigner(cfg *configs.RemoteSignerConfig) (*remoteSigner, error) {
+	if cfg.GetAddress() == "" {
+		return nil, errors.New("the address of the remote signer is required")
+	}
+	tlsConfig, err := ClientTLSConfig(cfg.GetCertFile(), cfg.GetKeyFile(), cfg.GetCaCertFile())
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := time.Duration(cfg.GetTimeoutMsec()) * time.Millisecond
+	if timeout == 0 {
+		timeout = time.Duration(defaults.DefaultRemoteSignerTimeoutMsec) * time.Millisecond
+	}
+
+	conn, err := grpc.Dial(cfg.GetAddress(), grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	if err != nil {
+		return nil, fmt.Errorf("connecting to the remote signer: %w", err)
+	}
+	rs := &remoteSigner{
+		conn:    conn,
+		client:  signerTypes.NewRemoteSignerClient(conn),
+		timeout: timeout,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	resp, err := rs.client.GetPublicKey(ctx, &signerTypes.GetPublicKeyRequest{})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("getting the public key of the remote signer: %w", err)
+	}
+	if rs.publicKey, err = crypto.NewPublicKeyFromBytes(resp.GetPublicKey()); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return rs, nil
+}
+
+// Close closes the connection to the remote signer
+func (rs *remoteSigner) Close() error {
+	return rs.conn.Close()
+}
+
+func (rs *remoteSigner) PublicKey() crypto.PublicKey {
+	return rs.publicKey
+}
+
+func (rs *remoteSigner) SignVote(signBytes []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), rs.timeout)
+	defer cancel()
+
+	resp, err := rs.client.SignVote(ctx, &signerTypes.SignVoteRequest{SignBytes: signBytes})
+	if err != nil {
+		return nil, fmt.Errorf("signing the vote with the remote signer: %w", err)
+	}
+	if !rs.publicKey.Verify(signBytes, resp.GetSignature()) {
+		return nil, errors.New("invalid vote signature returned by the remote signer")
+	}
+	return resp.GetSignature(), nil
+}
+
+func (rs *remoteSigner) SignEnvelope(envelope *messaging.PocketEnvelope) error {
+	envelope.PrepareSignature(rs.publicKey)
+	envelopeBz, err := codec.GetCodec().Marshal(envelope)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), rs.timeout)
+	defer cancel()
+
+	resp, err := rs.client.SignEnvelope(ctx, &signerTypes.SignEnvelopeRequest{Envelope: envelopeBz})
+	if err != nil {
+		return fmt.Errorf("signing the envelope with the remote signer: %w", err)
+	}
+	envelope.Signature.Signature = resp.GetSignature()
+	if _, err := envelope.VerifySignature(); err != nil {
+		return fmt.Errorf("invalid envelope signature returned by the remote signer: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+	"github.com/pokt-network/pocket/logger"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/messaging"
+	signerTypes "github.com/pokt-network/pocket/signer/types"
+)
+
+var _ signerTypes.RemoteSignerServer = &Server{}
+
+// Server implements the `RemoteSigner` service of the signer daemon for the validator key it holds
+type Server struct {
+	signerTypes.UnimplementedRemoteSignerServer
+
+	privateKey crypto.PrivateKey
+	protection *SlashingProtection
+}
+
+// NewServer returns the remote signer of `privateKey`, which refuses to sign the votes rejected by `protection`
+func NewServer(privateKey crypto.PrivateKey, protection *SlashingProtection) *Server {
+	return &Server{
+		privateKey: privateKey,
+		protection: protection,
+	}
+}
+
+// NewGRPCServer returns a gRPC server serving `server` over mutual TLS, see `ServerTLSConfig`
+func NewGRPCServer(server *Server, tlsConfig *tls.Config) *grpc.Server {
+	grpcServer := grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig)))
+	signerTypes.RegisterRemoteSignerServer(grpcServer, server)
+	return grpcServer
+}
+
+func (s *Server) GetPublicKey(context.Context, *signerTypes.GetPublicKeyRequest) (*signerTypes.GetPublicKeyResponse, error) {
+	return &signerTypes.GetPublicKeyResponse{PublicKey: s.privateKey.PublicKey().Bytes()}, nil
+}
+
+// SignVote only signs the canonical signable bytes of a hotstuff vote, see `typesCons.GetSignableBytes`
+func (s *Server) SignVote(_ context.Context, req *signerTypes.SignVoteRequest) (*signerTypes.SignResponse, error) {
+	vote := new(typesCons.HotstuffMessage)
+	if err := proto.Unmarshal(req.GetSignBytes(), vote); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "parsing the vote: %s", err)
+	}
+	if err := validateVote(vote, req.GetSignBytes()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	signature, err := s.protection.SignVote(vote.GetHeight(), vote.GetRound(), vote.GetStep(), req.GetSignBytes(), s.privateKey.Sign)
+	if errors.Is(err, ErrVoteRegression) || errors.Is(err, ErrConflictingVote) {
+		logger.Global.Warn().Err(err).Msg("Refused to sign a vote")
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	logger.Global.Info().
+		Uint64("height", vote.GetHeight()).
+		Uint64("round", vote.GetRound()).
+		Str("step", typesCons.StepToString[vote.GetStep()]).
+		Msg("Signed a vote")
+	return &signerTypes.SignResponse{Signature: signature}, nil
+}
+
+// SignEnvelope only signs the envelopes whose signature metadata is that of the validator key
+func (s *Server) SignEnvelope(_ context.Context, req *signerTypes.SignEnvelopeRequest) (*signerTypes.SignResponse, error) {
+	envelope := new(messaging.PocketEnvelope)
+	if err := proto.Unmarshal(req.GetEnvelope(), envelope); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "parsing the envelope: %s", err)
+	}
+	if err := s.validateEnvelope(envelope); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	signBz, err := envelope.SignBytes()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	signature, err := s.privateKey.Sign(signBz)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &signerTypes.SignResponse{Signature: signature}, nil
+}
+
+// validateVote checks the bytes are the signable bytes of a vote so that no other message is signed
+func validateVote(vote *typesCons.HotstuffMessage, signBytes []byte) error {
+	switch vote.GetStep() {
+	case typesCons.HotstuffStep_HOTSTUFF_STEP_PREPARE,
+		typesCons.HotstuffStep_HOTSTUFF_STEP_PRECOMMIT,
+		typesCons.HotstuffStep_HOTSTUFF_STEP_COMMIT:
+	default:
+		return fmt.Errorf("invalid vote step: %s", typesCons.StepToString[vote.GetStep()])
+	}
+	if vote.GetBlock() == nil {
+		return typesCons.ErrNilBlockVote
+	}
+	canonical, err := typesCons.GetSignableBytes(vote)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(canonical, signBytes) {
+		return errors.New("the bytes to sign are not the signable bytes of a vote")
+	}
+	return nil
+}
+
+func (s *Server) validateEnvelope(envelope *messaging.PocketEnvelope) error {
+	if envelope.GetContent() == nil {
+		return errors.New("the envelope has no content")
+	}
+	signature := envelope.GetSignature()
+	if signature == nil || signature.GetTimestamp() == nil {
+		return errors.New("the signature metadata of the envelope is not set")
+	}
+	if len(signature.GetSignature()) != 0 {
+		return errors.New("the envelope is already signed")
+	}
+	publicKey := s.privateKey.PublicKey()
+	if !bytes.Equal(signature.GetPublicKey(), publicKey.Bytes()) || !publicKey.Address().Equals(signature.GetOriginAddress()) {
+		return errors.New("the signer of the envelope is not the key of the remote signer")
+	}
+	return nil
+}
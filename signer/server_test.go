@@ -0,0 +1,206 @@
+package signer
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	typesCons "github.com/pokt-network/pocket/consensus/types"
+	"github.com/pokt-network/pocket/runtime/configs"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/messaging"
+)
+
+// testCA issues the certificates of the remote signer and of the nodes
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key}
+}
+
+// writeCA writes the PEM encoded certificate of the CA to `dir`
+func (ca *testCA) writeCA(t *testing.T, dir string) string {
+	t.Helper()
+	caFile := filepath.Join(dir, "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.cert.Raw}), 0o600))
+	return caFile
+}
+
+// issue writes a certificate issued by the CA and its key to `dir`
+func (ca *testCA) issue(t *testing.T, dir, name string, extKeyUsage x509.ExtKeyUsage) (certFile, keyFile string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{extKeyUsage},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile = filepath.Join(dir, name+".pem")
+	keyFile = filepath.Join(dir, name+"-key.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600))
+	return certFile, keyFile
+}
+
+// startTestServer serves a remote signer of `privateKey` and returns the configuration of a node allowed to use it
+func startTestServer(t *testing.T, privateKey crypto.PrivateKey) *configs.RemoteSignerConfig {
+	t.Helper()
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	caFile := ca.writeCA(t, dir)
+	serverCert, serverKey := ca.issue(t, dir, "signer", x509.ExtKeyUsageServerAuth)
+	clientCert, clientKey := ca.issue(t, dir, "node", x509.ExtKeyUsageClientAuth)
+
+	tlsConfig, err := ServerTLSConfig(serverCert, serverKey, caFile)
+	require.NoError(t, err)
+	protection, err := NewSlashingProtection(filepath.Join(dir, "signer_state.json"))
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcServer := NewGRPCServer(NewServer(privateKey, protection), tlsConfig)
+	go grpcServer.Serve(listener) // nolint:errcheck // stopped on cleanup
+	t.Cleanup(grpcServer.Stop)
+
+	return &configs.RemoteSignerConfig{
+		Enabled:     true,
+		Address:     listener.Addr().String(),
+		CaCertFile:  caFile,
+		CertFile:    clientCert,
+		KeyFile:     clientKey,
+		TimeoutMsec: 5000,
+	}
+}
+
+func newTestVote(t *testing.T, height, round uint64, step typesCons.HotstuffStep, stateHash string) []byte {
+	t.Helper()
+	signBytes, err := typesCons.GetSignableBytes(&typesCons.HotstuffMessage{
+		Type:   typesCons.HotstuffMessageType_HOTSTUFF_MESSAGE_VOTE,
+		Height: height,
+		Round:  round,
+		Step:   step,
+		Block: &coreTypes.Block{
+			BlockHeader: &coreTypes.BlockHeader{Height: height, StateHash: stateHash},
+		},
+	})
+	require.NoError(t, err)
+	return signBytes
+}
+
+func TestRemoteSigner_SignVote(t *testing.T) {
+	privateKey, err := crypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	rs, err := NewRemoteSigner(startTestServer(t, privateKey))
+	require.NoError(t, err)
+	t.Cleanup(func() { rs.Close() })
+
+	require.True(t, rs.PublicKey().Equals(privateKey.PublicKey()))
+
+	vote := newTestVote(t, 1, 0, typesCons.HotstuffStep_HOTSTUFF_STEP_PREPARE, "aa")
+	signature, err := rs.SignVote(vote)
+	require.NoError(t, err)
+	require.True(t, privateKey.PublicKey().Verify(vote, signature))
+
+	// Signing the same vote again is allowed
+	_, err = rs.SignVote(vote)
+	require.NoError(t, err)
+
+	// A different block for the same height, round and step is refused
+	_, err = rs.SignVote(newTestVote(t, 1, 0, typesCons.HotstuffStep_HOTSTUFF_STEP_PREPARE, "bb"))
+	require.Equal(t, codes.FailedPrecondition, status.Code(errorsUnwrapAll(err)))
+
+	// Arbitrary bytes are refused
+	_, err = rs.SignVote([]byte("not a vote"))
+	require.Equal(t, codes.InvalidArgument, status.Code(errorsUnwrapAll(err)))
+
+	// Votes are only signed for the voting steps
+	_, err = rs.SignVote(newTestVote(t, 2, 0, typesCons.HotstuffStep_HOTSTUFF_STEP_DECIDE, "aa"))
+	require.Equal(t, codes.InvalidArgument, status.Code(errorsUnwrapAll(err)))
+}
+
+func TestRemoteSigner_SignEnvelope(t *testing.T) {
+	privateKey, err := crypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	rs, err := NewRemoteSigner(startTestServer(t, privateKey))
+	require.NoError(t, err)
+	t.Cleanup(func() { rs.Close() })
+
+	content, err := anypb.New(&coreTypes.Block{})
+	require.NoError(t, err)
+	envelope := &messaging.PocketEnvelope{Content: content, Nonce: crypto.GetNonce()}
+
+	require.NoError(t, rs.SignEnvelope(envelope))
+	pubKey, err := envelope.VerifySignature()
+	require.NoError(t, err)
+	require.True(t, pubKey.Equals(privateKey.PublicKey()))
+}
+
+func TestRemoteSigner_RequiresClientCertificate(t *testing.T) {
+	privateKey, err := crypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	cfg := startTestServer(t, privateKey)
+
+	// A certificate issued by another CA is rejected by the remote signer
+	dir := t.TempDir()
+	otherCA := newTestCA(t)
+	cfg.CertFile, cfg.KeyFile = otherCA.issue(t, dir, "intruder", x509.ExtKeyUsageClientAuth)
+	cfg.TimeoutMsec = 1000
+
+	_, err = NewRemoteSigner(cfg)
+	require.Error(t, err)
+}
+
+// errorsUnwrapAll returns the innermost wrapped error, i.e. the gRPC status
+func errorsUnwrapAll(err error) error {
+	for {
+		unwrapped, ok := err.(interface{ Unwrap() error })
+		if !ok || unwrapped.Unwrap() == nil {
+			return err
+		}
+		err = unwrapped.Unwrap()
+	}
+}
@@ -6,4 +6,7 @@ var (
 	NonExistentMetricErr = func(metricType, name, action string) error {
 		return fmt.Errorf("tried to %s a non-existent %s: %s", action, name, metricType)
 	}
+	NegativeCounterValueErr = func(name string, value float64) error {
+		return fmt.Errorf("tried to add the negative value %f to the counter: %s", value, name)
+	}
 )
@@ -0,0 +1,17 @@
+package telemetry
+
+const (
+	// Time Series Metrics
+	RPC_REQUESTS_REJECTED_TIMESERIES_METRIC_NAME        = "rpc_requests_rejected_counter"
+	RPC_REQUESTS_REJECTED_TIMESERIES_METRIC_DESCRIPTION = "the counter to track the number of RPC requests rejected by the authentication or the rate limits"
+
+	// Event Metrics
+	RPC_EVENT_METRICS_NAMESPACE = "event_metrics_namespace_rpc"
+
+	RPC_REQUEST_REJECTED_EVENT_METRIC_NAME = "request_rejected_event_metric"
+
+	// Attributes
+	RPC_REQUEST_REJECTED_EVENT_METRIC_REASON_LABEL = "reason"
+	RPC_REQUEST_REJECTED_EVENT_METRIC_ROUTE_LABEL  = "route"
+	RPC_REQUEST_REJECTED_EVENT_METRIC_CLIENT_LABEL = "client"
+)
@@ -0,0 +1,24 @@
+package telemetry
+
+const (
+	// Time Series Metrics
+	SERVICER_TIMESERIES_METRIC_NAMESPACE = "servicer"
+	SERVICER_TIMESERIES_METRIC_MODULE    = "relay"
+
+	SERVICER_RELAYS_TIMESERIES_METRIC_NAME        = "requests_total"
+	SERVICER_RELAYS_TIMESERIES_METRIC_DESCRIPTION = "the counter to track the number of relays handled by the servicer"
+
+	SERVICER_RELAY_LATENCY_TIMESERIES_METRIC_NAME        = "latency_seconds"
+	SERVICER_RELAY_LATENCY_TIMESERIES_METRIC_DESCRIPTION = "the histogram to track the time taken by the servicer to handle relays, including their execution"
+
+	SERVICER_MINED_RELAYS_TIMESERIES_METRIC_NAME        = "mined_total"
+	SERVICER_MINED_RELAYS_TIMESERIES_METRIC_DESCRIPTION = "the counter to track the number of relays stored by the servicer to claim rewards, each of them consuming a session token"
+
+	SERVICER_SESSION_TOKENS_REMAINING_TIMESERIES_METRIC_NAME        = "session_tokens_remaining"
+	SERVICER_SESSION_TOKENS_REMAINING_TIMESERIES_METRIC_DESCRIPTION = "the gauge to track the session tokens the application has left on the servicer, as of the last relay admitted"
+
+	// Attributes
+	SERVICER_RELAY_TIMESERIES_METRIC_CHAIN_LABEL   = "chain"
+	SERVICER_RELAY_TIMESERIES_METRIC_APP_LABEL     = "app"
+	SERVICER_RELAY_TIMESERIES_METRIC_OUTCOME_LABEL = "outcome"
+)
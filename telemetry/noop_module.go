@@ -119,3 +119,26 @@ func (*NoopTelemetryModule) GetGaugeVec(name string) (prometheus.GaugeVec, error
 func (*NoopTelemetryModule) GaugeVecRegister(namespace, module, name, description string, labels []string) {
 	NOOP("GaugeVecRegister", "namespace", namespace, "module", module, "name", name, "description", description, "labels", labels)
 }
+
+func (*NoopTelemetryModule) GaugeVecSet(name string, value float64, labelValues ...string) error {
+	NOOP("GaugeVecSet", "name", name, "value", value, "labelValues", labelValues)
+	return nil
+}
+
+func (*NoopTelemetryModule) CounterVecRegister(namespace, module, name, description string, labels []string) {
+	NOOP("CounterVecRegister", "namespace", namespace, "module", module, "name", name, "description", description, "labels", labels)
+}
+
+func (*NoopTelemetryModule) CounterVecAdd(name string, value float64, labelValues ...string) error {
+	NOOP("CounterVecAdd", "name", name, "value", value, "labelValues", labelValues)
+	return nil
+}
+
+func (*NoopTelemetryModule) HistogramVecRegister(namespace, module, name, description string, labels []string, buckets []float64) {
+	NOOP("HistogramVecRegister", "namespace", namespace, "module", module, "name", name, "description", description, "labels", labels, "buckets", buckets)
+}
+
+func (*NoopTelemetryModule) HistogramVecObserve(name string, value float64, labelValues ...string) error {
+	NOOP("HistogramVecObserve", "name", name, "value", value, "labelValues", labelValues)
+	return nil
+}
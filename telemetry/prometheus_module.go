@@ -31,9 +31,11 @@ type PrometheusTelemetryModule struct {
 
 	logger *modules.Logger
 
-	counters     map[string]prometheus.Counter
-	gauges       map[string]prometheus.Gauge
-	gaugeVectors map[string]prometheus.GaugeVec
+	counters         map[string]prometheus.Counter
+	gauges           map[string]prometheus.Gauge
+	gaugeVectors     map[string]prometheus.GaugeVec
+	counterVectors   map[string]*prometheus.CounterVec
+	histogramVectors map[string]*prometheus.HistogramVec
 }
 
 func CreatePrometheusTelemetryModule(bus modules.Bus, options ...modules.ModuleOption) (modules.Module, error) {
@@ -57,6 +59,8 @@ func (*PrometheusTelemetryModule) Create(bus modules.Bus, options ...modules.Mod
 	m.counters = map[string]prometheus.Counter{}
 	m.gauges = map[string]prometheus.Gauge{}
 	m.gaugeVectors = map[string]prometheus.GaugeVec{}
+	m.counterVectors = map[string]*prometheus.CounterVec{}
+	m.histogramVectors = map[string]*prometheus.HistogramVec{}
 
 	return m, nil
 }
@@ -203,7 +207,7 @@ func (p *PrometheusTelemetryModule) GaugeSub(name string, value float64) (promet
 }
 
 func (p *PrometheusTelemetryModule) GaugeVecRegister(namespace, module, name, description string, labels []string) {
-	if _, exists := p.counters[name]; exists {
+	if _, exists := p.gaugeVectors[name]; exists {
 		p.logger.Warn().Str("gauge_vector", name).Msg("Trying to register and already registered gauge vector")
 		return
 	}
@@ -226,3 +230,87 @@ func (p *PrometheusTelemetryModule) GetGaugeVec(name string) (prometheus.GaugeVe
 	}
 	return prometheus.GaugeVec{}, nil
 }
+
+func (p *PrometheusTelemetryModule) GaugeVecSet(name string, value float64, labelValues ...string) error {
+	gv, exists := p.gaugeVectors[name]
+	if !exists {
+		return NonExistentMetricErr("gauge vector", name, "set")
+	}
+
+	gg, err := gv.GetMetricWithLabelValues(labelValues...)
+	if err != nil {
+		return err
+	}
+	gg.Set(value)
+
+	return nil
+}
+
+func (p *PrometheusTelemetryModule) CounterVecRegister(namespace, module, name, description string, labels []string) {
+	if _, exists := p.counterVectors[name]; exists {
+		p.logger.Warn().Str("counter_vector", name).Msg("Trying to register and already registered counter vector")
+		return
+	}
+
+	p.counterVectors[name] = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: module,
+			Name:      name,
+			Help:      description,
+		},
+		labels,
+	)
+}
+
+// Adds the given value to the counter with the given label values. Prometheus counters cannot decrease so the value must not be negative.
+func (p *PrometheusTelemetryModule) CounterVecAdd(name string, value float64, labelValues ...string) error {
+	cv, exists := p.counterVectors[name]
+	if !exists {
+		return NonExistentMetricErr("counter vector", name, "add to")
+	}
+	if value < 0 {
+		return NegativeCounterValueErr(name, value)
+	}
+
+	counter, err := cv.GetMetricWithLabelValues(labelValues...)
+	if err != nil {
+		return err
+	}
+	counter.Add(value)
+
+	return nil
+}
+
+func (p *PrometheusTelemetryModule) HistogramVecRegister(namespace, module, name, description string, labels []string, buckets []float64) {
+	if _, exists := p.histogramVectors[name]; exists {
+		p.logger.Warn().Str("histogram_vector", name).Msg("Trying to register and already registered histogram vector")
+		return
+	}
+
+	p.histogramVectors[name] = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: module,
+			Name:      name,
+			Help:      description,
+			Buckets:   buckets,
+		},
+		labels,
+	)
+}
+
+func (p *PrometheusTelemetryModule) HistogramVecObserve(name string, value float64, labelValues ...string) error {
+	hv, exists := p.histogramVectors[name]
+	if !exists {
+		return NonExistentMetricErr("histogram vector", name, "observe")
+	}
+
+	histogram, err := hv.GetMetricWithLabelValues(labelValues...)
+	if err != nil {
+		return err
+	}
+	histogram.Observe(value)
+
+	return nil
+}
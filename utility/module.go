@@ -2,7 +2,9 @@ package utility
 
 import (
 	"errors"
+	"fmt"
 
+	"github.com/pokt-network/pocket/app"
 	"github.com/pokt-network/pocket/logger"
 	"github.com/pokt-network/pocket/runtime/configs"
 	"github.com/pokt-network/pocket/shared/mempool"
@@ -12,10 +14,12 @@ import (
 	"github.com/pokt-network/pocket/utility/servicer"
 	"github.com/pokt-network/pocket/utility/types"
 	"github.com/pokt-network/pocket/utility/validator"
+	"golang.org/x/mod/semver"
 )
 
 const (
 	ErrInvalidActorsEnabled = "invalid actors combination enabled"
+	ErrInvalidAppVersion    = "invalid app version"
 )
 
 var (
@@ -39,6 +43,11 @@ func Create(bus modules.Bus, options ...modules.ModuleOption) (modules.Module, e
 }
 
 func (*utilityModule) Create(bus modules.Bus, options ...modules.ModuleOption) (modules.Module, error) {
+	// The binary version is compared to the protocol version of every block, so an invalid one must not start the node
+	if !semver.IsValid(app.AppVersion) {
+		return nil, fmt.Errorf("%s: %q is not a semantic version", ErrInvalidAppVersion, app.AppVersion)
+	}
+
 	m := &utilityModule{
 		actorModules: map[string]modules.Module{},
 	}
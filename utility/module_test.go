@@ -0,0 +1,38 @@
+package utility
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pokt-network/pocket/app"
+	"github.com/pokt-network/pocket/runtime"
+	"github.com/pokt-network/pocket/runtime/configs"
+	mocks "github.com/pokt-network/pocket/shared/modules/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreate_InvalidAppVersion(t *testing.T) {
+	binaryVersion := app.AppVersion
+	t.Cleanup(func() { app.AppVersion = binaryVersion })
+
+	ctrl := gomock.NewController(t)
+	mockRunMgr := mocks.NewMockRuntimeMgr(ctrl)
+	cfg, err := configs.CreateTempConfig(&configs.Config{})
+	require.NoError(t, err)
+	mockRunMgr.EXPECT().GetConfig().Return(cfg).AnyTimes()
+
+	for _, version := range []string{"", "1.0.0", "v1.0.0.1", "dev"} {
+		bus, err := runtime.CreateBus(mockRunMgr)
+		require.NoError(t, err)
+
+		app.AppVersion = version
+		_, err = Create(bus)
+		require.ErrorContains(t, err, ErrInvalidAppVersion, "version %q", version)
+	}
+
+	app.AppVersion = "v1.0.0-rc.1"
+	bus, err := runtime.CreateBus(mockRunMgr)
+	require.NoError(t, err)
+	_, err = Create(bus)
+	require.NoError(t, err)
+}
@@ -0,0 +1,118 @@
+package servicer
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/pokt-network/pocket/runtime/configs"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+)
+
+// payloadRedaction controls how the relay payloads appear in the access log
+type payloadRedaction string
+
+const (
+	payloadRedactionFull payloadRedaction = "full"
+	payloadRedactionHash payloadRedaction = "hash"
+	payloadRedactionNone payloadRedaction = "none"
+)
+
+// relayAccessLog writes a JSON line for every relay handled by the servicer
+type relayAccessLog struct {
+	logger    zerolog.Logger
+	redaction payloadRedaction
+	// file is the file the entries are appended to, it is nil when writing to stdout
+	file *os.File
+}
+
+func newRelayAccessLog(cfg *configs.RelayAccessLogConfig) (*relayAccessLog, error) {
+	redaction := payloadRedaction(cfg.GetPayloadRedaction())
+	switch redaction {
+	case "":
+		redaction = payloadRedactionFull
+	case payloadRedactionFull, payloadRedactionHash, payloadRedactionNone:
+	default:
+		return nil, fmt.Errorf("invalid relay access log payload redaction %q: must be one of %q, %q or %q",
+			redaction, payloadRedactionFull, payloadRedactionHash, payloadRedactionNone)
+	}
+
+	accessLog := &relayAccessLog{redaction: redaction}
+	var w io.Writer = os.Stdout
+	if path := cfg.GetFilePath(); path != "" {
+		file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("Error opening relay access log file %s: %w", path, err)
+		}
+		accessLog.file = file
+		w = file
+	}
+	accessLog.logger = zerolog.New(w).With().Timestamp().Logger()
+
+	return accessLog, nil
+}
+
+// log writes the access log entry of a relay. The session and the response may be nil if the relay was rejected,
+// in which case the chain and the application requested by the relay are logged.
+func (l *relayAccessLog) log(relay *coreTypes.Relay, session *coreTypes.Session, response *coreTypes.RelayResponse, outcome relayOutcome, mined bool, latency time.Duration, err error) {
+	chain, app := relay.GetMeta().GetRelayChain().GetId(), relay.GetMeta().GetApplicationAddress()
+	if session != nil {
+		chain, app = relayLabels(session)
+	}
+	event := l.logger.Info().
+		Str("chain", chain).
+		Str("app", app).
+		Str("outcome", string(outcome)).
+		Bool("mined", mined).
+		Float64("latency_ms", float64(latency.Microseconds())/1000)
+
+	if meta := relay.GetMeta(); meta != nil {
+		event = event.
+			Int64("block_height", meta.GetBlockHeight()).
+			Str("geozone", meta.GetGeoZone().GetId())
+	}
+
+	var requestPayload string
+	switch payload := relay.GetRelayPayload().(type) {
+	case *coreTypes.Relay_JsonRpcPayload:
+		event = event.Str("payload_type", "json_rpc").Str("method", payload.JsonRpcPayload.GetMethod())
+		requestPayload = string(payload.JsonRpcPayload.GetParameters())
+	case *coreTypes.Relay_RestPayload:
+		event = event.Str("payload_type", "rest").Str("path", payload.RestPayload.GetHttpPath())
+		requestPayload = payload.RestPayload.GetContents()
+	}
+	event = l.logPayload(event, "request", requestPayload)
+	if response != nil {
+		event = l.logPayload(event, "response", response.GetPayload())
+	}
+
+	if err != nil {
+		event = event.Err(err)
+	}
+	event.Msg("relay")
+}
+
+// logPayload adds the payload to the entry according to the configured redaction, its size is always logged
+func (l *relayAccessLog) logPayload(event *zerolog.Event, name, payload string) *zerolog.Event {
+	event = event.Int(name+"_size", len(payload))
+	switch l.redaction {
+	case payloadRedactionHash:
+		return event.Str(name+"_hash", hex.EncodeToString(cryptoPocket.SHA3Hash([]byte(payload))))
+	case payloadRedactionNone:
+		return event.Str(name, payload)
+	default:
+		return event
+	}
+}
+
+func (l *relayAccessLog) close() error {
+	if l.file == nil {
+		return nil
+	}
+	return l.file.Close()
+}
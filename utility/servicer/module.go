@@ -31,6 +31,7 @@ var (
 	errValidateRelayMeta   = errors.New("relay failed metadata validation")
 	errValidateServicer    = errors.New("relay failed servicer validation")
 	errShouldMineRelay     = errors.New("relay failed validating available tokens")
+	errExecuteRelay        = errors.New("relay failed execution")
 
 	_ modules.ServicerModule = &servicer{}
 )
@@ -67,6 +68,9 @@ type servicer struct {
 	address string
 	// public key of the servicer, calculated from the provided private key.
 	publicKey string
+
+	// accessLog writes the relays handled by the servicer as JSON lines, it is nil unless enabled in the servicer's configuration.
+	accessLog *relayAccessLog
 }
 
 var (
@@ -111,11 +115,28 @@ func (*servicer) Create(bus modules.Bus, options ...modules.ModuleOption) (modul
 
 // TODO: implement this function
 func (s *servicer) Start() error {
+	s.registerMetrics()
+
+	if accessLogCfg := s.config.GetRelayAccessLog(); accessLogCfg.GetEnabled() {
+		accessLog, err := newRelayAccessLog(accessLogCfg)
+		if err != nil {
+			return err
+		}
+		s.accessLog = accessLog
+	}
+
 	s.logger.Info().Msg("🧬 Servicer module started 🧬")
 	return nil
 }
 
 func (s *servicer) Stop() error {
+	if s.accessLog != nil {
+		if err := s.accessLog.close(); err != nil {
+			return fmt.Errorf("Error closing the relay access log: %w", err)
+		}
+		s.accessLog = nil
+	}
+
 	s.logger.Info().Msg("🧬 Servicer module stopped 🧬")
 	return nil
 }
@@ -125,46 +146,51 @@ func (s *servicer) GetModuleName() string {
 }
 
 // HandleRelay processes a relay after performing validation.
-// It also updates the servicer's internal state to keep track of served relays.
+// It also updates the servicer's internal state to keep track of served relays, and records the relay in the metrics and the access log.
 func (s *servicer) HandleRelay(relay *coreTypes.Relay) (*coreTypes.RelayResponse, error) {
+	start := time.Now()
+	response, session, mined, err := s.handleRelay(relay)
+	s.recordRelay(relay, session, response, mined, time.Since(start), err)
+	return response, err
+}
+
+// handleRelay serves the relay and returns the session it was admitted in, which is nil if it was not admitted,
+// and whether it was stored to claim rewards for the session
+func (s *servicer) handleRelay(relay *coreTypes.Relay) (response *coreTypes.RelayResponse, session *coreTypes.Session, mined bool, err error) {
 	if relay == nil {
-		return nil, fmt.Errorf("cannot serve nil relay")
+		return nil, nil, false, fmt.Errorf("cannot serve nil relay")
 	}
 
-	if err := s.admitRelay(relay); err != nil {
-		return nil, fmt.Errorf("Error admitting relay: %w", err)
+	session, err = s.admitRelay(relay)
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("Error admitting relay: %w", err)
 	}
 
-	response, err := s.executeRelay(relay)
+	response, err = s.executeRelay(relay)
 	if err != nil {
-		return nil, fmt.Errorf("Error executing relay: %w", err)
+		return nil, session, false, fmt.Errorf("Error executing relay: %s: %w", err.Error(), errExecuteRelay)
 	}
 
 	// TODO(M6): Look into data integrity checks and response validation.
 
-	session, err := s.getSession(relay)
-	if err != nil {
-		return nil, err
-	}
-
 	relayDigest, relayReqResBytes, shouldStore, err := s.isRelayVolumeApplicable(session, relay, response)
 	if err != nil {
-		return nil, fmt.Errorf("Error calculating relay service digest: %w", err)
+		return nil, session, false, fmt.Errorf("Error calculating relay service digest: %w", err)
 	}
 	if !shouldStore {
-		return response, nil
+		return response, session, false, nil
 	}
 
 	localCtx, err := s.GetBus().GetPersistenceModule().GetLocalContext()
 	if err != nil {
-		return nil, fmt.Errorf("Error getting a local context to update token usage for application %s: %w", relay.Meta.ApplicationAddress, err)
+		return nil, session, false, fmt.Errorf("Error getting a local context to update token usage for application %s: %w", session.GetApplication().GetAddress(), err)
 	}
 
 	if err := localCtx.StoreServicedRelay(session, relayDigest, relayReqResBytes); err != nil {
-		return nil, fmt.Errorf("Error recording service proof for application %s: %w", relay.Meta.ApplicationAddress, err)
+		return nil, session, false, fmt.Errorf("Error recording service proof for application %s: %w", session.GetApplication().GetAddress(), err)
 	}
 
-	return response, nil
+	return response, session, true, nil
 }
 
 // isRelayVolumeApplicable returns:
@@ -283,6 +309,7 @@ func (s *servicer) shouldMineRelay(session *coreTypes.Session) error {
 	if err != nil {
 		return fmt.Errorf("Error getting servicer token usage: application %s session number %d: %w", session.Application.PublicKey, session.SessionNumber, err)
 	}
+	s.recordSessionTokens(session, servicerAppSessionTokens, usedAppSessionTokens)
 
 	if usedAppSessionTokens == nil || usedAppSessionTokens.Cmp(servicerAppSessionTokens) < 0 {
 		return nil // should attempt to mine a relay
@@ -376,38 +403,38 @@ func (s *servicer) getSession(relay *coreTypes.Relay) (*coreTypes.Session, error
 	return session, nil
 }
 
-// admitRelay decides whether the relay should be served
-func (s *servicer) admitRelay(relay *coreTypes.Relay) error {
+// admitRelay decides whether the relay should be served and returns the session it is served in
+func (s *servicer) admitRelay(relay *coreTypes.Relay) (*coreTypes.Session, error) {
 	// TODO: utility module should initialize the servicer (if this module instance is a servicer)
 	const errPrefix = "Error admitting relay"
 
 	if relay == nil {
-		return fmt.Errorf("%s: relay is nil", errPrefix)
+		return nil, fmt.Errorf("%s: relay is nil", errPrefix)
 	}
 
 	height := s.GetBus().GetConsensusModule().CurrentHeight()
 	if err := s.validateRelayMeta(relay.Meta, int64(height)); err != nil {
-		return fmt.Errorf("%s: %w", err.Error(), errValidateRelayMeta)
+		return nil, fmt.Errorf("%s: %w", err.Error(), errValidateRelayMeta)
 	}
 
 	session, err := s.getSession(relay)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := validateRelayBlockHeight(relay.Meta, session); err != nil {
-		return fmt.Errorf("%s: %w", err.Error(), errValidateBlockHeight)
+		return nil, fmt.Errorf("%s: %w", err.Error(), errValidateBlockHeight)
 	}
 
 	if err := s.validateServicer(relay.Meta, session); err != nil {
-		return fmt.Errorf("%s: %s: %w", errPrefix, err.Error(), errValidateServicer)
+		return nil, fmt.Errorf("%s: %s: %w", errPrefix, err.Error(), errValidateServicer)
 	}
 
 	if err := s.shouldMineRelay(session); err != nil {
-		return fmt.Errorf("%s: %s: %w", errPrefix, err.Error(), errShouldMineRelay)
+		return nil, fmt.Errorf("%s: %s: %w", errPrefix, err.Error(), errShouldMineRelay)
 	}
 
-	return nil
+	return session, nil
 }
 
 // ADDTEST: Need to add more unit tests for the numerical portion of this functionality
@@ -1,13 +1,17 @@
 package servicer
 
 import (
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"log"
 	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -156,7 +160,7 @@ func TestRelay_Admit(t *testing.T) {
 			servicer, ok := servicerMod.(*servicer)
 			require.True(t, ok)
 
-			err = servicer.admitRelay(testCase.relay)
+			_, err = servicer.admitRelay(testCase.relay)
 			require.ErrorIs(t, err, testCase.expected)
 		})
 	}
@@ -203,6 +207,160 @@ func TestRelay_Execute(t *testing.T) {
 	}
 }
 
+func TestRelay_Handle(t *testing.T) {
+	testCases := []struct {
+		name              string
+		relay             *coreTypes.Relay
+		usedSessionTokens int64
+		expectedOutcome   relayOutcome
+		expectedChain     string
+	}{
+		{
+			name:            "nil relay is recorded as an error",
+			expectedOutcome: relayOutcomeError,
+		},
+		{
+			name:            "Relay for unsupported service is recorded as invalid",
+			relay:           testRelay(testRelayChain("foo")),
+			expectedOutcome: relayOutcomeInvalidMeta,
+			expectedChain:   "foo",
+		},
+		{
+			name:              "Relay for app out of quota is recorded as exhausting the session tokens",
+			relay:             testRelay(),
+			usedSessionTokens: 999999,
+			expectedOutcome:   relayOutcomeTokensExhausted,
+			expectedChain:     "POKT-UnitTestNet",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			accessLogPath := filepath.Join(t.TempDir(), "relays.log")
+			config := testServicerConfig(withRelayAccessLog(accessLogPath, "hash"))
+			session := testSession(
+				sessionNumber(2),
+				sessionBlocks(4),
+				sessionHeight(8),
+				sessionServicers(testServicer1),
+			)
+			mockBus := mockBus(t, config, uint64(testCurrentHeight), session, testCase.usedSessionTokens)
+
+			servicerMod, err := CreateServicer(mockBus)
+			require.NoError(t, err)
+			require.NoError(t, servicerMod.Start())
+
+			_, relayErr := servicerMod.HandleRelay(testCase.relay)
+			require.Error(t, relayErr)
+			require.Equal(t, testCase.expectedOutcome, getRelayOutcome(relayErr))
+			require.NoError(t, servicerMod.Stop())
+
+			accessLog, err := os.ReadFile(accessLogPath)
+			require.NoError(t, err)
+			var entry map[string]any
+			require.NoError(t, json.Unmarshal(accessLog, &entry))
+			require.Equal(t, string(testCase.expectedOutcome), entry["outcome"])
+			require.Equal(t, testCase.expectedChain, entry["chain"])
+			require.Equal(t, false, entry["mined"])
+			require.Equal(t, relayErr.Error(), entry["error"])
+			if testCase.relay != nil {
+				require.Equal(t, testApp1.Address, entry["app"])
+				require.Equal(t, "rest", entry["payload_type"])
+				require.Contains(t, entry, "request_hash")
+			}
+		})
+	}
+}
+
+func TestRelay_AccessLogRedaction(t *testing.T) {
+	const (
+		requestPayload  = `{"address":"0x1234"}`
+		responsePayload = `{"balance":"0x5678"}`
+	)
+
+	testCases := []struct {
+		name       string
+		redaction  string
+		expected   map[string]any
+		notLogged  []string
+		expectsErr bool
+	}{
+		{
+			name:      "payloads are omitted by default",
+			expected:  map[string]any{"request_size": float64(len(requestPayload)), "response_size": float64(len(responsePayload))},
+			notLogged: []string{"request", "response", "request_hash", "response_hash"},
+		},
+		{
+			name:      "payloads are hashed",
+			redaction: "hash",
+			expected: map[string]any{
+				"request_hash":  hex.EncodeToString(crypto.SHA3Hash([]byte(requestPayload))),
+				"response_hash": hex.EncodeToString(crypto.SHA3Hash([]byte(responsePayload))),
+			},
+			notLogged: []string{"request", "response"},
+		},
+		{
+			name:      "payloads are logged as is",
+			redaction: "none",
+			expected:  map[string]any{"request": requestPayload, "response": responsePayload},
+		},
+		{
+			name:       "unknown redaction is rejected",
+			redaction:  "partial",
+			expectsErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			accessLogPath := filepath.Join(t.TempDir(), "relays.log")
+			accessLog, err := newRelayAccessLog(&configs.RelayAccessLogConfig{
+				Enabled:          true,
+				FilePath:         accessLogPath,
+				PayloadRedaction: testCase.redaction,
+			})
+			if testCase.expectsErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			relay := testRelay(testEthGoerliRelay())
+			relay.GetJsonRpcPayload().Parameters = []byte(requestPayload)
+			accessLog.log(relay, nil, &coreTypes.RelayResponse{Payload: responsePayload}, relayOutcomeSuccess, true, 25*time.Millisecond, nil)
+			require.NoError(t, accessLog.close())
+
+			bz, err := os.ReadFile(accessLogPath)
+			require.NoError(t, err)
+			var entry map[string]any
+			require.NoError(t, json.Unmarshal(bz, &entry))
+			require.Equal(t, "ETH-Goerli", entry["chain"])
+			require.Equal(t, "eth_blockNumber", entry["method"])
+			require.Equal(t, string(relayOutcomeSuccess), entry["outcome"])
+			require.Equal(t, float64(25), entry["latency_ms"])
+			for field, value := range testCase.expected {
+				require.Equal(t, value, entry[field], field)
+			}
+			for _, field := range testCase.notLogged {
+				require.NotContains(t, entry, field)
+			}
+		})
+	}
+}
+
+func TestRelay_Labels(t *testing.T) {
+	// the chain and the application requested by a relay that was not admitted are not used as labels
+	chain, app := relayLabels(nil)
+	require.Equal(t, relayLabelUnknown, chain)
+	require.Equal(t, relayLabelUnknown, app)
+
+	session := testSession()
+	session.RelayChain = "POKT-UnitTestNet"
+	chain, app = relayLabels(session)
+	require.Equal(t, "POKT-UnitTestNet", chain)
+	require.Equal(t, testApp1.Address, app)
+}
+
 func TestRelay_Sign(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -315,6 +473,16 @@ func withPrivateKey(key string) func(*configs.ServicerConfig) {
 	}
 }
 
+func withRelayAccessLog(path, redaction string) func(*configs.ServicerConfig) {
+	return func(cfg *configs.ServicerConfig) {
+		cfg.RelayAccessLog = &configs.RelayAccessLogConfig{
+			Enabled:          true,
+			FilePath:         path,
+			PayloadRedaction: redaction,
+		}
+	}
+}
+
 func testServicerConfig(editors ...configModifier) *configs.ServicerConfig {
 	config := configs.ServicerConfig{
 		PrivateKey: testServicer1PrivateKey.String(),
@@ -394,8 +562,20 @@ func mockBus(t *testing.T, cfg *configs.ServicerConfig, height uint64, session *
 	persistenceMock.EXPECT().NewReadContext(gomock.Any()).Return(persistenceReadContextMock, nil).AnyTimes()
 	persistenceMock.EXPECT().GetLocalContext().Return(persistenceLocalContextMock, nil).AnyTimes()
 
+	timeSeriesAgentMock := mockModules.NewMockTimeSeriesAgent(ctrl)
+	timeSeriesAgentMock.EXPECT().CounterVecRegister(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	timeSeriesAgentMock.EXPECT().HistogramVecRegister(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	timeSeriesAgentMock.EXPECT().GaugeVecRegister(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	timeSeriesAgentMock.EXPECT().CounterVecAdd(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	timeSeriesAgentMock.EXPECT().HistogramVecObserve(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	timeSeriesAgentMock.EXPECT().GaugeVecSet(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	telemetryMock := mockModules.NewMockTelemetryModule(ctrl)
+	telemetryMock.EXPECT().GetTimeSeriesAgent().Return(timeSeriesAgentMock).AnyTimes()
+
 	busMock := mockModules.NewMockBus(ctrl)
 	busMock.EXPECT().GetRuntimeMgr().Return(runtimeMgrMock).AnyTimes()
+	busMock.EXPECT().GetTelemetryModule().Return(telemetryMock).AnyTimes()
 	busMock.EXPECT().GetPersistenceModule().Return(persistenceMock).AnyTimes()
 	busMock.EXPECT().GetConsensusModule().Return(consensusMock).AnyTimes()
 	busMock.EXPECT().GetUtilityModule().Return(baseUtilityMock(ctrl, session)).AnyTimes()
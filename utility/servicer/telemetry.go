@@ -0,0 +1,147 @@
+package servicer
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/telemetry"
+)
+
+// relayOutcome labels the relays in the metrics and the access log with the result of their handling
+type relayOutcome string
+
+const (
+	relayOutcomeSuccess            relayOutcome = "success"
+	relayOutcomeInvalidMeta        relayOutcome = "invalid_meta"
+	relayOutcomeInvalidBlockHeight relayOutcome = "invalid_block_height"
+	relayOutcomeInvalidServicer    relayOutcome = "invalid_servicer"
+	relayOutcomeTokensExhausted    relayOutcome = "tokens_exhausted"
+	relayOutcomeExecutionError     relayOutcome = "execution_error"
+	relayOutcomeError              relayOutcome = "error"
+)
+
+// relayLatencyBuckets are the buckets, in seconds, of the relay latency histogram
+var relayLatencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+// getRelayOutcome maps the error returned by HandleRelay to the outcome of the relay
+func getRelayOutcome(err error) relayOutcome {
+	switch {
+	case err == nil:
+		return relayOutcomeSuccess
+	case errors.Is(err, errValidateRelayMeta):
+		return relayOutcomeInvalidMeta
+	case errors.Is(err, errValidateBlockHeight):
+		return relayOutcomeInvalidBlockHeight
+	case errors.Is(err, errValidateServicer):
+		return relayOutcomeInvalidServicer
+	case errors.Is(err, errShouldMineRelay):
+		return relayOutcomeTokensExhausted
+	case errors.Is(err, errExecuteRelay):
+		return relayOutcomeExecutionError
+	default:
+		return relayOutcomeError
+	}
+}
+
+// relayLabelUnknown labels the chain and the application of the relays that were not admitted
+const relayLabelUnknown = "unknown"
+
+// relayLabels returns the chain and the application address of the session the relay was admitted in. The values
+// supplied by the relays that were not admitted are not used, so clients cannot create an unbounded number of series.
+func relayLabels(session *coreTypes.Session) (chain, app string) {
+	if session == nil {
+		return relayLabelUnknown, relayLabelUnknown
+	}
+	return session.GetRelayChain(), session.GetApplication().GetAddress()
+}
+
+// registerMetrics registers the relay metrics labelled by chain, application and outcome
+func (s *servicer) registerMetrics() {
+	timeSeriesAgent := s.GetBus().GetTelemetryModule().GetTimeSeriesAgent()
+	labels := []string{
+		telemetry.SERVICER_RELAY_TIMESERIES_METRIC_CHAIN_LABEL,
+		telemetry.SERVICER_RELAY_TIMESERIES_METRIC_APP_LABEL,
+		telemetry.SERVICER_RELAY_TIMESERIES_METRIC_OUTCOME_LABEL,
+	}
+	sessionLabels := []string{
+		telemetry.SERVICER_RELAY_TIMESERIES_METRIC_CHAIN_LABEL,
+		telemetry.SERVICER_RELAY_TIMESERIES_METRIC_APP_LABEL,
+	}
+
+	timeSeriesAgent.CounterVecRegister(
+		telemetry.SERVICER_TIMESERIES_METRIC_NAMESPACE,
+		telemetry.SERVICER_TIMESERIES_METRIC_MODULE,
+		telemetry.SERVICER_RELAYS_TIMESERIES_METRIC_NAME,
+		telemetry.SERVICER_RELAYS_TIMESERIES_METRIC_DESCRIPTION,
+		labels,
+	)
+	timeSeriesAgent.HistogramVecRegister(
+		telemetry.SERVICER_TIMESERIES_METRIC_NAMESPACE,
+		telemetry.SERVICER_TIMESERIES_METRIC_MODULE,
+		telemetry.SERVICER_RELAY_LATENCY_TIMESERIES_METRIC_NAME,
+		telemetry.SERVICER_RELAY_LATENCY_TIMESERIES_METRIC_DESCRIPTION,
+		labels,
+		relayLatencyBuckets,
+	)
+	timeSeriesAgent.CounterVecRegister(
+		telemetry.SERVICER_TIMESERIES_METRIC_NAMESPACE,
+		telemetry.SERVICER_TIMESERIES_METRIC_MODULE,
+		telemetry.SERVICER_MINED_RELAYS_TIMESERIES_METRIC_NAME,
+		telemetry.SERVICER_MINED_RELAYS_TIMESERIES_METRIC_DESCRIPTION,
+		sessionLabels,
+	)
+	timeSeriesAgent.GaugeVecRegister(
+		telemetry.SERVICER_TIMESERIES_METRIC_NAMESPACE,
+		telemetry.SERVICER_TIMESERIES_METRIC_MODULE,
+		telemetry.SERVICER_SESSION_TOKENS_REMAINING_TIMESERIES_METRIC_NAME,
+		telemetry.SERVICER_SESSION_TOKENS_REMAINING_TIMESERIES_METRIC_DESCRIPTION,
+		sessionLabels,
+	)
+}
+
+// recordRelay updates the relay metrics and writes the access log entry of the relay if the access log is enabled
+func (s *servicer) recordRelay(relay *coreTypes.Relay, session *coreTypes.Session, response *coreTypes.RelayResponse, mined bool, latency time.Duration, relayErr error) {
+	outcome := getRelayOutcome(relayErr)
+	chain, app := relayLabels(session)
+
+	timeSeriesAgent := s.GetBus().GetTelemetryModule().GetTimeSeriesAgent()
+	if err := timeSeriesAgent.CounterVecAdd(telemetry.SERVICER_RELAYS_TIMESERIES_METRIC_NAME, 1, chain, app, string(outcome)); err != nil {
+		s.logger.Warn().Err(err).Msg("failed to update the relays counter")
+	}
+	if err := timeSeriesAgent.HistogramVecObserve(telemetry.SERVICER_RELAY_LATENCY_TIMESERIES_METRIC_NAME, latency.Seconds(), chain, app, string(outcome)); err != nil {
+		s.logger.Warn().Err(err).Msg("failed to update the relay latency histogram")
+	}
+	if mined {
+		if err := timeSeriesAgent.CounterVecAdd(telemetry.SERVICER_MINED_RELAYS_TIMESERIES_METRIC_NAME, 1, chain, app); err != nil {
+			s.logger.Warn().Err(err).Msg("failed to update the mined relays counter")
+		}
+	}
+
+	if s.accessLog != nil {
+		s.accessLog.log(relay, session, response, outcome, mined, latency, relayErr)
+	}
+}
+
+// recordSessionTokens updates the gauge tracking the session tokens the application of the session has left on the servicer
+func (s *servicer) recordSessionTokens(session *coreTypes.Session, startingTokens, usedTokens *big.Int) {
+	remaining := new(big.Int).Set(startingTokens)
+	if usedTokens != nil {
+		remaining.Sub(remaining, usedTokens)
+	}
+	if remaining.Sign() < 0 {
+		remaining.SetInt64(0)
+	}
+	value, _ := new(big.Float).SetInt(remaining).Float64()
+
+	err := s.GetBus().GetTelemetryModule().GetTimeSeriesAgent().GaugeVecSet(
+		telemetry.SERVICER_SESSION_TOKENS_REMAINING_TIMESERIES_METRIC_NAME,
+		value,
+		session.GetRelayChain(),
+		session.GetApplication().GetAddress(),
+	)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("failed to update the session tokens gauge")
+	}
+}
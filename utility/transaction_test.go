@@ -75,6 +75,85 @@ func TestHandleTransaction_ErrorAlreadyCommitted(t *testing.T) {
 	require.EqualError(t, err, core_types.ErrTransactionAlreadyCommitted().Error())
 }
 
+func TestHandleTransaction_ErrorMultisigReplay(t *testing.T) {
+	// Prepare the environment
+	_, utilityMod, persistenceMod := prepareEnvironment(t, 0, 0, 0, 0)
+
+	members := make([]crypto.PrivateKey, 0, 3)
+	publicKeys := make([]crypto.PublicKey, 0, 3)
+	for i := 0; i < 3; i++ {
+		member, err := crypto.GeneratePrivateKey()
+		require.NoError(t, err)
+		members = append(members, member)
+		publicKeys = append(publicKeys, member.PublicKey())
+	}
+	multisigKey, err := crypto.NewMultisigPublicKey(2, publicKeys)
+	require.NoError(t, err)
+
+	message := &util_types.MessageSend{
+		FromAddress: multisigKey.Address(),
+		ToAddress:   []byte("to"),
+		Amount:      "10",
+	}
+	anyMessage, err := codec.GetCodec().ToAny(message)
+	require.NoError(t, err)
+	tx := &core_types.Transaction{
+		Nonce: strconv.Itoa(int(crypto.GetNonce())),
+		Msg:   anyMessage,
+	}
+	for _, member := range members[:2] {
+		require.NoError(t, tx.SignMultisig(member, core_types.NewMultisigPublicKey(multisigKey)))
+	}
+	txProtoBytes, err := codec.GetCodec().Marshal(tx)
+	require.NoError(t, err)
+
+	// Commit the multisig transaction
+	err = persistenceMod.GetTxIndexer().Index(&core_types.IndexedTransaction{
+		Tx:          txProtoBytes,
+		SignerAddr:  multisigKey.Address().String(),
+		MessageType: "MessageSend",
+	})
+	require.NoError(t, err)
+
+	rwCtx, err := persistenceMod.NewRWContext(0)
+	require.NoError(t, err)
+	_, err = rwCtx.ComputeStateHash()
+	require.NoError(t, err)
+	rwCtx.Release()
+
+	err = utilityMod.HandleTransaction(txProtoBytes)
+	require.EqualError(t, err, core_types.ErrTransactionAlreadyCommitted().Error())
+
+	// The permuted copies of the committed transaction have a different hash but must not be accepted either
+	txReorderedSignatures := proto.Clone(tx).(*core_types.Transaction)
+	signatures := txReorderedSignatures.MultiSignature.Signatures
+	signatures[0], signatures[1] = signatures[1], signatures[0]
+
+	txReorderedKeys := proto.Clone(tx).(*core_types.Transaction)
+	keys := txReorderedKeys.MultiSignature.PublicKey.PublicKeys
+	keys[0], keys[2] = keys[2], keys[0]
+
+	txSurplusSignature := proto.Clone(tx).(*core_types.Transaction)
+	signBytes, err := tx.SignableBytes()
+	require.NoError(t, err)
+	surplusSignature, err := members[2].Sign(signBytes)
+	require.NoError(t, err)
+	txSurplusSignature.MultiSignature.Signatures = append(txSurplusSignature.MultiSignature.Signatures, &core_types.Signature{
+		PublicKey: members[2].PublicKey().Bytes(),
+		Signature: surplusSignature,
+	})
+
+	for _, permutedTx := range []*core_types.Transaction{txReorderedSignatures, txReorderedKeys, txSurplusSignature} {
+		permutedTxProtoBytes, err := codec.GetCodec().Marshal(permutedTx)
+		require.NoError(t, err)
+		require.NotEqual(t, txProtoBytes, permutedTxProtoBytes)
+
+		err = utilityMod.HandleTransaction(permutedTxProtoBytes)
+		require.Error(t, err)
+		require.Equal(t, core_types.CodeInvalidMultisigError, err.(core_types.Error).Code())
+	}
+}
+
 func TestHandleTransaction_BasicValidation(t *testing.T) {
 	privKey, err := crypto.GeneratePrivateKey()
 	require.NoError(t, err)
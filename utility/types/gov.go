@@ -83,6 +83,22 @@ const (
 
 	// Parameter / flags gov params
 	MessageChangeParameterFee = "message_change_parameter_fee"
+
+	// Governance proposal gov params
+	MessageSubmitProposalFee               = "message_submit_proposal_fee"
+	MessageVoteFee                         = "message_vote_fee"
+	GovernanceVotingBlocksParamName        = "governance_voting_blocks"
+	GovernanceQuorumPercentageParamName    = "governance_quorum_percentage"
+	GovernanceThresholdPercentageParamName = "governance_threshold_percentage"
+
+	// Protocol upgrade gov params
+	MessageUpgradeFee = "message_upgrade_fee"
+
+	// Delegation gov params
+	ValidatorCommissionPercentageParamName = "validator_commission_percentage"
+	MessageDelegateFee                     = "message_delegate_fee"
+	MessageUndelegateFee                   = "message_undelegate_fee"
+	MessageRedelegateFee                   = "message_redelegate_fee"
 )
 
 // TECHDEBT: The parameters below are equivalent to the list above with the suffix `_owner`. There
@@ -154,4 +170,17 @@ const (
 	MessageUnpauseServicerFeeOwner        = "message_unpause_servicer_fee_owner"
 
 	MessageChangeParameterFeeOwner = "message_change_parameter_fee_owner"
+
+	MessageSubmitProposalFeeOwner      = "message_submit_proposal_fee_owner"
+	MessageVoteFeeOwner                = "message_vote_fee_owner"
+	GovernanceVotingBlocksOwner        = "governance_voting_blocks_owner"
+	GovernanceQuorumPercentageOwner    = "governance_quorum_percentage_owner"
+	GovernanceThresholdPercentageOwner = "governance_threshold_percentage_owner"
+
+	MessageUpgradeFeeOwner = "message_upgrade_fee_owner"
+
+	ValidatorCommissionPercentageOwner = "validator_commission_percentage_owner"
+	MessageDelegateFeeOwner            = "message_delegate_fee_owner"
+	MessageUndelegateFeeOwner          = "message_undelegate_fee_owner"
+	MessageRedelegateFeeOwner          = "message_redelegate_fee_owner"
 )
@@ -1,12 +1,14 @@
 package types
 
 import (
+	"bytes"
 	"encoding/hex"
 	"log"
 
 	"github.com/pokt-network/pocket/shared/codec"
 	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	cryptoPocket "github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/utils"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -37,6 +39,12 @@ var (
 	_ Message = &MessageUnstake{}
 	_ Message = &MessageUnpause{}
 	_ Message = &MessageChangeParameter{}
+	_ Message = &MessageSubmitProposal{}
+	_ Message = &MessageVote{}
+	_ Message = &MessageUpgrade{}
+	_ Message = &MessageDelegate{}
+	_ Message = &MessageUndelegate{}
+	_ Message = &MessageRedelegate{}
 )
 
 func (msg *MessageSend) ValidateBasic() coreTypes.Error {
@@ -84,6 +92,96 @@ func (msg *MessageChangeParameter) ValidateBasic() coreTypes.Error {
 	}
 	return nil
 }
+func (msg *MessageUpgrade) ValidateBasic() coreTypes.Error {
+	if err := validateAddress(msg.Signer); err != nil {
+		return err
+	}
+	if err := coreTypes.ValidateProtocolVersion(msg.Version); err != nil {
+		return err
+	}
+	if msg.Height <= 0 {
+		return coreTypes.ErrInvalidUpgradeHeight(msg.Height, 0)
+	}
+	return nil
+}
+func (msg *MessageDelegate) ValidateBasic() coreTypes.Error {
+	if err := validateAddress(msg.Delegator); err != nil {
+		return err
+	}
+	if err := validateAddress(msg.ValidatorAddress); err != nil {
+		return err
+	}
+	return validateDelegationAmount(msg.Amount)
+}
+func (msg *MessageUndelegate) ValidateBasic() coreTypes.Error {
+	if err := validateAddress(msg.Delegator); err != nil {
+		return err
+	}
+	if err := validateAddress(msg.ValidatorAddress); err != nil {
+		return err
+	}
+	return validateDelegationAmount(msg.Amount)
+}
+func (msg *MessageRedelegate) ValidateBasic() coreTypes.Error {
+	if err := validateAddress(msg.Delegator); err != nil {
+		return err
+	}
+	if err := validateAddress(msg.SourceValidatorAddress); err != nil {
+		return err
+	}
+	if err := validateAddress(msg.DestinationValidatorAddress); err != nil {
+		return err
+	}
+	if bytes.Equal(msg.SourceValidatorAddress, msg.DestinationValidatorAddress) {
+		return coreTypes.ErrInvalidRedelegation("the source and destination validators are the same")
+	}
+	return validateDelegationAmount(msg.Amount)
+}
+func (msg *MessageSubmitProposal) ValidateBasic() coreTypes.Error {
+	if err := validateAddress(msg.Proposer); err != nil {
+		return err
+	}
+	if msg.Title == "" {
+		return coreTypes.ErrInvalidProposal("the title is empty")
+	}
+	switch content := msg.Content.(type) {
+	case *MessageSubmitProposal_ParamChange:
+		if content.ParamChange.GetParameterKey() == "" {
+			return coreTypes.ErrEmptyParamKey()
+		}
+		if content.ParamChange.GetParameterValue() == nil {
+			return coreTypes.ErrEmptyParamValue()
+		}
+	case *MessageSubmitProposal_FlagChange:
+		if content.FlagChange.GetFlagKey() == "" {
+			return coreTypes.ErrInvalidProposal("the flag key is empty")
+		}
+		if content.FlagChange.GetFlagValue() == nil {
+			return coreTypes.ErrInvalidProposal("the flag value is empty")
+		}
+	case *MessageSubmitProposal_Upgrade:
+		if content.Upgrade.GetVersion() == "" {
+			return coreTypes.ErrInvalidProposal("the upgrade version is empty")
+		}
+		if content.Upgrade.GetHeight() <= 0 {
+			return coreTypes.ErrInvalidProposal("the upgrade height must be positive")
+		}
+	default:
+		return coreTypes.ErrEmptyProposalContent()
+	}
+	return nil
+}
+func (msg *MessageVote) ValidateBasic() coreTypes.Error {
+	if err := validateAddress(msg.Voter); err != nil {
+		return err
+	}
+	switch msg.Option {
+	case coreTypes.VoteOption_VOTE_OPTION_YES, coreTypes.VoteOption_VOTE_OPTION_NO, coreTypes.VoteOption_VOTE_OPTION_ABSTAIN:
+		return nil
+	default:
+		return coreTypes.ErrInvalidVoteOption(msg.Option)
+	}
+}
 
 func (msg *MessageSend) SetSigner(signer []byte)            { /* no-op */ }
 func (msg *MessageStake) SetSigner(signer []byte)           { msg.Signer = signer }
@@ -91,6 +189,12 @@ func (msg *MessageEditStake) SetSigner(signer []byte)       { msg.Signer = signe
 func (msg *MessageUnstake) SetSigner(signer []byte)         { msg.Signer = signer }
 func (msg *MessageUnpause) SetSigner(signer []byte)         { msg.Signer = signer }
 func (msg *MessageChangeParameter) SetSigner(signer []byte) { msg.Signer = signer }
+func (msg *MessageSubmitProposal) SetSigner(signer []byte)  { /* no-op */ }
+func (msg *MessageVote) SetSigner(signer []byte)            { msg.Signer = signer }
+func (msg *MessageUpgrade) SetSigner(signer []byte)         { msg.Signer = signer }
+func (msg *MessageDelegate) SetSigner(signer []byte)        { /* no-op */ }
+func (msg *MessageUndelegate) SetSigner(signer []byte)      { /* no-op */ }
+func (msg *MessageRedelegate) SetSigner(signer []byte)      { /* no-op */ }
 
 func (msg *MessageSend) GetMessageName() string            { return getMessageType(msg) }
 func (msg *MessageStake) GetMessageName() string           { return getMessageType(msg) }
@@ -98,6 +202,12 @@ func (msg *MessageEditStake) GetMessageName() string       { return getMessageTy
 func (msg *MessageUnstake) GetMessageName() string         { return getMessageType(msg) }
 func (msg *MessageUnpause) GetMessageName() string         { return getMessageType(msg) }
 func (msg *MessageChangeParameter) GetMessageName() string { return getMessageType(msg) }
+func (msg *MessageSubmitProposal) GetMessageName() string  { return getMessageType(msg) }
+func (msg *MessageVote) GetMessageName() string            { return getMessageType(msg) }
+func (msg *MessageUpgrade) GetMessageName() string         { return getMessageType(msg) }
+func (msg *MessageDelegate) GetMessageName() string        { return getMessageType(msg) }
+func (msg *MessageUndelegate) GetMessageName() string      { return getMessageType(msg) }
+func (msg *MessageRedelegate) GetMessageName() string      { return getMessageType(msg) }
 
 func (msg *MessageSend) GetMessageRecipient() string            { return hex.EncodeToString(msg.ToAddress) }
 func (msg *MessageStake) GetMessageRecipient() string           { return "" }
@@ -105,8 +215,18 @@ func (msg *MessageEditStake) GetMessageRecipient() string       { return "" }
 func (msg *MessageUnstake) GetMessageRecipient() string         { return "" }
 func (msg *MessageUnpause) GetMessageRecipient() string         { return "" }
 func (msg *MessageChangeParameter) GetMessageRecipient() string { return "" }
+func (msg *MessageSubmitProposal) GetMessageRecipient() string  { return "" }
+func (msg *MessageVote) GetMessageRecipient() string            { return "" }
+func (msg *MessageUpgrade) GetMessageRecipient() string         { return "" }
+func (msg *MessageDelegate) GetMessageRecipient() string        { return "" }
+func (msg *MessageUndelegate) GetMessageRecipient() string      { return "" }
+func (msg *MessageRedelegate) GetMessageRecipient() string      { return "" }
 
-func (msg *MessageSend) GetSigner() []byte { return msg.FromAddress }
+func (msg *MessageSend) GetSigner() []byte           { return msg.FromAddress }
+func (msg *MessageSubmitProposal) GetSigner() []byte { return msg.Proposer }
+func (msg *MessageDelegate) GetSigner() []byte       { return msg.Delegator }
+func (msg *MessageUndelegate) GetSigner() []byte     { return msg.Delegator }
+func (msg *MessageRedelegate) GetSigner() []byte     { return msg.Delegator }
 
 func (msg *MessageSend) GetActorType() coreTypes.ActorType {
 	return coreTypes.ActorType_ACTOR_TYPE_UNSPECIFIED // there's no actor type for message send, so return zero to allow fee retrieval
@@ -114,6 +234,24 @@ func (msg *MessageSend) GetActorType() coreTypes.ActorType {
 func (msg *MessageChangeParameter) GetActorType() coreTypes.ActorType {
 	return -1 // CONSIDERATION: Should we create an actor for the DAO or ACLed addresses?
 }
+func (msg *MessageSubmitProposal) GetActorType() coreTypes.ActorType {
+	return -1 // any account can submit a proposal
+}
+func (msg *MessageVote) GetActorType() coreTypes.ActorType {
+	return coreTypes.ActorType_ACTOR_TYPE_VAL
+}
+func (msg *MessageUpgrade) GetActorType() coreTypes.ActorType {
+	return -1 // the upgrades are scheduled by the ACL owner
+}
+func (msg *MessageDelegate) GetActorType() coreTypes.ActorType {
+	return coreTypes.ActorType_ACTOR_TYPE_VAL
+}
+func (msg *MessageUndelegate) GetActorType() coreTypes.ActorType {
+	return coreTypes.ActorType_ACTOR_TYPE_VAL
+}
+func (msg *MessageRedelegate) GetActorType() coreTypes.ActorType {
+	return coreTypes.ActorType_ACTOR_TYPE_VAL
+}
 
 func (msg *MessageSend) GetCanonicalBytes() []byte            { return getCanonicalBytes(msg) }
 func (msg *MessageStake) GetCanonicalBytes() []byte           { return getCanonicalBytes(msg) }
@@ -121,6 +259,12 @@ func (msg *MessageEditStake) GetCanonicalBytes() []byte       { return getCanoni
 func (msg *MessageUnstake) GetCanonicalBytes() []byte         { return getCanonicalBytes(msg) }
 func (msg *MessageUnpause) GetCanonicalBytes() []byte         { return getCanonicalBytes(msg) }
 func (msg *MessageChangeParameter) GetCanonicalBytes() []byte { return getCanonicalBytes(msg) }
+func (msg *MessageSubmitProposal) GetCanonicalBytes() []byte  { return getCanonicalBytes(msg) }
+func (msg *MessageVote) GetCanonicalBytes() []byte            { return getCanonicalBytes(msg) }
+func (msg *MessageUpgrade) GetCanonicalBytes() []byte         { return getCanonicalBytes(msg) }
+func (msg *MessageDelegate) GetCanonicalBytes() []byte        { return getCanonicalBytes(msg) }
+func (msg *MessageUndelegate) GetCanonicalBytes() []byte      { return getCanonicalBytes(msg) }
+func (msg *MessageRedelegate) GetCanonicalBytes() []byte      { return getCanonicalBytes(msg) }
 
 // Helpers
 
@@ -149,6 +293,17 @@ func validateOutputAddress(address []byte) coreTypes.Error {
 	return nil
 }
 
+// validateDelegationAmount ensures the amount delegated, undelegated or redelegated is a positive integer
+func validateDelegationAmount(amount string) coreTypes.Error {
+	if err := validateAmount(amount); err != nil {
+		return err
+	}
+	if value, _ := utils.StringToBigInt(amount); value.Sign() <= 0 {
+		return coreTypes.ErrInvalidAmount()
+	}
+	return nil
+}
+
 // CONSIDERATION: If the protobufs contain semantic types, we could potentially leverage
 //
 //	a shared `address.ValidateBasic()` throughout the codebase.s
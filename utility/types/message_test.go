@@ -200,3 +200,176 @@ func TestMessage_Unpause_ValidateBasic(t *testing.T) {
 	er = msgMissingAddress.ValidateBasic()
 	require.Equal(t, coreTypes.ErrEmptyAddress().Code(), er.Code())
 }
+
+func TestMessage_SubmitProposal_ValidateBasic(t *testing.T) {
+	proposer, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+
+	paramValueAny, err := codec.GetCodec().ToAny(wrapperspb.Int32(1))
+	require.NoError(t, err)
+
+	msg := MessageSubmitProposal{
+		Proposer: proposer,
+		Title:    "title",
+		Content: &MessageSubmitProposal_ParamChange{ParamChange: &coreTypes.ParamChangeProposal{
+			ParameterKey:   "key",
+			ParameterValue: paramValueAny,
+		}},
+	}
+	er := msg.ValidateBasic()
+	require.NoError(t, er)
+
+	msgMissingProposer := proto.Clone(&msg).(*MessageSubmitProposal)
+	msgMissingProposer.Proposer = nil
+	require.Equal(t, coreTypes.ErrEmptyAddress().Code(), msgMissingProposer.ValidateBasic().Code())
+
+	msgMissingTitle := proto.Clone(&msg).(*MessageSubmitProposal)
+	msgMissingTitle.Title = ""
+	require.Equal(t, coreTypes.CodeInvalidProposalError, msgMissingTitle.ValidateBasic().Code())
+
+	msgMissingContent := proto.Clone(&msg).(*MessageSubmitProposal)
+	msgMissingContent.Content = nil
+	require.Equal(t, coreTypes.ErrEmptyProposalContent().Code(), msgMissingContent.ValidateBasic().Code())
+
+	msgMissingParamValue := proto.Clone(&msg).(*MessageSubmitProposal)
+	msgMissingParamValue.GetParamChange().ParameterValue = nil
+	require.Equal(t, coreTypes.ErrEmptyParamValue().Code(), msgMissingParamValue.ValidateBasic().Code())
+
+	msgFlagChange := proto.Clone(&msg).(*MessageSubmitProposal)
+	msgFlagChange.Content = &MessageSubmitProposal_FlagChange{FlagChange: &coreTypes.FlagChangeProposal{
+		FlagKey:   "key",
+		FlagValue: paramValueAny,
+		Enabled:   true,
+	}}
+	require.NoError(t, msgFlagChange.ValidateBasic())
+
+	msgUpgrade := proto.Clone(&msg).(*MessageSubmitProposal)
+	msgUpgrade.Content = &MessageSubmitProposal_Upgrade{Upgrade: &coreTypes.UpgradeProposal{Version: "1.0.1", Height: 100}}
+	require.NoError(t, msgUpgrade.ValidateBasic())
+
+	msgUpgradeMissingHeight := proto.Clone(msgUpgrade).(*MessageSubmitProposal)
+	msgUpgradeMissingHeight.GetUpgrade().Height = 0
+	require.Equal(t, coreTypes.CodeInvalidProposalError, msgUpgradeMissingHeight.ValidateBasic().Code())
+}
+
+func TestMessage_Vote_ValidateBasic(t *testing.T) {
+	voter, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+
+	msg := MessageVote{
+		Voter:      voter,
+		ProposalId: 1,
+		Option:     coreTypes.VoteOption_VOTE_OPTION_YES,
+	}
+	er := msg.ValidateBasic()
+	require.NoError(t, er)
+
+	msgMissingVoter := proto.Clone(&msg).(*MessageVote)
+	msgMissingVoter.Voter = nil
+	require.Equal(t, coreTypes.ErrEmptyAddress().Code(), msgMissingVoter.ValidateBasic().Code())
+
+	msgMissingOption := proto.Clone(&msg).(*MessageVote)
+	msgMissingOption.Option = coreTypes.VoteOption_VOTE_OPTION_UNSPECIFIED
+	require.Equal(t, coreTypes.CodeInvalidVoteOptionError, msgMissingOption.ValidateBasic().Code())
+}
+
+func TestMessage_Upgrade_ValidateBasic(t *testing.T) {
+	signer, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+
+	msg := MessageUpgrade{
+		Signer:  signer,
+		Version: "v1.0.0",
+		Height:  100,
+	}
+	er := msg.ValidateBasic()
+	require.NoError(t, er)
+
+	msgMissingSigner := proto.Clone(&msg).(*MessageUpgrade)
+	msgMissingSigner.Signer = nil
+	require.Equal(t, coreTypes.ErrEmptyAddress().Code(), msgMissingSigner.ValidateBasic().Code())
+
+	msgInvalidVersion := proto.Clone(&msg).(*MessageUpgrade)
+	msgInvalidVersion.Version = "1.0"
+	require.Equal(t, coreTypes.CodeInvalidProtocolVersionError, msgInvalidVersion.ValidateBasic().Code())
+
+	msgInvalidHeight := proto.Clone(&msg).(*MessageUpgrade)
+	msgInvalidHeight.Height = 0
+	require.Equal(t, coreTypes.CodeInvalidUpgradeHeightError, msgInvalidHeight.ValidateBasic().Code())
+}
+
+func TestMessage_Delegate_ValidateBasic(t *testing.T) {
+	delegator, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	validator, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+
+	msg := MessageDelegate{
+		Delegator:        delegator,
+		ValidatorAddress: validator,
+		Amount:           defaultAmount,
+	}
+	er := msg.ValidateBasic()
+	require.NoError(t, er)
+
+	msgMissingDelegator := proto.Clone(&msg).(*MessageDelegate)
+	msgMissingDelegator.Delegator = nil
+	require.Equal(t, coreTypes.ErrEmptyAddress().Code(), msgMissingDelegator.ValidateBasic().Code())
+
+	msgMissingValidator := proto.Clone(&msg).(*MessageDelegate)
+	msgMissingValidator.ValidatorAddress = nil
+	require.Equal(t, coreTypes.ErrEmptyAddress().Code(), msgMissingValidator.ValidateBasic().Code())
+
+	msgZeroAmount := proto.Clone(&msg).(*MessageDelegate)
+	msgZeroAmount.Amount = "0"
+	require.Equal(t, coreTypes.ErrInvalidAmount().Code(), msgZeroAmount.ValidateBasic().Code())
+}
+
+func TestMessage_Undelegate_ValidateBasic(t *testing.T) {
+	delegator, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	validator, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+
+	msg := MessageUndelegate{
+		Delegator:        delegator,
+		ValidatorAddress: validator,
+		Amount:           defaultAmount,
+	}
+	er := msg.ValidateBasic()
+	require.NoError(t, er)
+
+	msgMissingValidator := proto.Clone(&msg).(*MessageUndelegate)
+	msgMissingValidator.ValidatorAddress = nil
+	require.Equal(t, coreTypes.ErrEmptyAddress().Code(), msgMissingValidator.ValidateBasic().Code())
+
+	msgMissingAmount := proto.Clone(&msg).(*MessageUndelegate)
+	msgMissingAmount.Amount = ""
+	require.Equal(t, coreTypes.ErrEmptyAmount().Code(), msgMissingAmount.ValidateBasic().Code())
+}
+
+func TestMessage_Redelegate_ValidateBasic(t *testing.T) {
+	delegator, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	sourceValidator, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	destinationValidator, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+
+	msg := MessageRedelegate{
+		Delegator:                   delegator,
+		SourceValidatorAddress:      sourceValidator,
+		DestinationValidatorAddress: destinationValidator,
+		Amount:                      defaultAmount,
+	}
+	er := msg.ValidateBasic()
+	require.NoError(t, er)
+
+	msgMissingDestination := proto.Clone(&msg).(*MessageRedelegate)
+	msgMissingDestination.DestinationValidatorAddress = nil
+	require.Equal(t, coreTypes.ErrEmptyAddress().Code(), msgMissingDestination.ValidateBasic().Code())
+
+	msgSameValidator := proto.Clone(&msg).(*MessageRedelegate)
+	msgSameValidator.DestinationValidatorAddress = sourceValidator
+	require.Equal(t, coreTypes.CodeInvalidRedelegationError, msgSameValidator.ValidateBasic().Code())
+}
@@ -21,6 +21,12 @@ func (uow *baseUtilityUnitOfWork) beginBlock() coreTypes.Error {
 		"source": "beginBlock",
 	}).Logger()
 
+	log.Info().Msg("handling the protocol version")
+	// refuse to apply the block if the binary does not support the protocol version active at this height
+	if err := uow.handleProtocolVersion(); err != nil {
+		return err
+	}
+
 	log.Debug().Bool("TODO", true).Msg("determining prevBlockByzantineValidators")
 	previousBlockByzantineValidators, err := uow.prevBlockByzantineValidators()
 	if err != nil {
@@ -53,12 +59,30 @@ func (uow *baseUtilityUnitOfWork) endBlock(proposer []byte) coreTypes.Error {
 		return err
 	}
 
+	log.Info().Msg("handling unbonding delegations")
+	// return the stake undelegated <ValidatorUnstakingBlocks> ago to the delegators
+	if err := uow.unbondDelegations(); err != nil {
+		return err
+	}
+
 	log.Info().Msg("handling unstaking paused actors")
 	// begin unstaking the actors who have been paused for MaxPauseBlocks
 	if err := uow.beginUnstakingMaxPausedActors(); err != nil {
 		return err
 	}
 
+	log.Info().Msg("handling delegations of unstaking validators")
+	// undelegate the stake of the validators that are no longer staked, including the ones that just began unstaking
+	if err := uow.unbondDelegationsOfUnstakingValidators(); err != nil {
+		return err
+	}
+
+	log.Info().Msg("handling governance proposals")
+	// tally the proposals whose voting window ends at this height and execute the ones that passed
+	if err := uow.handleProposals(); err != nil {
+		return err
+	}
+
 	// INCOMPLETE: Identify what else needs to be done in the begin block lifecycle phase
 	return nil
 }
@@ -90,7 +114,7 @@ func (uow *baseUtilityUnitOfWork) handleProposerRewards(proposer []byte) coreTyp
 	amountToProposerFloat.Quo(amountToProposerFloat, big.NewFloat(100))
 	amountToProposer, _ := amountToProposerFloat.Int(nil)
 	amountToDAO := feesAndRewardsCollected.Sub(feesAndRewardsCollected, amountToProposer)
-	if err := uow.addAccountAmount(proposer, amountToProposer); err != nil {
+	if err := uow.distributeProposerRewards(proposer, amountToProposer); err != nil {
 		return err
 	}
 	if err := uow.addPoolAmount(coreTypes.Pools_POOLS_DAO.Address(), amountToDAO); err != nil {
@@ -0,0 +1,315 @@
+package unit_of_work
+
+// Internal business logic for delegated staking: token holders bond stake to validators, which counts towards the
+// voting power of the validators and earns the delegators a share of their proposer rewards.
+// The delegated tokens are held in the validator stake pool until they finish unbonding, and share the risk of the
+// validator: they are burnt along with its stake and unbonded once it stops being staked.
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/utils"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+func (u *baseUtilityUnitOfWork) handleMessageDelegate(message *typesUtil.MessageDelegate) coreTypes.Error {
+	amount, er := utils.StringToBigInt(message.Amount)
+	if er != nil {
+		return coreTypes.ErrStringToBigInt(er)
+	}
+	if err := u.ensureValidatorStaked(message.ValidatorAddress); err != nil {
+		return err
+	}
+	// ensure the delegator has sufficient funds for the delegation
+	delegatorAccountAmount, err := u.getAccountAmount(message.Delegator)
+	if err != nil {
+		return err
+	}
+	if delegatorAccountAmount.Cmp(amount) < 0 {
+		return coreTypes.ErrInsufficientAmount(hex.EncodeToString(message.Delegator))
+	}
+	if err := u.subtractAccountAmount(message.Delegator, amount); err != nil {
+		return err
+	}
+	if err := u.addPoolAmount(coreTypes.Pools_POOLS_VALIDATOR_STAKE.Address(), amount); err != nil {
+		return err
+	}
+	return u.addDelegation(message.Delegator, message.ValidatorAddress, amount)
+}
+
+func (u *baseUtilityUnitOfWork) handleMessageUndelegate(message *typesUtil.MessageUndelegate) coreTypes.Error {
+	amount, er := utils.StringToBigInt(message.Amount)
+	if er != nil {
+		return coreTypes.ErrStringToBigInt(er)
+	}
+	if err := u.subtractDelegation(message.Delegator, message.ValidatorAddress, amount); err != nil {
+		return err
+	}
+	// the stake remains in the validator stake pool until it finishes unbonding
+	completionHeight, err := u.getUnbondingHeight(coreTypes.ActorType_ACTOR_TYPE_VAL)
+	if err != nil {
+		return err
+	}
+	if er := u.persistenceRWContext.SetUnbondingDelegation(message.Delegator, message.ValidatorAddress, utils.BigIntToString(amount), completionHeight); er != nil {
+		return coreTypes.ErrSetDelegation(er)
+	}
+	return nil
+}
+
+func (u *baseUtilityUnitOfWork) handleMessageRedelegate(message *typesUtil.MessageRedelegate) coreTypes.Error {
+	amount, er := utils.StringToBigInt(message.Amount)
+	if er != nil {
+		return coreTypes.ErrStringToBigInt(er)
+	}
+	if err := u.ensureValidatorStaked(message.DestinationValidatorAddress); err != nil {
+		return err
+	}
+	// the stake moves between validators without unbonding, so it stays in the validator stake pool
+	if err := u.subtractDelegation(message.Delegator, message.SourceValidatorAddress, amount); err != nil {
+		return err
+	}
+	return u.addDelegation(message.Delegator, message.DestinationValidatorAddress, amount)
+}
+
+// unbondDelegations returns the stake that finishes unbonding at the current height to the delegators
+func (u *baseUtilityUnitOfWork) unbondDelegations() coreTypes.Error {
+	unbondings, er := u.persistenceReadContext.GetUnbondingDelegationsReadyToUnbond(u.height)
+	if er != nil {
+		return coreTypes.ErrGetDelegations(er)
+	}
+	for _, unbonding := range unbondings {
+		delegator, er := hex.DecodeString(unbonding.Delegator)
+		if er != nil {
+			return coreTypes.ErrHexDecodeFromString(er)
+		}
+		amount, er := utils.StringToBigInt(unbonding.Amount)
+		if er != nil {
+			return coreTypes.ErrStringToBigInt(er)
+		}
+		if err := u.subPoolAmount(coreTypes.Pools_POOLS_VALIDATOR_STAKE.Address(), amount); err != nil {
+			return err
+		}
+		if err := u.addAccountAmount(delegator, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unbondDelegationsOfUnstakingValidators undelegates all the stake delegated to the validators that are unstaking or
+// unstaked, which unbonds after `validator_unstaking_blocks` like a regular undelegation
+func (u *baseUtilityUnitOfWork) unbondDelegationsOfUnstakingValidators() coreTypes.Error {
+	delegations, er := u.persistenceReadContext.GetAllDelegations(u.height)
+	if er != nil {
+		return coreTypes.ErrGetDelegations(er)
+	}
+	if len(delegations) == 0 {
+		return nil
+	}
+	validators, er := u.persistenceReadContext.GetAllValidators(u.height)
+	if er != nil {
+		return coreTypes.ErrGetAllValidators(er)
+	}
+	stakedValidators := make(map[string]bool, len(validators))
+	for _, validator := range validators {
+		stakedValidators[validator.Address] = validator.UnstakingHeight == -1
+	}
+
+	completionHeight, err := u.getUnbondingHeight(coreTypes.ActorType_ACTOR_TYPE_VAL)
+	if err != nil {
+		return err
+	}
+	for _, delegation := range delegations {
+		if stakedValidators[delegation.Validator] {
+			continue
+		}
+		delegator, er := hex.DecodeString(delegation.Delegator)
+		if er != nil {
+			return coreTypes.ErrHexDecodeFromString(er)
+		}
+		validator, er := hex.DecodeString(delegation.Validator)
+		if er != nil {
+			return coreTypes.ErrHexDecodeFromString(er)
+		}
+		if er := u.persistenceRWContext.SetDelegation(delegator, validator, "0"); er != nil {
+			return coreTypes.ErrSetDelegation(er)
+		}
+		if er := u.persistenceRWContext.SetUnbondingDelegation(delegator, validator, delegation.Amount, completionHeight); er != nil {
+			return coreTypes.ErrSetDelegation(er)
+		}
+	}
+	return nil
+}
+
+// burnValidatorDelegations burns `burnPercent` percent of every delegation to the validator along with its stake.
+// The stake already unbonding from the validator is not burnt.
+func (u *baseUtilityUnitOfWork) burnValidatorDelegations(validator []byte, burnPercent int) coreTypes.Error {
+	if burnPercent <= 0 {
+		return nil
+	}
+	delegations, er := u.persistenceReadContext.GetValidatorDelegations(validator, u.height)
+	if er != nil {
+		return coreTypes.ErrGetDelegations(er)
+	}
+	totalBurnt := big.NewInt(0)
+	for _, delegation := range delegations {
+		amount, er := utils.StringToBigInt(delegation.Amount)
+		if er != nil {
+			return coreTypes.ErrStringToBigInt(er)
+		}
+		// amount * burnPercent / 100, truncated
+		burnAmount := new(big.Int).Mul(amount, big.NewInt(int64(burnPercent)))
+		burnAmount.Quo(burnAmount, big.NewInt(100))
+		if burnAmount.Sign() == 0 {
+			continue
+		}
+		delegator, er := hex.DecodeString(delegation.Delegator)
+		if er != nil {
+			return coreTypes.ErrHexDecodeFromString(er)
+		}
+		if err := u.subtractDelegation(delegator, validator, burnAmount); err != nil {
+			return err
+		}
+		totalBurnt.Add(totalBurnt, burnAmount)
+	}
+	// remove the burnt stake from the pool
+	return u.subPoolAmount(coreTypes.Pools_POOLS_VALIDATOR_STAKE.Address(), totalBurnt)
+}
+
+// distributeProposerRewards pays the rewards of the block proposer. If stake is delegated to the proposer, the
+// delegators share the rewards left after the `validator_commission_percentage` commission of the validator,
+// pro rata to their share of the stake of the validator; the validator keeps the rest.
+func (u *baseUtilityUnitOfWork) distributeProposerRewards(proposer []byte, rewards *big.Int) coreTypes.Error {
+	delegations, er := u.persistenceReadContext.GetValidatorDelegations(proposer, u.height)
+	if er != nil {
+		return coreTypes.ErrGetDelegations(er)
+	}
+	if len(delegations) == 0 {
+		return u.addAccountAmount(proposer, rewards)
+	}
+
+	commissionPercentage, err := getGovParam[int](u, typesUtil.ValidatorCommissionPercentageParamName)
+	if err != nil {
+		return err
+	}
+	if commissionPercentage < 0 || commissionPercentage > 100 {
+		return coreTypes.ErrInvalidCommissionPercentage(commissionPercentage)
+	}
+	selfStake, err := u.getActorStakeAmount(coreTypes.ActorType_ACTOR_TYPE_VAL, proposer)
+	if err != nil {
+		return err
+	}
+
+	delegatedAmounts := make([]*big.Int, len(delegations))
+	totalDelegated := big.NewInt(0)
+	for i, delegation := range delegations {
+		amount, er := utils.StringToBigInt(delegation.Amount)
+		if er != nil {
+			return coreTypes.ErrStringToBigInt(er)
+		}
+		delegatedAmounts[i] = amount
+		totalDelegated.Add(totalDelegated, amount)
+	}
+
+	// delegatorsRewards = rewards * (100 - commission) / 100 * delegated / (self stake + delegated)
+	delegatorsRewards := new(big.Int).Mul(rewards, big.NewInt(int64(100-commissionPercentage)))
+	delegatorsRewards.Mul(delegatorsRewards, totalDelegated)
+	delegatorsRewards.Quo(delegatorsRewards, new(big.Int).Mul(big.NewInt(100), new(big.Int).Add(selfStake, totalDelegated)))
+
+	validatorRewards := new(big.Int).Set(rewards)
+	for i, delegation := range delegations {
+		delegatorRewards := new(big.Int).Mul(delegatorsRewards, delegatedAmounts[i])
+		delegatorRewards.Quo(delegatorRewards, totalDelegated)
+		if delegatorRewards.Sign() == 0 {
+			continue
+		}
+		delegator, er := hex.DecodeString(delegation.Delegator)
+		if er != nil {
+			return coreTypes.ErrHexDecodeFromString(er)
+		}
+		if err := u.addAccountAmount(delegator, delegatorRewards); err != nil {
+			return err
+		}
+		validatorRewards.Sub(validatorRewards, delegatorRewards)
+	}
+	// the validator also receives the remainders of the integer divisions
+	return u.addAccountAmount(proposer, validatorRewards)
+}
+
+// getValidatorsDelegatedStake returns the stake delegated to every validator, keyed by the validator address bytes
+func (u *baseUtilityUnitOfWork) getValidatorsDelegatedStake() (map[string]*big.Int, coreTypes.Error) {
+	delegations, er := u.persistenceReadContext.GetAllDelegations(u.height)
+	if er != nil {
+		return nil, coreTypes.ErrGetDelegations(er)
+	}
+	delegatedStake := make(map[string]*big.Int)
+	for _, delegation := range delegations {
+		validator, er := hex.DecodeString(delegation.Validator)
+		if er != nil {
+			return nil, coreTypes.ErrHexDecodeFromString(er)
+		}
+		amount, er := utils.StringToBigInt(delegation.Amount)
+		if er != nil {
+			return nil, coreTypes.ErrStringToBigInt(er)
+		}
+		if stake, ok := delegatedStake[string(validator)]; ok {
+			stake.Add(stake, amount)
+			continue
+		}
+		delegatedStake[string(validator)] = amount
+	}
+	return delegatedStake, nil
+}
+
+// ensureValidatorStaked ensures the validator stake can be delegated to, i.e. it is staked and not unstaking
+func (u *baseUtilityUnitOfWork) ensureValidatorStaked(validator []byte) coreTypes.Error {
+	status, err := u.getActorStatus(coreTypes.ActorType_ACTOR_TYPE_VAL, validator)
+	if err != nil {
+		return err
+	}
+	if status != coreTypes.StakeStatus_Staked {
+		return coreTypes.ErrInvalidStatus(status, coreTypes.StakeStatus_Staked)
+	}
+	return nil
+}
+
+func (u *baseUtilityUnitOfWork) getDelegation(delegator, validator []byte) (*big.Int, coreTypes.Error) {
+	amountStr, er := u.persistenceReadContext.GetDelegation(delegator, validator, u.height)
+	if er != nil {
+		return nil, coreTypes.ErrGetDelegations(er)
+	}
+	amount, er := utils.StringToBigInt(amountStr)
+	if er != nil {
+		return nil, coreTypes.ErrStringToBigInt(er)
+	}
+	return amount, nil
+}
+
+func (u *baseUtilityUnitOfWork) addDelegation(delegator, validator []byte, amount *big.Int) coreTypes.Error {
+	delegated, err := u.getDelegation(delegator, validator)
+	if err != nil {
+		return err
+	}
+	delegated.Add(delegated, amount)
+	if er := u.persistenceRWContext.SetDelegation(delegator, validator, utils.BigIntToString(delegated)); er != nil {
+		return coreTypes.ErrSetDelegation(er)
+	}
+	return nil
+}
+
+func (u *baseUtilityUnitOfWork) subtractDelegation(delegator, validator []byte, amount *big.Int) coreTypes.Error {
+	delegated, err := u.getDelegation(delegator, validator)
+	if err != nil {
+		return err
+	}
+	if delegated.Cmp(amount) < 0 {
+		return coreTypes.ErrInsufficientDelegation(hex.EncodeToString(delegator), hex.EncodeToString(validator))
+	}
+	delegated.Sub(delegated, amount)
+	if er := u.persistenceRWContext.SetDelegation(delegator, validator, utils.BigIntToString(delegated)); er != nil {
+		return coreTypes.ErrSetDelegation(er)
+	}
+	return nil
+}
@@ -0,0 +1,304 @@
+package unit_of_work
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/pokt-network/pocket/runtime/test_artifacts"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/utils"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slices"
+)
+
+func TestUtilityUnitOfWork_HandleMessageDelegate(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 1)
+	delegator := getTestingDelegator(t, uow)
+	validator := getTestingValidatorAddress(t, uow, 0)
+
+	accountAmountBefore, err := uow.getAccountAmount(delegator)
+	require.NoError(t, err)
+	poolAmountBefore, err := uow.getPoolAmount(coreTypes.Pools_POOLS_VALIDATOR_STAKE.Address())
+	require.NoError(t, err)
+
+	msg := &typesUtil.MessageDelegate{
+		Delegator:        delegator,
+		ValidatorAddress: validator,
+		Amount:           test_artifacts.DefaultStakeAmountString,
+	}
+	require.NoError(t, uow.handleMessageDelegate(msg))
+	require.NoError(t, uow.handleMessageDelegate(msg))
+
+	delegated, err := uow.getDelegation(delegator, validator)
+	require.NoError(t, err)
+	expectedDelegated := new(big.Int).Mul(test_artifacts.DefaultStakeAmount, big.NewInt(2))
+	require.Equal(t, expectedDelegated, delegated)
+
+	accountAmountAfter, err := uow.getAccountAmount(delegator)
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).Sub(accountAmountBefore, expectedDelegated), accountAmountAfter)
+	poolAmountAfter, err := uow.getPoolAmount(coreTypes.Pools_POOLS_VALIDATOR_STAKE.Address())
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).Add(poolAmountBefore, expectedDelegated), poolAmountAfter)
+
+	candidates, err := uow.getSignerCandidates(msg)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{delegator}, candidates)
+
+	msgInsufficientFunds := &typesUtil.MessageDelegate{
+		Delegator:        delegator,
+		ValidatorAddress: validator,
+		Amount:           utils.BigIntToString(new(big.Int).Add(accountAmountAfter, big.NewInt(1))),
+	}
+	require.Equal(t, coreTypes.CodeInsufficientAmountError, uow.handleMessageDelegate(msgInsufficientFunds).Code())
+
+	msgNotValidator := &typesUtil.MessageDelegate{
+		Delegator:        delegator,
+		ValidatorAddress: delegator,
+		Amount:           test_artifacts.DefaultStakeAmountString,
+	}
+	require.Error(t, uow.handleMessageDelegate(msgNotValidator))
+}
+
+func TestUtilityUnitOfWork_HandleMessageUndelegate(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 1)
+	delegator := getTestingDelegator(t, uow)
+	validator := getTestingValidatorAddress(t, uow, 0)
+	delegateTestingStake(t, uow, delegator, validator, test_artifacts.DefaultStakeAmount)
+
+	accountAmountBefore, err := uow.getAccountAmount(delegator)
+	require.NoError(t, err)
+
+	undelegateAmount := new(big.Int).Quo(test_artifacts.DefaultStakeAmount, big.NewInt(4))
+	msg := &typesUtil.MessageUndelegate{
+		Delegator:        delegator,
+		ValidatorAddress: validator,
+		Amount:           utils.BigIntToString(undelegateAmount),
+	}
+	require.NoError(t, uow.handleMessageUndelegate(msg))
+
+	delegated, err := uow.getDelegation(delegator, validator)
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).Sub(test_artifacts.DefaultStakeAmount, undelegateAmount), delegated)
+
+	msg.Amount = utils.BigIntToString(test_artifacts.DefaultStakeAmount)
+	require.Equal(t, coreTypes.CodeInsufficientDelegationError, uow.handleMessageUndelegate(msg).Code())
+
+	unbondings, er := uow.persistenceReadContext.GetUnbondingDelegations(delegator, uow.height)
+	require.NoError(t, er)
+	require.Len(t, unbondings, 1)
+	require.Equal(t, utils.BigIntToString(undelegateAmount), unbondings[0].Amount)
+
+	unstakingBlocks, err := getGovParam[int64](uow, typesUtil.ValidatorUnstakingBlocksParamName)
+	require.NoError(t, err)
+	require.Equal(t, uow.height+unstakingBlocks, unbondings[0].CompletionHeight)
+
+	// the stake is only returned to the delegator once it finishes unbonding
+	uow.height = unbondings[0].CompletionHeight - 1
+	require.NoError(t, uow.unbondDelegations())
+	accountAmount, err := uow.getAccountAmount(delegator)
+	require.NoError(t, err)
+	require.Equal(t, accountAmountBefore, accountAmount)
+
+	uow.height = unbondings[0].CompletionHeight
+	require.NoError(t, uow.unbondDelegations())
+	accountAmount, err = uow.getAccountAmount(delegator)
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).Add(accountAmountBefore, undelegateAmount), accountAmount)
+
+	unbondings, er = uow.persistenceReadContext.GetUnbondingDelegations(delegator, uow.height)
+	require.NoError(t, er)
+	require.Empty(t, unbondings)
+}
+
+func TestUtilityUnitOfWork_HandleMessageRedelegate(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 1)
+	delegator := getTestingDelegator(t, uow)
+	sourceValidator := getTestingValidatorAddress(t, uow, 0)
+	destinationValidator := getTestingValidatorAddress(t, uow, 1)
+	delegateTestingStake(t, uow, delegator, sourceValidator, test_artifacts.DefaultStakeAmount)
+
+	accountAmountBefore, err := uow.getAccountAmount(delegator)
+	require.NoError(t, err)
+
+	msg := &typesUtil.MessageRedelegate{
+		Delegator:                   delegator,
+		SourceValidatorAddress:      sourceValidator,
+		DestinationValidatorAddress: destinationValidator,
+		Amount:                      test_artifacts.DefaultStakeAmountString,
+	}
+	require.NoError(t, uow.handleMessageRedelegate(msg))
+
+	sourceDelegated, err := uow.getDelegation(delegator, sourceValidator)
+	require.NoError(t, err)
+	require.Zero(t, sourceDelegated.Sign())
+	destinationDelegated, err := uow.getDelegation(delegator, destinationValidator)
+	require.NoError(t, err)
+	require.Equal(t, test_artifacts.DefaultStakeAmount, destinationDelegated)
+
+	// the stake is moved without unbonding
+	accountAmountAfter, err := uow.getAccountAmount(delegator)
+	require.NoError(t, err)
+	require.Equal(t, accountAmountBefore, accountAmountAfter)
+	unbondings, er := uow.persistenceReadContext.GetUnbondingDelegations(delegator, uow.height)
+	require.NoError(t, er)
+	require.Empty(t, unbondings)
+	delegations, er := uow.persistenceReadContext.GetDelegations(delegator, uow.height)
+	require.NoError(t, er)
+	require.Len(t, delegations, 1)
+	require.Equal(t, hex.EncodeToString(destinationValidator), delegations[0].Validator)
+
+	require.Equal(t, coreTypes.CodeInsufficientDelegationError, uow.handleMessageRedelegate(msg).Code())
+}
+
+func TestUtilityUnitOfWork_GetValidatorsVotingPower_Delegated(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 1)
+	delegator := getTestingDelegator(t, uow)
+	validator := getTestingValidatorAddress(t, uow, 0)
+
+	votingPowerBefore, totalBefore, err := uow.getValidatorsVotingPower()
+	require.NoError(t, err)
+
+	delegateTestingStake(t, uow, delegator, validator, test_artifacts.DefaultStakeAmount)
+
+	votingPower, total, err := uow.getValidatorsVotingPower()
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).Add(votingPowerBefore[string(validator)], test_artifacts.DefaultStakeAmount), votingPower[string(validator)])
+	require.Equal(t, new(big.Int).Add(totalBefore, test_artifacts.DefaultStakeAmount), total)
+}
+
+func TestUtilityUnitOfWork_DistributeProposerRewards(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 1)
+	delegator := getTestingDelegator(t, uow)
+	proposer := getTestingValidatorAddress(t, uow, 0)
+	rewards := big.NewInt(1000)
+
+	// without delegations the proposer receives all the rewards
+	proposerAmountBefore, err := uow.getAccountAmount(proposer)
+	require.NoError(t, err)
+	require.NoError(t, uow.distributeProposerRewards(proposer, rewards))
+	proposerAmount, err := uow.getAccountAmount(proposer)
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).Add(proposerAmountBefore, rewards), proposerAmount)
+
+	// delegating as much as the self stake of the proposer entitles the delegator to half of the rewards after commission
+	selfStake, err := uow.getActorStakeAmount(coreTypes.ActorType_ACTOR_TYPE_VAL, proposer)
+	require.NoError(t, err)
+	delegateTestingStake(t, uow, delegator, proposer, selfStake)
+	commissionPercentage, err := getGovParam[int](uow, typesUtil.ValidatorCommissionPercentageParamName)
+	require.NoError(t, err)
+
+	proposerAmountBefore, err = uow.getAccountAmount(proposer)
+	require.NoError(t, err)
+	delegatorAmountBefore, err := uow.getAccountAmount(delegator)
+	require.NoError(t, err)
+	require.NoError(t, uow.distributeProposerRewards(proposer, rewards))
+
+	expectedDelegatorRewards := big.NewInt(int64(1000 * (100 - commissionPercentage) / 100 / 2))
+	delegatorAmount, err := uow.getAccountAmount(delegator)
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).Add(delegatorAmountBefore, expectedDelegatorRewards), delegatorAmount)
+	proposerAmount, err = uow.getAccountAmount(proposer)
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).Add(proposerAmountBefore, new(big.Int).Sub(rewards, expectedDelegatorRewards)), proposerAmount)
+}
+
+func TestUtilityUnitOfWork_BurnValidator_Delegations(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 1)
+	delegator := getTestingDelegator(t, uow)
+	validator := getTestingValidatorAddress(t, uow, 0)
+	delegateTestingStake(t, uow, delegator, validator, test_artifacts.DefaultStakeAmount)
+
+	selfStake, err := uow.getActorStakeAmount(coreTypes.ActorType_ACTOR_TYPE_VAL, validator)
+	require.NoError(t, err)
+	poolAmountBefore, err := uow.getPoolAmount(coreTypes.Pools_POOLS_VALIDATOR_STAKE.Address())
+	require.NoError(t, err)
+	burnPercent, err := getGovParam[int](uow, typesUtil.MissedBlocksBurnPercentageParamName)
+	require.NoError(t, err)
+
+	require.NoError(t, uow.burnValidator(validator))
+
+	// the delegation is burnt by the same percentage as the self stake of the validator
+	expectedDelegationBurn := new(big.Int).Quo(new(big.Int).Mul(test_artifacts.DefaultStakeAmount, big.NewInt(int64(burnPercent))), big.NewInt(100))
+	require.NotZero(t, expectedDelegationBurn.Sign())
+	delegated, err := uow.getDelegation(delegator, validator)
+	require.NoError(t, err)
+	require.Equal(t, new(big.Int).Sub(test_artifacts.DefaultStakeAmount, expectedDelegationBurn), delegated)
+
+	selfStakeAfter, err := uow.getActorStakeAmount(coreTypes.ActorType_ACTOR_TYPE_VAL, validator)
+	require.NoError(t, err)
+	expectedSelfStakeBurn := new(big.Int).Sub(selfStake, selfStakeAfter)
+
+	// both burns are removed from the validator stake pool
+	poolAmountAfter, err := uow.getPoolAmount(coreTypes.Pools_POOLS_VALIDATOR_STAKE.Address())
+	require.NoError(t, err)
+	expectedPoolBurn := new(big.Int).Add(expectedSelfStakeBurn, expectedDelegationBurn)
+	require.Equal(t, new(big.Int).Sub(poolAmountBefore, expectedPoolBurn), poolAmountAfter)
+}
+
+func TestUtilityUnitOfWork_UnbondDelegationsOfUnstakingValidators(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 1)
+	delegator := getTestingDelegator(t, uow)
+	unstakingValidator := getTestingValidatorAddress(t, uow, 0)
+	stakedValidator := getTestingValidatorAddress(t, uow, 1)
+	delegateTestingStake(t, uow, delegator, unstakingValidator, test_artifacts.DefaultStakeAmount)
+	delegateTestingStake(t, uow, delegator, stakedValidator, test_artifacts.DefaultStakeAmount)
+
+	unbondingHeight, err := uow.getUnbondingHeight(coreTypes.ActorType_ACTOR_TYPE_VAL)
+	require.NoError(t, err)
+	require.NoError(t, uow.setActorUnbondingHeight(coreTypes.ActorType_ACTOR_TYPE_VAL, unstakingValidator, unbondingHeight))
+	require.NoError(t, uow.unbondDelegationsOfUnstakingValidators())
+
+	// the delegation to the unstaking validator is undelegated and starts unbonding
+	delegated, err := uow.getDelegation(delegator, unstakingValidator)
+	require.NoError(t, err)
+	require.Zero(t, delegated.Sign())
+
+	unbondings, er := uow.persistenceReadContext.GetUnbondingDelegations(delegator, uow.height)
+	require.NoError(t, er)
+	require.Len(t, unbondings, 1)
+	require.Equal(t, hex.EncodeToString(unstakingValidator), unbondings[0].Validator)
+	require.Equal(t, test_artifacts.DefaultStakeAmountString, unbondings[0].Amount)
+	require.Equal(t, unbondingHeight, unbondings[0].CompletionHeight)
+
+	// the delegation to the staked validator is left as is
+	delegated, err = uow.getDelegation(delegator, stakedValidator)
+	require.NoError(t, err)
+	require.Equal(t, test_artifacts.DefaultStakeAmount, delegated)
+}
+
+func delegateTestingStake(t *testing.T, uow *baseUtilityUnitOfWork, delegator, validator []byte, amount *big.Int) {
+	t.Helper()
+	require.NoError(t, uow.handleMessageDelegate(&typesUtil.MessageDelegate{
+		Delegator:        delegator,
+		ValidatorAddress: validator,
+		Amount:           utils.BigIntToString(amount),
+	}))
+}
+
+// getTestingDelegator returns the address of a funded account that is not a validator
+func getTestingDelegator(t *testing.T, uow *baseUtilityUnitOfWork) []byte {
+	t.Helper()
+	validators := getAllTestingValidators(t, uow)
+	for _, account := range getAllTestingAccounts(t, uow) {
+		if slices.IndexFunc(validators, func(v *coreTypes.Actor) bool { return v.GetAddress() == account.GetAddress() }) != -1 {
+			continue
+		}
+		addr, err := hex.DecodeString(account.GetAddress())
+		require.NoError(t, err)
+		return addr
+	}
+	t.Fatal("no account that is not a validator")
+	return nil
+}
+
+func getTestingValidatorAddress(t *testing.T, uow *baseUtilityUnitOfWork, index int) []byte {
+	t.Helper()
+	validators := getAllTestingValidators(t, uow)
+	require.Greater(t, len(validators), index)
+	addr, err := hex.DecodeString(validators[index].GetAddress())
+	require.NoError(t, err)
+	return addr
+}
@@ -91,6 +91,16 @@ func prepareGovParamParamTypesMap() map[string]int {
 		typesUtil.MessagePauseServicerFee:                  BIGINT,
 		typesUtil.MessageUnpauseServicerFee:                BIGINT,
 		typesUtil.MessageChangeParameterFee:                BIGINT,
+		typesUtil.MessageSubmitProposalFee:                 BIGINT,
+		typesUtil.MessageVoteFee:                           BIGINT,
+		typesUtil.GovernanceVotingBlocksParamName:          INT64,
+		typesUtil.GovernanceQuorumPercentageParamName:      INT,
+		typesUtil.GovernanceThresholdPercentageParamName:   INT,
+		typesUtil.MessageUpgradeFee:                        BIGINT,
+		typesUtil.ValidatorCommissionPercentageParamName:   INT,
+		typesUtil.MessageDelegateFee:                       BIGINT,
+		typesUtil.MessageUndelegateFee:                     BIGINT,
+		typesUtil.MessageRedelegateFee:                     BIGINT,
 	}
 }
 
@@ -207,6 +217,18 @@ func (u *baseUtilityUnitOfWork) getFee(msg typesUtil.Message, actorType coreType
 		}
 	case *typesUtil.MessageChangeParameter:
 		return getGovParam[*big.Int](u, typesUtil.MessageChangeParameterFee)
+	case *typesUtil.MessageSubmitProposal:
+		return getGovParam[*big.Int](u, typesUtil.MessageSubmitProposalFee)
+	case *typesUtil.MessageVote:
+		return getGovParam[*big.Int](u, typesUtil.MessageVoteFee)
+	case *typesUtil.MessageUpgrade:
+		return getGovParam[*big.Int](u, typesUtil.MessageUpgradeFee)
+	case *typesUtil.MessageDelegate:
+		return getGovParam[*big.Int](u, typesUtil.MessageDelegateFee)
+	case *typesUtil.MessageUndelegate:
+		return getGovParam[*big.Int](u, typesUtil.MessageUndelegateFee)
+	case *typesUtil.MessageRedelegate:
+		return getGovParam[*big.Int](u, typesUtil.MessageRedelegateFee)
 	default:
 		return nil, coreTypes.ErrUnknownMessage(x)
 	}
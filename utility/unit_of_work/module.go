@@ -9,8 +9,9 @@ import (
 )
 
 const (
-	leaderUtilityUOWModuleName  = "leader_utility_UOW"
-	replicaUtilityUOWModuleName = "replica_utility_UOW"
+	leaderUtilityUOWModuleName     = "leader_utility_UOW"
+	replicaUtilityUOWModuleName    = "replica_utility_UOW"
+	simulationUtilityUOWModuleName = "simulation_utility_UOW"
 )
 
 var _ modules.UtilityUnitOfWork = &baseUtilityUnitOfWork{}
@@ -21,6 +22,8 @@ type baseUtilityUnitOfWork struct {
 	logger *modules.Logger
 
 	height int64
+	// protocolVersion is the protocol version active at `height`, see `getProtocolVersion`
+	protocolVersion string
 
 	// TECHDEBT(#564): the way we access the contexts and apply changes to them is still a work in progress.
 	// The path forward will become clearer during the implementation of change tracking in #564.
@@ -0,0 +1,310 @@
+package unit_of_work
+
+// Internal business logic for on-chain governance: submitting proposals, stake-weighted voting
+// by the validators and tallying / executing the proposals at the end of their voting window.
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/utils"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func (u *baseUtilityUnitOfWork) handleMessageSubmitProposal(message *typesUtil.MessageSubmitProposal) coreTypes.Error {
+	proposal := &coreTypes.Proposal{
+		Proposer:    message.Proposer,
+		Title:       message.Title,
+		Description: message.Description,
+		Status:      coreTypes.ProposalStatus_PROPOSAL_STATUS_VOTING,
+	}
+	switch content := message.Content.(type) {
+	case *typesUtil.MessageSubmitProposal_ParamChange:
+		if err := u.validateProposedParamValue(content.ParamChange.ParameterKey, content.ParamChange.ParameterValue); err != nil {
+			return err
+		}
+		proposal.Content = &coreTypes.Proposal_ParamChange{ParamChange: content.ParamChange}
+	case *typesUtil.MessageSubmitProposal_FlagChange:
+		// the feature flags are keyed by the name of the parameter they gate, and hold a value of its type
+		if err := u.validateProposedParamValue(content.FlagChange.FlagKey, content.FlagChange.FlagValue); err != nil {
+			return err
+		}
+		proposal.Content = &coreTypes.Proposal_FlagChange{FlagChange: content.FlagChange}
+	case *typesUtil.MessageSubmitProposal_Upgrade:
+		if err := u.validateUpgradeVersion(content.Upgrade.Version); err != nil {
+			return err
+		}
+		proposal.Content = &coreTypes.Proposal_Upgrade{Upgrade: content.Upgrade}
+	default:
+		return coreTypes.ErrEmptyProposalContent()
+	}
+
+	votingBlocks, err := getGovParam[int64](u, typesUtil.GovernanceVotingBlocksParamName)
+	if err != nil {
+		return err
+	}
+	// the upgrade is scheduled once the proposal passes, so it must be activated after the end of the voting window
+	if upgrade := message.GetUpgrade(); upgrade != nil && upgrade.Height <= u.height+votingBlocks {
+		return coreTypes.ErrInvalidProposal(fmt.Sprintf("the upgrade height %d must be after the end of the voting window at height %d", upgrade.Height, u.height+votingBlocks))
+	}
+	lastID, er := u.persistenceReadContext.GetLastProposalID(u.height)
+	if er != nil {
+		return coreTypes.ErrGetProposals(er)
+	}
+	proposal.Id = lastID + 1
+	proposal.SubmitHeight = u.height
+	proposal.VotingEndHeight = u.height + votingBlocks
+
+	if er := u.persistenceRWContext.SetProposal(proposal); er != nil {
+		return coreTypes.ErrSetProposal(er)
+	}
+	return nil
+}
+
+func (u *baseUtilityUnitOfWork) handleMessageVote(message *typesUtil.MessageVote) coreTypes.Error {
+	proposal, er := u.persistenceReadContext.GetProposal(message.ProposalId, u.height)
+	if er != nil {
+		return coreTypes.ErrProposalNotFound(message.ProposalId)
+	}
+	if proposal.Status != coreTypes.ProposalStatus_PROPOSAL_STATUS_VOTING || u.height > proposal.VotingEndHeight {
+		return coreTypes.ErrProposalNotVoting(proposal.Id, proposal.Status)
+	}
+	status, err := u.getActorStatus(coreTypes.ActorType_ACTOR_TYPE_VAL, message.Voter)
+	if err != nil {
+		return err
+	}
+	if status != coreTypes.StakeStatus_Staked {
+		return coreTypes.ErrInvalidStatus(status, coreTypes.StakeStatus_Staked)
+	}
+	vote := &coreTypes.ProposalVote{
+		ProposalId: message.ProposalId,
+		Voter:      message.Voter,
+		Option:     message.Option,
+		Height:     u.height,
+	}
+	if er := u.persistenceRWContext.SetProposalVote(vote); er != nil {
+		return coreTypes.ErrSetProposal(er)
+	}
+	return nil
+}
+
+// handleProposals tallies the proposals whose voting window ends at the current height, and executes the ones that passed
+func (u *baseUtilityUnitOfWork) handleProposals() coreTypes.Error {
+	proposals, er := u.persistenceReadContext.GetProposals(coreTypes.ProposalStatus_PROPOSAL_STATUS_VOTING, u.height)
+	if er != nil {
+		return coreTypes.ErrGetProposals(er)
+	}
+	var votingPower map[string]*big.Int
+	var totalVotingPower *big.Int
+	for _, proposal := range proposals {
+		if proposal.VotingEndHeight > u.height {
+			continue
+		}
+		if votingPower == nil {
+			var err coreTypes.Error
+			if votingPower, totalVotingPower, err = u.getValidatorsVotingPower(); err != nil {
+				return err
+			}
+		}
+		passed, err := u.tallyProposal(proposal, votingPower, totalVotingPower)
+		if err != nil {
+			return err
+		}
+		proposal.Status = coreTypes.ProposalStatus_PROPOSAL_STATUS_REJECTED
+		if passed {
+			proposal.Status = coreTypes.ProposalStatus_PROPOSAL_STATUS_PASSED
+			if err := u.executeProposal(proposal); err != nil {
+				// an upgrade superseded by a higher version since the proposal was submitted is not scheduled, which must not halt the chain
+				if err.Code() != coreTypes.CodeUpgradeVersionNotIncreasingError {
+					return err
+				}
+				u.logger.Warn().Err(err).Uint64("proposal_id", proposal.Id).Msg("passed proposal could not be executed")
+				proposal.Status = coreTypes.ProposalStatus_PROPOSAL_STATUS_FAILED
+			}
+		}
+		if er := u.persistenceRWContext.SetProposal(proposal); er != nil {
+			return coreTypes.ErrSetProposal(er)
+		}
+	}
+	return nil
+}
+
+// tallyProposal sets the tally of the proposal and returns whether it passed: the votes must reach
+// `governance_quorum_percentage` of the total voting power, and the yes votes must exceed
+// `governance_threshold_percentage` of the yes and no votes.
+func (u *baseUtilityUnitOfWork) tallyProposal(proposal *coreTypes.Proposal, votingPower map[string]*big.Int, totalVotingPower *big.Int) (bool, coreTypes.Error) {
+	quorumPercentage, err := getGovParam[int](u, typesUtil.GovernanceQuorumPercentageParamName)
+	if err != nil {
+		return false, err
+	}
+	thresholdPercentage, err := getGovParam[int](u, typesUtil.GovernanceThresholdPercentageParamName)
+	if err != nil {
+		return false, err
+	}
+	votes, er := u.persistenceReadContext.GetProposalVotes(proposal.Id, u.height)
+	if er != nil {
+		return false, coreTypes.ErrGetProposals(er)
+	}
+
+	yes, no, abstain := big.NewInt(0), big.NewInt(0), big.NewInt(0)
+	for _, vote := range votes {
+		// the voting power is the stake of the voter at the end of the voting window, so validators that unstaked do not count
+		power, ok := votingPower[string(vote.Voter)]
+		if !ok {
+			continue
+		}
+		switch vote.Option {
+		case coreTypes.VoteOption_VOTE_OPTION_YES:
+			yes.Add(yes, power)
+		case coreTypes.VoteOption_VOTE_OPTION_NO:
+			no.Add(no, power)
+		case coreTypes.VoteOption_VOTE_OPTION_ABSTAIN:
+			abstain.Add(abstain, power)
+		}
+	}
+	proposal.Tally = &coreTypes.ProposalTally{
+		Yes:              utils.BigIntToString(yes),
+		No:               utils.BigIntToString(no),
+		Abstain:          utils.BigIntToString(abstain),
+		TotalVotingPower: utils.BigIntToString(totalVotingPower),
+	}
+
+	if totalVotingPower.Sign() == 0 {
+		return false, nil
+	}
+	// quorum: (yes + no + abstain) * 100 >= quorum% * total
+	turnout := new(big.Int).Add(yes, no)
+	turnout.Add(turnout, abstain)
+	if turnout.Mul(turnout, big.NewInt(100)).Cmp(new(big.Int).Mul(totalVotingPower, big.NewInt(int64(quorumPercentage)))) < 0 {
+		return false, nil
+	}
+	// threshold: yes * 100 > threshold% * (yes + no)
+	decisive := new(big.Int).Add(yes, no)
+	return new(big.Int).Mul(yes, big.NewInt(100)).Cmp(decisive.Mul(decisive, big.NewInt(int64(thresholdPercentage)))) > 0, nil
+}
+
+func (u *baseUtilityUnitOfWork) executeProposal(proposal *coreTypes.Proposal) coreTypes.Error {
+	switch content := proposal.Content.(type) {
+	case *coreTypes.Proposal_ParamChange:
+		value, err := proposalValue(content.ParamChange.ParameterValue)
+		if err != nil {
+			return err
+		}
+		return u.updateParam(content.ParamChange.ParameterKey, value)
+	case *coreTypes.Proposal_FlagChange:
+		value, err := proposalValue(content.FlagChange.FlagValue)
+		if err != nil {
+			return err
+		}
+		return u.updateFlag(content.FlagChange.FlagKey, value, content.FlagChange.Enabled)
+	case *coreTypes.Proposal_Upgrade:
+		return u.scheduleUpgrade(content.Upgrade.Version, content.Upgrade.Height)
+	default:
+		return coreTypes.ErrEmptyProposalContent()
+	}
+}
+
+// getValidatorsVotingPower returns the voting power of every active validator keyed by address, along with their total
+func (u *baseUtilityUnitOfWork) getValidatorsVotingPower() (map[string]*big.Int, *big.Int, coreTypes.Error) {
+	validators, er := u.persistenceReadContext.GetAllValidators(u.height)
+	if er != nil {
+		return nil, nil, coreTypes.ErrGetAllValidators(er)
+	}
+	delegatedStake, err := u.getValidatorsDelegatedStake()
+	if err != nil {
+		return nil, nil, err
+	}
+	votingPower := make(map[string]*big.Int, len(validators))
+	total := big.NewInt(0)
+	for _, validator := range validators {
+		if validator.PausedHeight != -1 || validator.UnstakingHeight != -1 {
+			continue
+		}
+		address, err := crypto.NewAddress(validator.Address)
+		if err != nil {
+			return nil, nil, coreTypes.ErrGetAllValidators(err)
+		}
+		stake, err := utils.StringToBigInt(validator.StakedAmount)
+		if err != nil {
+			return nil, nil, coreTypes.ErrStringToBigInt(err)
+		}
+		// the stake delegated to the validator counts towards its voting power
+		if delegated, ok := delegatedStake[string(address)]; ok {
+			stake.Add(stake, delegated)
+		}
+		votingPower[string(address)] = stake
+		total.Add(total, stake)
+	}
+	return votingPower, total, nil
+}
+
+// validateProposedParamValue ensures the parameter exists, is not an owner parameter (those remain
+// under the control of the ACL owner) and that the proposed value has the type of the parameter, so
+// `updateParam` or `updateFlag` can apply it once the proposal passes
+func (u *baseUtilityUnitOfWork) validateProposedParamValue(paramKey string, proposedValue *anypb.Any) coreTypes.Error {
+	paramType, ok := govParamTypes[paramKey]
+	if !ok {
+		return coreTypes.ErrUnknownParam(paramKey)
+	}
+	value, err := proposalValue(proposedValue)
+	if err != nil {
+		return err
+	}
+	switch v := value.(type) {
+	case *wrapperspb.Int32Value:
+		if paramType == INT || paramType == INT64 {
+			return nil
+		}
+	case *wrapperspb.StringValue:
+		if paramType == STRING {
+			return nil
+		}
+		if paramType == BIGINT {
+			if _, er := utils.StringToBigInt(v.Value); er != nil {
+				return coreTypes.ErrStringToBigInt(er)
+			}
+			return nil
+		}
+	case *wrapperspb.BytesValue:
+		if paramType == BYTES {
+			return nil
+		}
+	}
+	return coreTypes.ErrInvalidProposal(fmt.Sprintf("invalid value type %T for parameter %s", value, paramKey))
+}
+
+func (u *baseUtilityUnitOfWork) updateFlag(flagName string, value any, enabled bool) coreTypes.Error {
+	var er error
+	switch t := value.(type) {
+	case *wrapperspb.Int32Value:
+		er = u.persistenceRWContext.SetFlag(flagName, int(t.Value), enabled)
+	case *wrapperspb.StringValue:
+		er = u.persistenceRWContext.SetFlag(flagName, t.Value, enabled)
+	case *wrapperspb.BytesValue:
+		er = u.persistenceRWContext.SetFlag(flagName, t.Value, enabled)
+	}
+	if er != nil {
+		return coreTypes.ErrUpdateParam(er)
+	}
+	return nil
+}
+
+// proposalValue decodes the value of a param or flag change proposal, which must be one of the
+// wrapper types supported by `updateParam` so a passed proposal can always be executed
+func proposalValue(value *anypb.Any) (any, coreTypes.Error) {
+	v, err := codec.GetCodec().FromAny(value)
+	if err != nil {
+		return nil, coreTypes.ErrProtoFromAny(err)
+	}
+	switch v.(type) {
+	case *wrapperspb.Int32Value, *wrapperspb.StringValue, *wrapperspb.BytesValue:
+		return v, nil
+	default:
+		return nil, coreTypes.ErrInvalidProposal(fmt.Sprintf("unsupported value type %T", v))
+	}
+}
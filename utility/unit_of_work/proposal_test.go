@@ -0,0 +1,158 @@
+package unit_of_work
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/pokt-network/pocket/runtime/test_artifacts"
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestUtilityUnitOfWork_HandleProposals_ParamChange(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 0)
+	proposal := submitTestingParamChangeProposal(t, uow, 2)
+
+	validators := getAllTestingValidators(t, uow)
+	require.GreaterOrEqual(t, len(validators), 2)
+	for _, validator := range validators[:2] {
+		voteOnTestingProposal(t, uow, proposal.Id, validator, coreTypes.VoteOption_VOTE_OPTION_YES)
+	}
+
+	// the proposal is not tallied before the end of its voting window
+	require.NoError(t, uow.handleProposals())
+	proposal, err := uow.persistenceReadContext.GetProposal(proposal.Id, uow.height)
+	require.NoError(t, err)
+	require.Equal(t, coreTypes.ProposalStatus_PROPOSAL_STATUS_VOTING, proposal.Status)
+
+	uow.height = proposal.VotingEndHeight
+	require.NoError(t, uow.handleProposals())
+	proposal, err = uow.persistenceReadContext.GetProposal(proposal.Id, uow.height)
+	require.NoError(t, err)
+	require.Equal(t, coreTypes.ProposalStatus_PROPOSAL_STATUS_PASSED, proposal.Status)
+	require.Equal(t, "0", proposal.Tally.No)
+
+	gotParam, er := getGovParam[int](uow, typesUtil.MissedBlocksBurnPercentageParamName)
+	require.NoError(t, er)
+	require.Equal(t, 2, gotParam)
+}
+
+func TestUtilityUnitOfWork_HandleProposals_Rejected(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 0)
+	defaultParam, er := getGovParam[int](uow, typesUtil.MissedBlocksBurnPercentageParamName)
+	require.NoError(t, er)
+	proposal := submitTestingParamChangeProposal(t, uow, int32(defaultParam+1))
+
+	validators := getAllTestingValidators(t, uow)
+	voteOnTestingProposal(t, uow, proposal.Id, validators[0], coreTypes.VoteOption_VOTE_OPTION_NO)
+
+	uow.height = proposal.VotingEndHeight
+	require.NoError(t, uow.handleProposals())
+	proposal, err := uow.persistenceReadContext.GetProposal(proposal.Id, uow.height)
+	require.NoError(t, err)
+	require.Equal(t, coreTypes.ProposalStatus_PROPOSAL_STATUS_REJECTED, proposal.Status)
+	require.Equal(t, "0", proposal.Tally.Yes)
+
+	gotParam, er := getGovParam[int](uow, typesUtil.MissedBlocksBurnPercentageParamName)
+	require.NoError(t, er)
+	require.Equal(t, defaultParam, gotParam)
+}
+
+func TestUtilityUnitOfWork_HandleMessageSubmitProposal_InvalidParam(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 0)
+	value, err := codec.GetCodec().ToAny(wrapperspb.String(hex.EncodeToString(test_artifacts.DefaultParamsOwner.Address())))
+	require.NoError(t, err)
+
+	msg := &typesUtil.MessageSubmitProposal{
+		Proposer: test_artifacts.DefaultParamsOwner.Address(),
+		Title:    "change the acl owner",
+		Content: &typesUtil.MessageSubmitProposal_ParamChange{ParamChange: &coreTypes.ParamChangeProposal{
+			ParameterKey:   typesUtil.AclOwner,
+			ParameterValue: value,
+		}},
+	}
+	require.Equal(t, coreTypes.CodeUnknownParamError, uow.handleMessageSubmitProposal(msg).Code())
+
+	msg.GetParamChange().ParameterKey = typesUtil.MissedBlocksBurnPercentageParamName
+	require.Equal(t, coreTypes.CodeInvalidProposalError, uow.handleMessageSubmitProposal(msg).Code())
+}
+
+func TestUtilityUnitOfWork_HandleMessageSubmitProposal_InvalidFlag(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 0)
+	value, err := codec.GetCodec().ToAny(wrapperspb.String("enabled"))
+	require.NoError(t, err)
+
+	msg := &typesUtil.MessageSubmitProposal{
+		Proposer: test_artifacts.DefaultParamsOwner.Address(),
+		Title:    "enable a feature",
+		Content: &typesUtil.MessageSubmitProposal_FlagChange{FlagChange: &coreTypes.FlagChangeProposal{
+			FlagKey:   "nonexistent_flag",
+			FlagValue: value,
+			Enabled:   true,
+		}},
+	}
+	require.Equal(t, coreTypes.CodeUnknownParamError, uow.handleMessageSubmitProposal(msg).Code())
+
+	// the value must have the type of the parameter gated by the flag
+	msg.GetFlagChange().FlagKey = typesUtil.MissedBlocksBurnPercentageParamName
+	require.Equal(t, coreTypes.CodeInvalidProposalError, uow.handleMessageSubmitProposal(msg).Code())
+
+	msg.GetFlagChange().FlagValue, err = codec.GetCodec().ToAny(wrapperspb.Int32(2))
+	require.NoError(t, err)
+	require.NoError(t, uow.handleMessageSubmitProposal(msg))
+}
+
+func TestUtilityUnitOfWork_HandleMessageVote_NotValidator(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 0)
+	proposal := submitTestingParamChangeProposal(t, uow, 2)
+
+	app := getFirstActor(t, uow, coreTypes.ActorType_ACTOR_TYPE_APP)
+	appAddr, err := hex.DecodeString(app.GetAddress())
+	require.NoError(t, err)
+	msg := &typesUtil.MessageVote{
+		Voter:      appAddr,
+		ProposalId: proposal.Id,
+		Option:     coreTypes.VoteOption_VOTE_OPTION_YES,
+	}
+	require.Error(t, uow.handleMessageVote(msg))
+
+	msg.ProposalId = proposal.Id + 1
+	require.Equal(t, coreTypes.CodeProposalNotFoundError, uow.handleMessageVote(msg).Code())
+}
+
+func submitTestingParamChangeProposal(t *testing.T, uow *baseUtilityUnitOfWork, value int32) *coreTypes.Proposal {
+	t.Helper()
+	paramValue, err := codec.GetCodec().ToAny(wrapperspb.Int32(value))
+	require.NoError(t, err)
+
+	msg := &typesUtil.MessageSubmitProposal{
+		Proposer: test_artifacts.DefaultParamsOwner.Address(),
+		Title:    "change the missed blocks burn percentage",
+		Content: &typesUtil.MessageSubmitProposal_ParamChange{ParamChange: &coreTypes.ParamChangeProposal{
+			ParameterKey:   typesUtil.MissedBlocksBurnPercentageParamName,
+			ParameterValue: paramValue,
+		}},
+	}
+	require.NoError(t, uow.handleMessageSubmitProposal(msg))
+
+	lastID, err := uow.persistenceReadContext.GetLastProposalID(uow.height)
+	require.NoError(t, err)
+	proposal, err := uow.persistenceReadContext.GetProposal(lastID, uow.height)
+	require.NoError(t, err)
+	require.Equal(t, coreTypes.ProposalStatus_PROPOSAL_STATUS_VOTING, proposal.Status)
+	return proposal
+}
+
+func voteOnTestingProposal(t *testing.T, uow *baseUtilityUnitOfWork, proposalID uint64, validator *coreTypes.Actor, option coreTypes.VoteOption) {
+	t.Helper()
+	voter, err := hex.DecodeString(validator.GetAddress())
+	require.NoError(t, err)
+	require.NoError(t, uow.handleMessageVote(&typesUtil.MessageVote{
+		Voter:      voter,
+		ProposalId: proposalID,
+		Option:     option,
+	}))
+}
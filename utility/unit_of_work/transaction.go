@@ -31,12 +31,11 @@ func (u *baseUtilityUnitOfWork) basicValidateTransaction(tx *coreTypes.Transacti
 		return nil, err
 	}
 
-	// Get the address of the transaction signer
-	pubKey, er := crypto.NewPublicKeyFromBytes(tx.Signature.PublicKey)
-	if er != nil {
-		return nil, coreTypes.ErrNewPublicKeyFromBytes(er)
+	// Get the address of the transaction signer, which is the address of the multisig for multi-signed transactions
+	address, err := tx.SignerAddress()
+	if err != nil {
+		return nil, err
 	}
-	address := pubKey.Address()
 
 	// Validate that the signer has a valid signature
 	address, err = u.validateTxSignature(address, msg)
@@ -34,6 +34,50 @@ func TestUtilityUnitOfWork_BasicValidateTransaction(t *testing.T) {
 	require.Equal(t, expectedAfterBalance, amount, "unexpected after balance")
 }
 
+func TestUtilityUnitOfWork_BasicValidateTransaction_Multisig(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 0)
+
+	members := make([]crypto.PrivateKey, 0, 3)
+	publicKeys := make([]crypto.PublicKey, 0, 3)
+	for i := 0; i < 3; i++ {
+		member, err := crypto.GeneratePrivateKey()
+		require.NoError(t, err)
+		members = append(members, member)
+		publicKeys = append(publicKeys, member.PublicKey())
+	}
+	multisigKey, err := crypto.NewMultisigPublicKey(2, publicKeys)
+	require.NoError(t, err)
+	multisigAddr := multisigKey.Address()
+	require.NoError(t, uow.setAccountAmount(multisigAddr, test_artifacts.DefaultAccountAmount))
+
+	recipientAddr, err := crypto.GenerateAddress()
+	require.NoError(t, err)
+	msg := NewTestingSendMessage(t, multisigAddr, recipientAddr.Bytes(), utils.BigIntToString(defaultSendAmount))
+	any, err := codec.GetCodec().ToAny(&msg)
+	require.NoError(t, err)
+	tx := &coreTypes.Transaction{
+		Msg:   any,
+		Nonce: testNonce,
+	}
+	for _, member := range members[1:] {
+		require.NoError(t, tx.SignMultisig(member, coreTypes.NewMultisigPublicKey(multisigKey)))
+	}
+	require.NoError(t, tx.ValidateBasic())
+
+	validatedMsg, er := uow.basicValidateTransaction(tx)
+	require.NoError(t, er)
+	require.Equal(t, multisigAddr.Bytes(), validatedMsg.GetSigner())
+
+	// A member cannot send from the multisig account with its own signature
+	memberTx := &coreTypes.Transaction{
+		Msg:   any,
+		Nonce: testNonce,
+	}
+	require.NoError(t, memberTx.Sign(members[0]))
+	_, er = uow.basicValidateTransaction(memberTx)
+	require.Equal(t, coreTypes.CodeInvalidSignerError, er.Code())
+}
+
 func TestUtilityUnitOfWork_HandleTransaction(t *testing.T) {
 	uow := newTestingUtilityUnitOfWork(t, 0)
 
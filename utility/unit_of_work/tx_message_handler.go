@@ -27,6 +27,18 @@ func (u *baseUtilityUnitOfWork) handleMessage(msg typesUtil.Message) (err coreTy
 		return u.handleUnpauseMessage(x)
 	case *typesUtil.MessageChangeParameter:
 		return u.handleMessageChangeParameter(x)
+	case *typesUtil.MessageSubmitProposal:
+		return u.handleMessageSubmitProposal(x)
+	case *typesUtil.MessageVote:
+		return u.handleMessageVote(x)
+	case *typesUtil.MessageUpgrade:
+		return u.handleMessageUpgrade(x)
+	case *typesUtil.MessageDelegate:
+		return u.handleMessageDelegate(x)
+	case *typesUtil.MessageUndelegate:
+		return u.handleMessageUndelegate(x)
+	case *typesUtil.MessageRedelegate:
+		return u.handleMessageRedelegate(x)
 	case *ibcTypes.UpdateIBCStore:
 		return u.handleUpdateIBCStore(x)
 	case *ibcTypes.PruneIBCStore:
@@ -25,6 +25,18 @@ func (u *baseUtilityUnitOfWork) getSignerCandidates(msg typesUtil.Message) ([][]
 		return u.getMessageUnpauseSignerCandidates(x)
 	case *typesUtil.MessageChangeParameter:
 		return u.getMessageChangeParameterSignerCandidates(x)
+	case *typesUtil.MessageSubmitProposal:
+		return u.getMessageSubmitProposalSignerCandidates(x)
+	case *typesUtil.MessageVote:
+		return u.getMessageVoteSignerCandidates(x)
+	case *typesUtil.MessageUpgrade:
+		return u.getMessageUpgradeSignerCandidates(x)
+	case *typesUtil.MessageDelegate:
+		return u.getMessageDelegateSignerCandidates(x)
+	case *typesUtil.MessageUndelegate:
+		return u.getMessageUndelegateSignerCandidates(x)
+	case *typesUtil.MessageRedelegate:
+		return u.getMessageRedelegateSignerCandidates(x)
 	case *ibcTypes.UpdateIBCStore:
 		return u.getUpdateIBCStoreSingerCandidates(x)
 	case *ibcTypes.PruneIBCStore:
@@ -78,6 +90,40 @@ func (u *baseUtilityUnitOfWork) getMessageSendSignerCandidates(msg *typesUtil.Me
 	return [][]byte{msg.FromAddress}, nil
 }
 
+func (u *baseUtilityUnitOfWork) getMessageSubmitProposalSignerCandidates(msg *typesUtil.MessageSubmitProposal) ([][]byte, coreTypes.Error) {
+	return [][]byte{msg.Proposer}, nil
+}
+
+func (u *baseUtilityUnitOfWork) getMessageVoteSignerCandidates(msg *typesUtil.MessageVote) ([][]byte, coreTypes.Error) {
+	output, err := u.getActorOutputAddress(coreTypes.ActorType_ACTOR_TYPE_VAL, msg.Voter)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([][]byte, 0)
+	candidates = append(candidates, output, msg.Voter)
+	return candidates, nil
+}
+
+func (u *baseUtilityUnitOfWork) getMessageUpgradeSignerCandidates(_ *typesUtil.MessageUpgrade) ([][]byte, coreTypes.Error) {
+	aclOwner, err := u.getByteArrayParam(typesUtil.AclOwner)
+	if err != nil {
+		return nil, err
+	}
+	return [][]byte{aclOwner}, nil
+}
+
+func (u *baseUtilityUnitOfWork) getMessageDelegateSignerCandidates(msg *typesUtil.MessageDelegate) ([][]byte, coreTypes.Error) {
+	return [][]byte{msg.Delegator}, nil
+}
+
+func (u *baseUtilityUnitOfWork) getMessageUndelegateSignerCandidates(msg *typesUtil.MessageUndelegate) ([][]byte, coreTypes.Error) {
+	return [][]byte{msg.Delegator}, nil
+}
+
+func (u *baseUtilityUnitOfWork) getMessageRedelegateSignerCandidates(msg *typesUtil.MessageRedelegate) ([][]byte, coreTypes.Error) {
+	return [][]byte{msg.Delegator}, nil
+}
+
 func (u *baseUtilityUnitOfWork) getUpdateIBCStoreSingerCandidates(msg *ibcTypes.UpdateIBCStore) ([][]byte, coreTypes.Error) {
 	return [][]byte{msg.Signer}, nil
 }
@@ -0,0 +1,130 @@
+package unit_of_work
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/pokt-network/pocket/logger"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	"github.com/pokt-network/pocket/shared/modules"
+)
+
+var (
+	_ modules.UtilityUnitOfWork           = &simulationUtilityUnitOfWork{}
+	_ modules.SimulationUtilityUnitOfWork = &simulationUtilityUnitOfWork{}
+)
+
+// simulationUtilityUnitOfWork is a throwaway unit of work used to dry run transactions. It must be
+// backed by a persistence simulation context and can never be committed.
+type simulationUtilityUnitOfWork struct {
+	baseUtilityUnitOfWork
+}
+
+func NewSimulationUOW(height int64, readContext modules.PersistenceReadContext, rwPersistenceContext modules.PersistenceRWContext) *simulationUtilityUnitOfWork {
+	return &simulationUtilityUnitOfWork{
+		baseUtilityUnitOfWork: baseUtilityUnitOfWork{
+			height:                 height,
+			persistenceReadContext: readContext,
+			persistenceRWContext:   rwPersistenceContext,
+			logger:                 logger.Global.CreateLoggerForModule(simulationUtilityUOWModuleName),
+		},
+	}
+}
+
+func (uow *simulationUtilityUnitOfWork) Commit(_ []byte) error {
+	return fmt.Errorf("cannot commit a simulation unit of work")
+}
+
+// SimulateTransaction runs the transaction through the same validation, fee deduction and message handling
+// as `HandleTransaction`, recording the outcome of each step instead of stopping at the first failure
+// where possible. Changes are left in the unit of work, which must be released to roll them back.
+func (uow *simulationUtilityUnitOfWork) SimulateTransaction(tx *coreTypes.Transaction, signer []byte) *coreTypes.TxSimulation {
+	result := &coreTypes.TxSimulation{
+		Height: uow.height,
+	}
+
+	msg, err := uow.validateTxMessage(tx)
+	if err != nil {
+		return simulationError(result, err)
+	}
+	result.MessageType = msg.GetMessageName()
+	if err := msg.ValidateBasic(); err != nil {
+		return simulationError(result, err)
+	}
+
+	fee, err := uow.getFee(msg, msg.GetActorType())
+	if err != nil {
+		return simulationError(result, err)
+	}
+	result.Fee = fee.String()
+
+	signerCandidates, err := uow.getSignerCandidates(msg)
+	if err != nil {
+		return simulationError(result, err)
+	}
+	for _, candidate := range signerCandidates {
+		result.SignerCandidates = append(result.SignerCandidates, hex.EncodeToString(candidate))
+	}
+
+	// Signed transactions must have a valid signature and are simulated on behalf of their signer,
+	// otherwise the public key or the signer provided are used to identify who would sign it.
+	// Partially signed multi-signature transactions are simulated on behalf of their multisig.
+	switch {
+	case tx.GetSignature() != nil && len(tx.GetSignature().GetSignature()) > 0:
+		if er := tx.ValidateBasic(); er != nil {
+			return simulationError(result, toCoreError(er))
+		}
+		pubKey, er := crypto.NewPublicKeyFromBytes(tx.GetSignature().GetPublicKey())
+		if er != nil {
+			return simulationError(result, coreTypes.ErrNewPublicKeyFromBytes(er))
+		}
+		signer = pubKey.Address()
+	case tx.GetMultiSignature() != nil:
+		if tx.GetSignature() != nil {
+			return simulationError(result, coreTypes.ErrMultipleSignatureStructures())
+		}
+		if signer, err = tx.SignerAddress(); err != nil {
+			return simulationError(result, err)
+		}
+	case tx.GetSignature() != nil && len(tx.GetSignature().GetPublicKey()) > 0:
+		pubKey, er := crypto.NewPublicKeyFromBytes(tx.GetSignature().GetPublicKey())
+		if er != nil {
+			return simulationError(result, coreTypes.ErrNewPublicKeyFromBytes(er))
+		}
+		signer = pubKey.Address()
+	}
+	if len(signer) == 0 {
+		return simulationError(result, coreTypes.ErrEmptySignatureStructure())
+	}
+	result.SignerAddr = hex.EncodeToString(signer)
+
+	address, err := uow.validateTxSignature(signer, msg)
+	if err != nil {
+		return simulationError(result, err)
+	}
+	result.IsSignerCandidate = true
+	msg.SetSigner(address)
+
+	if err := uow.validateAndDeductTxFees(address, msg); err != nil {
+		return simulationError(result, err)
+	}
+	if err := uow.handleMessage(msg); err != nil {
+		return simulationError(result, err)
+	}
+	return result
+}
+
+func simulationError(result *coreTypes.TxSimulation, err coreTypes.Error) *coreTypes.TxSimulation {
+	result.ResultCode = int32(err.Code())
+	result.Error = err.Error()
+	return result
+}
+
+// toCoreError preserves the code of the errors returned by `Transaction.ValidateBasic`, which are all `coreTypes.Error`
+func toCoreError(err error) coreTypes.Error {
+	if coreErr, ok := err.(coreTypes.Error); ok {
+		return coreErr
+	}
+	return coreTypes.NewError(coreTypes.CodeTransactionSignError, err.Error())
+}
@@ -0,0 +1,66 @@
+package unit_of_work
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	"github.com/pokt-network/pocket/shared/crypto"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+)
+
+func TestUtilityUnitOfWork_SimulateTransaction(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 0)
+	simulationUOW := &simulationUtilityUnitOfWork{baseUtilityUnitOfWork: *uow}
+
+	feeBig, err := getGovParam[*big.Int](uow, typesUtil.MessageSendFee)
+	require.NoError(t, err)
+
+	t.Run("signed transaction", func(t *testing.T) {
+		tx, _, _, signer := newTestingTransaction(t, uow)
+
+		simulation := simulationUOW.SimulateTransaction(tx, nil)
+		require.Equal(t, int32(0), simulation.GetResultCode(), simulation.GetError())
+		require.Equal(t, feeBig.String(), simulation.GetFee())
+		require.Equal(t, hex.EncodeToString(signer.Address()), simulation.GetSignerAddr())
+		require.Contains(t, simulation.GetSignerCandidates(), hex.EncodeToString(signer.Address()))
+		require.True(t, simulation.GetIsSignerCandidate())
+	})
+
+	t.Run("unsigned transaction with a signer", func(t *testing.T) {
+		tx, _, _, signer := newTestingTransaction(t, uow)
+		tx.Signature = nil
+
+		simulation := simulationUOW.SimulateTransaction(tx, signer.Address())
+		require.Equal(t, int32(0), simulation.GetResultCode(), simulation.GetError())
+		require.True(t, simulation.GetIsSignerCandidate())
+	})
+
+	t.Run("unsigned transaction without a signer", func(t *testing.T) {
+		tx, _, _, _ := newTestingTransaction(t, uow)
+		tx.Signature = nil
+
+		simulation := simulationUOW.SimulateTransaction(tx, nil)
+		require.Equal(t, int32(coreTypes.CodeEmptySignatureStructureError), simulation.GetResultCode())
+		require.Equal(t, feeBig.String(), simulation.GetFee())
+		require.NotEmpty(t, simulation.GetSignerCandidates())
+	})
+
+	t.Run("signer is not a candidate", func(t *testing.T) {
+		tx, _, _, _ := newTestingTransaction(t, uow)
+		tx.Signature = nil
+		other, er := crypto.GenerateAddress()
+		require.NoError(t, er)
+
+		simulation := simulationUOW.SimulateTransaction(tx, other)
+		require.Equal(t, int32(coreTypes.CodeInvalidSignerError), simulation.GetResultCode())
+		require.False(t, simulation.GetIsSignerCandidate())
+	})
+
+	t.Run("cannot be committed", func(t *testing.T) {
+		require.Error(t, simulationUOW.Commit(nil))
+	})
+}
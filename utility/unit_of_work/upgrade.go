@@ -0,0 +1,118 @@
+package unit_of_work
+
+// Internal business logic for protocol upgrades: scheduling a protocol version at a future height and
+// gating the application of the blocks on the version supported by the binary.
+
+import (
+	"github.com/pokt-network/pocket/app"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"golang.org/x/mod/semver"
+)
+
+// upgradeHandler applies the state migrations of a protocol version in the first block it is active
+type upgradeHandler func(uow *baseUtilityUnitOfWork) coreTypes.Error
+
+// upgradeHandlers maps the protocol versions to their upgrade handler, if any.
+// No version requires a migration yet: this is the extension point for the first upgrade that does.
+var upgradeHandlers = map[string]upgradeHandler{}
+
+func (u *baseUtilityUnitOfWork) handleMessageUpgrade(message *typesUtil.MessageUpgrade) coreTypes.Error {
+	return u.scheduleUpgrade(message.Version, message.Height)
+}
+
+// scheduleUpgrade schedules the version at the upgrade height, superseding the upgrade pending at the current height if any
+func (u *baseUtilityUnitOfWork) scheduleUpgrade(version string, upgradeHeight int64) coreTypes.Error {
+	if err := u.validateUpgradeVersion(version); err != nil {
+		return err
+	}
+	if upgradeHeight <= u.height {
+		return coreTypes.ErrInvalidUpgradeHeight(upgradeHeight, u.height)
+	}
+	if er := u.persistenceRWContext.SetUpgrade(version, upgradeHeight); er != nil {
+		return coreTypes.ErrSetUpgrade(er)
+	}
+	u.logger.Info().Str("version", version).Int64("upgrade_height", upgradeHeight).Msg("protocol upgrade scheduled")
+	return nil
+}
+
+// validateUpgradeVersion ensures the version is canonical and greater than both the active protocol version and the
+// version of the upgrade pending, so an upgrade can never schedule a downgrade
+func (u *baseUtilityUnitOfWork) validateUpgradeVersion(version string) coreTypes.Error {
+	if err := coreTypes.ValidateProtocolVersion(version); err != nil {
+		return err
+	}
+	active, err := u.isProtocolVersionActive(version)
+	if err != nil {
+		return err
+	}
+	if active {
+		return coreTypes.ErrUpgradeVersionNotIncreasing(version, u.protocolVersion)
+	}
+	pending, er := u.persistenceReadContext.GetPendingUpgrade(u.height)
+	if er != nil {
+		return coreTypes.ErrGetUpgrade(er)
+	}
+	if pending != nil && semver.Compare(version, pending.Version) <= 0 {
+		return coreTypes.ErrUpgradeVersionNotIncreasing(version, pending.Version)
+	}
+	return nil
+}
+
+// getProtocolVersion returns the protocol version active at the height of the unit of work. It is set at the beginning
+// of the block by `handleProtocolVersion`, and looked up if the unit of work did not begin a block.
+func (u *baseUtilityUnitOfWork) getProtocolVersion() (string, coreTypes.Error) {
+	if u.protocolVersion == "" {
+		version, er := u.persistenceReadContext.GetVersionAtHeight(u.height)
+		if er != nil {
+			return "", coreTypes.ErrGetUpgrade(er)
+		}
+		u.protocolVersion = version
+	}
+	return u.protocolVersion, nil
+}
+
+// isProtocolVersionActive returns true if the protocol version active at the height of the unit of work is at least
+// `version`. Logic that differs between protocol versions branches on it when the block is applied.
+func (u *baseUtilityUnitOfWork) isProtocolVersionActive(version string) (bool, coreTypes.Error) {
+	activeVersion, err := u.getProtocolVersion()
+	if err != nil {
+		return false, err
+	}
+	return semver.Compare(activeVersion, version) >= 0, nil
+}
+
+// handleProtocolVersion sets the protocol version active at the current height and refuses to apply the block if the
+// binary does not support it. The upgrade handler of the version runs in the first block the version is active.
+func (u *baseUtilityUnitOfWork) handleProtocolVersion() coreTypes.Error {
+	version, er := u.persistenceReadContext.GetVersionAtHeight(u.height)
+	if er != nil {
+		return coreTypes.ErrGetUpgrade(er)
+	}
+	if !coreTypes.SupportsProtocolVersion(app.AppVersion, version) {
+		u.logger.Error().Str("binary_version", app.AppVersion).Str("protocol_version", version).Msg("the binary must be upgraded to apply blocks at this height")
+		return coreTypes.ErrProtocolVersionNotSupported(app.AppVersion, version, u.height)
+	}
+	u.protocolVersion = version
+
+	pending, er := u.persistenceReadContext.GetPendingUpgrade(u.height)
+	if er != nil {
+		return coreTypes.ErrGetUpgrade(er)
+	}
+	if pending != nil && !coreTypes.SupportsProtocolVersion(app.AppVersion, pending.Version) {
+		u.logger.Warn().Str("binary_version", app.AppVersion).Str("protocol_version", pending.Version).Int64("upgrade_height", pending.Height).Msg("the binary must be upgraded before the upgrade height")
+	}
+
+	previousVersion, er := u.persistenceReadContext.GetVersionAtHeight(u.height - 1)
+	if er != nil {
+		return coreTypes.ErrGetUpgrade(er)
+	}
+	if previousVersion == version {
+		return nil
+	}
+	u.logger.Info().Str("protocol_version", version).Msg("protocol upgrade activated")
+	if handler, ok := upgradeHandlers[version]; ok {
+		return handler(u)
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package unit_of_work
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket/app"
+	"github.com/pokt-network/pocket/runtime/test_artifacts"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
+	typesUtil "github.com/pokt-network/pocket/utility/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUtilityUnitOfWork_HandleMessageUpgrade(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 1)
+
+	msg := &typesUtil.MessageUpgrade{
+		Signer:  test_artifacts.DefaultParamsOwner.Address(),
+		Version: "v1.0.0",
+		Height:  1,
+	}
+	require.Equal(t, coreTypes.CodeInvalidUpgradeHeightError, uow.handleMessageUpgrade(msg).Code())
+
+	msg.Height = 10
+	require.NoError(t, uow.handleMessageUpgrade(msg))
+
+	pending, err := uow.persistenceReadContext.GetPendingUpgrade(uow.height)
+	require.NoError(t, err)
+	require.Equal(t, "v1.0.0", pending.Version)
+	require.Equal(t, int64(10), pending.Height)
+
+	candidates, er := uow.getSignerCandidates(msg)
+	require.NoError(t, er)
+	require.Equal(t, [][]byte{test_artifacts.DefaultParamsOwner.Address()}, candidates)
+}
+
+func TestUtilityUnitOfWork_HandleProtocolVersion_BinaryTooOld(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 1)
+	binaryVersion := app.AppVersion
+	t.Cleanup(func() { app.AppVersion = binaryVersion })
+	app.AppVersion = "v1.0.0"
+
+	require.NoError(t, uow.scheduleUpgrade("v2.0.0", 5))
+
+	// blocks before the upgrade height are still applied
+	uow.height = 4
+	require.NoError(t, uow.handleProtocolVersion())
+	requireProtocolVersion(t, uow, coreTypes.GenesisProtocolVersion)
+
+	uow.height = 5
+	require.Equal(t, coreTypes.CodeProtocolVersionNotSupportedError, uow.handleProtocolVersion().Code())
+
+	app.AppVersion = "v2.0.0"
+	require.NoError(t, uow.handleProtocolVersion())
+	requireProtocolVersion(t, uow, "v2.0.0")
+}
+
+func TestUtilityUnitOfWork_HandleProtocolVersion_UpgradeHandler(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 1)
+	const version = "v1.0.0"
+	binaryVersion := app.AppVersion
+	t.Cleanup(func() { app.AppVersion = binaryVersion })
+	app.AppVersion = version
+	handled := make([]int64, 0)
+	upgradeHandlers[version] = func(u *baseUtilityUnitOfWork) coreTypes.Error {
+		handled = append(handled, u.height)
+		return nil
+	}
+	t.Cleanup(func() { delete(upgradeHandlers, version) })
+
+	require.NoError(t, uow.scheduleUpgrade(version, 3))
+	for height := int64(2); height <= 4; height++ {
+		uow.height = height
+		require.NoError(t, uow.handleProtocolVersion())
+	}
+	require.Equal(t, []int64{3}, handled)
+}
+
+func TestUtilityUnitOfWork_ScheduleUpgrade_NotIncreasing(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 1)
+	binaryVersion := app.AppVersion
+	t.Cleanup(func() { app.AppVersion = binaryVersion })
+	app.AppVersion = "v2.0.0"
+
+	// the version must be greater than the active one
+	require.Equal(t, coreTypes.CodeUpgradeVersionNotIncreasingError, uow.scheduleUpgrade(coreTypes.GenesisProtocolVersion, 5).Code())
+	require.NoError(t, uow.scheduleUpgrade("v1.0.0", 5))
+
+	// and than the pending one, which it supersedes
+	for _, version := range []string{"v0.9.0", "v1.0.0"} {
+		require.Equal(t, coreTypes.CodeUpgradeVersionNotIncreasingError, uow.scheduleUpgrade(version, 6).Code(), version)
+	}
+	require.NoError(t, uow.scheduleUpgrade("v1.1.0", 6))
+
+	uow.height = 6
+	require.NoError(t, uow.handleProtocolVersion())
+	requireProtocolVersion(t, uow, "v1.1.0")
+	active, err := uow.isProtocolVersionActive("v1.0.0")
+	require.NoError(t, err)
+	require.True(t, active)
+	require.Equal(t, coreTypes.CodeUpgradeVersionNotIncreasingError, uow.scheduleUpgrade("v1.1.0", 10).Code())
+}
+
+func TestUtilityUnitOfWork_HandleProposals_Upgrade(t *testing.T) {
+	uow := newTestingUtilityUnitOfWork(t, 1)
+	votingBlocks, err := getGovParam[int64](uow, typesUtil.GovernanceVotingBlocksParamName)
+	require.NoError(t, err)
+
+	msg := &typesUtil.MessageSubmitProposal{
+		Proposer: test_artifacts.DefaultParamsOwner.Address(),
+		Title:    "upgrade the protocol",
+		Content: &typesUtil.MessageSubmitProposal_Upgrade{Upgrade: &coreTypes.UpgradeProposal{
+			Version: "v1.0.0",
+			Height:  uow.height + votingBlocks,
+		}},
+	}
+	require.Equal(t, coreTypes.CodeInvalidProposalError, uow.handleMessageSubmitProposal(msg).Code())
+
+	msg.GetUpgrade().Height = uow.height + votingBlocks + 10
+	require.NoError(t, uow.handleMessageSubmitProposal(msg))
+	proposalID, er := uow.persistenceReadContext.GetLastProposalID(uow.height)
+	require.NoError(t, er)
+	for _, validator := range getAllTestingValidators(t, uow) {
+		voteOnTestingProposal(t, uow, proposalID, validator, coreTypes.VoteOption_VOTE_OPTION_YES)
+	}
+
+	uow.height += votingBlocks
+	require.NoError(t, uow.handleProposals())
+
+	pending, er := uow.persistenceReadContext.GetPendingUpgrade(uow.height)
+	require.NoError(t, er)
+	require.Equal(t, "v1.0.0", pending.Version)
+	require.Equal(t, msg.GetUpgrade().Height, pending.Height)
+
+	// a passed upgrade superseded by a higher version since its submission fails without halting the chain
+	msg.GetUpgrade().Version = "v1.1.0"
+	msg.GetUpgrade().Height = uow.height + votingBlocks + 10
+	require.NoError(t, uow.handleMessageSubmitProposal(msg))
+	proposalID, er = uow.persistenceReadContext.GetLastProposalID(uow.height)
+	require.NoError(t, er)
+	for _, validator := range getAllTestingValidators(t, uow) {
+		voteOnTestingProposal(t, uow, proposalID, validator, coreTypes.VoteOption_VOTE_OPTION_YES)
+	}
+	require.NoError(t, uow.scheduleUpgrade("v2.0.0", msg.GetUpgrade().Height))
+
+	uow.height += votingBlocks
+	require.NoError(t, uow.handleProposals())
+	proposal, er := uow.persistenceReadContext.GetProposal(proposalID, uow.height)
+	require.NoError(t, er)
+	require.Equal(t, coreTypes.ProposalStatus_PROPOSAL_STATUS_FAILED, proposal.Status)
+
+	pending, er = uow.persistenceReadContext.GetPendingUpgrade(uow.height)
+	require.NoError(t, er)
+	require.Equal(t, "v2.0.0", pending.Version)
+}
+
+func requireProtocolVersion(t *testing.T, uow *baseUtilityUnitOfWork, expected string) {
+	t.Helper()
+	version, err := uow.getProtocolVersion()
+	require.NoError(t, err)
+	require.Equal(t, expected, version)
+}
@@ -94,6 +94,11 @@ func (u *baseUtilityUnitOfWork) burnValidator(addr []byte) coreTypes.Error {
 		return err
 	}
 
+	// the stake delegated to the validator is burnt by the same percentage
+	if err := u.burnValidatorDelegations(addr, burnPercent); err != nil {
+		return err
+	}
+
 	// Need to check if the actor needs to be unstaked
 	minRequiredStake, err := getGovParam[*big.Int](u, typesUtil.ValidatorMinimumStakeParamName)
 	if err != nil {
@@ -1,6 +1,8 @@
 package utility
 
 import (
+	"github.com/pokt-network/pocket/shared/codec"
+	coreTypes "github.com/pokt-network/pocket/shared/core/types"
 	"github.com/pokt-network/pocket/shared/modules"
 	"github.com/pokt-network/pocket/utility/unit_of_work"
 )
@@ -25,3 +27,28 @@ func (u *utilityModule) NewUnitOfWork(height int64) (modules.UtilityUnitOfWork,
 	utilityUOW.SetBus(u.GetBus())
 	return utilityUOW, nil
 }
+
+func (u *utilityModule) SimulateTransaction(txProtoBytes, signer []byte) (*coreTypes.TxSimulation, error) {
+	tx := &coreTypes.Transaction{}
+	if err := codec.GetCodec().Unmarshal(txProtoBytes, tx); err != nil {
+		return nil, coreTypes.ErrProtoUnmarshal(err)
+	}
+
+	// The transaction is simulated as if it was included in the block currently being worked on
+	height := int64(u.GetBus().GetConsensusModule().CurrentHeight())
+	readContext, err := u.GetBus().GetPersistenceModule().NewReadContext(height)
+	if err != nil {
+		return nil, err
+	}
+	rwContext, err := u.GetBus().GetPersistenceModule().NewSimulationContext(height)
+	if err != nil {
+		readContext.Release()
+		return nil, err
+	}
+
+	simulationUOW := unit_of_work.NewSimulationUOW(height, readContext, rwContext)
+	simulationUOW.SetBus(u.GetBus())
+	defer simulationUOW.Release() //nolint:errcheck // Releasing rolls back the changes made by the simulation
+
+	return simulationUOW.SimulateTransaction(tx, signer), nil
+}